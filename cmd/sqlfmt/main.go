@@ -0,0 +1,26 @@
+// Command sqlfmt formats SQL scripts.
+//
+// Usage:
+//
+//	sqlfmt [flags] [file ...]
+//
+// Without files, sqlfmt formats standard input and writes the result to
+// standard output. Comments are kept on their lines, except that those inside
+// a statement move to the lines before it when it is written on one line with
+// -indent 0, or when they are not next to a list item, condition or other part
+// of the statement. Run sqlfmt -h for the flags, which select the
+// dialect, the case of keywords and identifiers, the indent, the line width
+// and the style of wrapped lists and clauses. As a pre-commit hook, sqlfmt -w
+// rewrites the files in place, and sqlfmt -l lists the files that are not
+// formatted and exits with status 1 if there are any.
+package main
+
+import (
+	"os"
+
+	"github.com/sanemat/go-sql-parser/internal/cli"
+)
+
+func main() {
+	os.Exit(cli.Fmt("sqlfmt", os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,27 @@
+// Command sqlparser lexes, parses, formats and checks SQL scripts.
+//
+// Usage:
+//
+//	sqlparser <command> [flags] [file ...]
+//
+// The commands are:
+//
+//	tokens  print the tokens the lexer reads
+//	parse   print the syntax tree as JSON or as an indented tree
+//	fmt     format SQL scripts, as the sqlfmt command does
+//	check   report syntax errors as file:line:col: message
+//
+// The files may be glob patterns, and - or no files reads standard input.
+// Each command exits with status 1 if an input has errors and 2 if it is
+// misused. Run sqlparser <command> -h for the flags of a command.
+package main
+
+import (
+	"os"
+
+	"github.com/sanemat/go-sql-parser/internal/cli"
+)
+
+func main() {
+	os.Exit(cli.Run("sqlparser", os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+)
+
+// Check parses the inputs named in args, reporting each that does not parse
+// as file:line:col: message, and returns the exit status, which is 1 if any
+// input does not parse. With -v it also names the inputs that parse.
+func Check(name string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: %s [flags] [file ...]\n", name)
+		flags.PrintDefaults()
+	}
+	verbose := flags.Bool("v", false, "also print the inputs without errors")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	inputs, err := expandInputs(flags.Args())
+	if err != nil {
+		return usageError(stderr, name, err)
+	}
+
+	status := 0
+	for _, input := range inputs {
+		src, err := readInput(input, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			status = 1
+			continue
+		}
+		if _, err := parseInput(input, string(src)); err != nil {
+			fmt.Fprintln(stdout, err)
+			status = 1
+			continue
+		}
+		if *verbose {
+			fmt.Fprintf(stdout, "%s: ok\n", displayName(input))
+		}
+	}
+	return status
+}
@@ -0,0 +1,123 @@
+// Package cli implements the commands of the executables under cmd, so that
+// they share their flags and can be tested without building them.
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sanemat/go-sql-parser/parser"
+)
+
+// command is a subcommand of Run.
+type command struct {
+	name    string
+	summary string
+	run     func(name string, args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+	{"tokens", "print the tokens the lexer reads", Tokens},
+	{"parse", "print the syntax tree as JSON or as an indented tree", Parse},
+	{"fmt", "format SQL scripts", Fmt},
+	{"check", "report syntax errors as file:line:col: message", Check},
+}
+
+// Run runs the subcommand named by the first of args, as the sqlparser
+// command, and returns the exit status.
+func Run(name string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr, name)
+		return 2
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		usage(stdout, name)
+		return 0
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(name+" "+c.name, args[1:], stdin, stdout, stderr)
+		}
+	}
+	return usageError(stderr, name, fmt.Errorf("unknown command %q", args[0]))
+}
+
+func usage(w io.Writer, name string) {
+	fmt.Fprintf(w, "usage: %s <command> [flags] [file ...]\n\ncommands:\n", name)
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-8s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(w, "\nThe files may be glob patterns, and - or no files reads standard input.\n"+
+		"Run '%s <command> -h' for the flags of a command.\n", name)
+}
+
+// expandInputs returns the inputs named by args, expanding glob patterns, with
+// "-" standing for standard input, which is also read if args is empty.
+func expandInputs(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return []string{"-"}, nil
+	}
+	var inputs []string
+	for _, arg := range args {
+		if arg == "-" || !strings.ContainsAny(arg, "*?[") {
+			inputs = append(inputs, arg)
+			continue
+		}
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, fmt.Errorf("bad pattern %q: %w", arg, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match %q", arg)
+		}
+		inputs = append(inputs, matches...)
+	}
+	return inputs, nil
+}
+
+// readInput returns the contents of the named file, or of stdin if the name is "-".
+func readInput(name string, stdin io.Reader) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(name)
+}
+
+// displayName returns the name of an input as shown in messages.
+func displayName(name string) string {
+	if name == "-" {
+		return "<stdin>"
+	}
+	return name
+}
+
+// position returns the 1-based line and column of the byte offset pos in src,
+// counting columns in characters.
+func position(src string, pos int) (line, column int) {
+	pos = min(max(pos, 0), len(src))
+	before := src[:pos]
+	line = strings.Count(before, "\n") + 1
+	column = utf8.RuneCountInString(before[strings.LastIndexByte(before, '\n')+1:]) + 1
+	return line, column
+}
+
+// parseDialect returns the dialect with the given name.
+func parseDialect(name string) (parser.Dialect, error) {
+	for _, dialect := range []parser.Dialect{parser.DialectPostgreSQL, parser.DialectMySQL} {
+		if dialect.String() == name {
+			return dialect, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown dialect %q", name)
+}
+
+// usageError reports err with a pointer to the usage and returns the exit status for misuse.
+func usageError(stderr io.Writer, name string, err error) int {
+	fmt.Fprintf(stderr, "%s: %v\nRun '%s -h' for usage.\n", name, err, name)
+	return 2
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantStatus int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "no command",
+			wantStatus: 2,
+			wantStderr: "usage: sqlparser <command>",
+		},
+		{
+			name:       "help",
+			args:       []string{"help"},
+			wantStdout: "usage: sqlparser <command>",
+		},
+		{
+			name:       "unknown command",
+			args:       []string{"lint"},
+			wantStatus: 2,
+			wantStderr: `sqlparser: unknown command "lint"`,
+		},
+		{
+			name:       "tokens",
+			args:       []string{"tokens"},
+			stdin:      "select a\nfrom t",
+			wantStdout: "<stdin>:1:1\tSelect\t\"select\"\n<stdin>:1:8\tIdentifier\t\"a\"\n<stdin>:2:1\tFrom\t\"from\"\n<stdin>:2:6\tIdentifier\t\"t\"\n<stdin>:2:7\tEOF\t\"\"\n",
+		},
+		{
+			name:       "tokens as json",
+			args:       []string{"tokens", "-format", "json"},
+			stdin:      "select 1",
+			wantStdout: `[{"type":"Select","literal":"select","pos":0,"line":1,"column":1},{"type":"NumericLiteral","literal":"1","pos":7,"line":1,"column":8},{"type":"EOF","literal":"","pos":8,"line":1,"column":9}]` + "\n",
+		},
+		{
+			name:       "tokens with a lexer error",
+			args:       []string{"tokens"},
+			stdin:      "select 'a",
+			wantStatus: 1,
+			wantStdout: "<stdin>:1:1\tSelect\t\"select\"\n<stdin>:1:8\tError\t\"'a\"\n",
+			wantStderr: "<stdin>:1:8: cannot lex \"'a\"\n",
+		},
+		{
+			name:       "parse as json",
+			args:       []string{"parse"},
+			stdin:      "select a",
+			wantStdout: "{\n  \"file\": \"<stdin>\",\n  \"statements\": [\n    {\n      \"type\": \"SelectStatement\",\n      \"pos\": 0,\n      \"end\": 8,\n      \"Expressions\": [\n        {\n          \"type\": \"ColumnExpression\",\n          \"pos\": 7,\n          \"end\": 8,\n          \"Name\": \"a\"\n        }\n      ]\n    }\n  ]\n}\n",
+		},
+		{
+			name:  "parse as a tree",
+			args:  []string{"parse", "-format", "tree"},
+			stdin: "select a from t\nwhere b in (1, 2)",
+			wantStdout: "SelectStatement (1:1-2:18)\n  Expressions:\n    ColumnExpression (1:8-1:9)\n      Name: \"a\"\n  From:\n    TableName (1:15-1:16)\n      Name: \"t\"\n" +
+				"  Where: InExpression (2:7-2:18)\n    Expression: ColumnExpression (2:7-2:8)\n      Name: \"b\"\n" +
+				"    List:\n      NumericLiteral (2:13-2:14)\n        Value: 1\n        Text: \"1\"\n      NumericLiteral (2:16-2:17)\n        Value: 2\n        Text: \"2\"\n",
+		},
+		{
+			name:       "parse error",
+			args:       []string{"parse"},
+			stdin:      "select 1;\nselect a from",
+			wantStatus: 1,
+			wantStderr: "<stdin>:2:14: ",
+		},
+		{
+			name:       "fmt",
+			args:       []string{"fmt", "-indent", "0"},
+			stdin:      "select 1",
+			wantStdout: "SELECT 1;\n",
+		},
+		{
+			name:       "check",
+			args:       []string{"check", "-v"},
+			stdin:      "select 1",
+			wantStdout: "<stdin>: ok\n",
+		},
+		{
+			name:       "check error",
+			args:       []string{"check"},
+			stdin:      "-- first\nselect 1 2",
+			wantStatus: 1,
+			wantStdout: "<stdin>:2:10: unexpected token \"2\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			status := Run("sqlparser", tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("Run() = %d, want %d; stderr %q", status, tt.wantStatus, stderr.String())
+			}
+			if !strings.HasPrefix(stdout.String(), tt.wantStdout) || (tt.wantStdout == "" && stdout.Len() > 0) {
+				t.Errorf("Run() stdout = %q, want prefix %q", stdout.String(), tt.wantStdout)
+			}
+			if !strings.HasPrefix(stderr.String(), tt.wantStderr) {
+				t.Errorf("Run() stderr = %q, want prefix %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestExpandInputs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.sql", "b.sql", "c.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := expandInputs([]string{filepath.Join(dir, "*.sql"), "-", "missing.sql"})
+	if err != nil {
+		t.Fatalf("expandInputs() error = %v", err)
+	}
+	want := []string{filepath.Join(dir, "a.sql"), filepath.Join(dir, "b.sql"), "-", "missing.sql"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandInputs() = %q, want %q", got, want)
+	}
+	if got, _ := expandInputs(nil); !reflect.DeepEqual(got, []string{"-"}) {
+		t.Errorf("expandInputs(nil) = %q, want standard input", got)
+	}
+	if _, err := expandInputs([]string{filepath.Join(dir, "*.csv")}); err == nil {
+		t.Error("expandInputs() error = nil for a pattern without matches")
+	}
+}
+
+func TestPosition(t *testing.T) {
+	tests := []struct {
+		src        string
+		pos        int
+		wantLine   int
+		wantColumn int
+	}{
+		{"select 1", 0, 1, 1},
+		{"select 1", 7, 1, 8},
+		{"select 1", 8, 1, 9},
+		{"select\n  1", 9, 2, 3},
+		{"select 'é', x", 12, 1, 12},
+	}
+	for _, tt := range tests {
+		line, column := position(tt.src, tt.pos)
+		if line != tt.wantLine || column != tt.wantColumn {
+			t.Errorf("position(%q, %d) = %d:%d, want %d:%d", tt.src, tt.pos, line, column, tt.wantLine, tt.wantColumn)
+		}
+	}
+}
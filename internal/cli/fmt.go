@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/sanemat/go-sql-parser/parser"
+)
+
+// Fmt formats SQL scripts, as the sqlfmt command. It formats the files named
+// in args, which may be glob patterns, or standard input if there are none,
+// writing the result to stdout unless -w or -l is given, and returns the exit
+// status.
+func Fmt(name string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: %s [flags] [file ...]\n", name)
+		flags.PrintDefaults()
+	}
+	dialect := flags.String("dialect", "postgresql", "`dialect` to write: postgresql or mysql")
+	keywordCase := flags.String("keyword-case", "upper", "`case` of keywords: upper or lower")
+	identifierCase := flags.String("identifier-case", "keep", "`case` of identifiers: keep, upper or lower")
+	indent := flags.Int("indent", 4, "spaces to indent nested lines by, or 0 to write each statement on one line")
+	width := flags.Int("width", 80, "line width to wrap lists and conditions at")
+	commaFirst := flags.Bool("comma-first", false, "start wrapped list lines with their commas")
+	river := flags.Bool("river", false, "right-align clause keywords so their bodies line up")
+	write := flags.Bool("w", false, "write the result to the files instead of standard output")
+	list := flags.Bool("l", false, "list the files whose formatting differs, exiting with status 1 if there are any")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	config := &parser.PrintConfig{
+		Indent:     *indent,
+		LineWidth:  *width,
+		CommaFirst: *commaFirst,
+		River:      *river,
+	}
+	var err error
+	if config.Dialect, err = parseDialect(*dialect); err != nil {
+		return usageError(stderr, name, err)
+	}
+	if config.KeywordCase, err = parseCase(*keywordCase, "upper"); err != nil {
+		return usageError(stderr, name, err)
+	}
+	if config.IdentifierCase, err = parseCase(*identifierCase, "keep"); err != nil {
+		return usageError(stderr, name, err)
+	}
+	if *indent < 0 || *width < 0 {
+		return usageError(stderr, name, fmt.Errorf("-indent and -width must not be negative"))
+	}
+
+	inputs, err := expandInputs(flags.Args())
+	if err != nil {
+		return usageError(stderr, name, err)
+	}
+	if *write && slices.Contains(inputs, "-") {
+		return usageError(stderr, name, fmt.Errorf("cannot use -w with standard input"))
+	}
+	status := 0
+	for _, input := range inputs {
+		src, err := readInput(input, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			status = 1
+			continue
+		}
+		formatted, err := config.Format(string(src))
+		if err != nil {
+			fmt.Fprintln(stderr, inputError(input, string(src), err))
+			status = 1
+			continue
+		}
+		changed := !bytes.Equal(src, []byte(formatted))
+		if *list && changed {
+			fmt.Fprintln(stdout, displayName(input))
+			status = 1
+		}
+		if *write && changed {
+			if err := os.WriteFile(input, []byte(formatted), 0o644); err != nil {
+				fmt.Fprintf(stderr, "%s: %v\n", name, err)
+				status = 1
+			}
+		}
+		if !*list && !*write {
+			io.WriteString(stdout, formatted)
+		}
+	}
+	return status
+}
+
+// parseCase returns the case named by value, where keep names the default case.
+func parseCase(value, keep string) (parser.Case, error) {
+	switch value {
+	case keep:
+		return parser.CaseDefault, nil
+	case "upper":
+		return parser.CaseUpper, nil
+	case "lower":
+		return parser.CaseLower, nil
+	}
+	return 0, fmt.Errorf("unknown case %q", value)
+}
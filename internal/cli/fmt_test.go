@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFmt(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantStatus int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "standard input",
+			stdin:      "select a from t where b = 1",
+			wantStdout: "SELECT a\nFROM t\nWHERE b = 1;\n",
+		},
+		{
+			name:       "options",
+			args:       []string{"-indent", "0", "-keyword-case", "lower", "-identifier-case", "upper", "-dialect", "mysql"},
+			stdin:      "SELECT a FROM t WHERE `b c` = 1",
+			wantStdout: "select A from T where `b c` = 1;\n",
+		},
+		{
+			name:       "syntax error",
+			stdin:      "-- first\nselect 1 2",
+			wantStatus: 1,
+			wantStderr: "<stdin>:2:10: unexpected token \"2\"",
+		},
+		{
+			name:       "unknown case",
+			args:       []string{"-keyword-case", "title"},
+			wantStatus: 2,
+			wantStderr: `sqlfmt: unknown case "title"`,
+		},
+		{
+			name:       "write needs files",
+			args:       []string{"-w"},
+			wantStatus: 2,
+			wantStderr: "sqlfmt: cannot use -w with standard input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			status := Fmt("sqlfmt", tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("Fmt() = %d, want %d; stderr %q", status, tt.wantStatus, stderr.String())
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("Fmt() stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+			if !strings.HasPrefix(stderr.String(), tt.wantStderr) {
+				t.Errorf("Fmt() stderr = %q, want prefix %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestFmtFiles(t *testing.T) {
+	dir := t.TempDir()
+	formatted := filepath.Join(dir, "formatted.sql")
+	unformatted := filepath.Join(dir, "unformatted.sql")
+	if err := os.WriteFile(formatted, []byte("SELECT 1;\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(unformatted, []byte("select 1"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr strings.Builder
+	if status := Fmt("sqlfmt", []string{"-l", formatted, unformatted}, nil, &stdout, &stderr); status != 1 {
+		t.Errorf("Fmt(-l) = %d, want 1; stderr %q", status, stderr.String())
+	}
+	if stdout.String() != unformatted+"\n" {
+		t.Errorf("Fmt(-l) stdout = %q, want %q", stdout.String(), unformatted+"\n")
+	}
+
+	stdout.Reset()
+	if status := Fmt("sqlfmt", []string{"-w", formatted, unformatted}, nil, &stdout, &stderr); status != 0 {
+		t.Errorf("Fmt(-w) = %d, want 0; stderr %q", status, stderr.String())
+	}
+	if stdout.String() != "" {
+		t.Errorf("Fmt(-w) stdout = %q, want nothing", stdout.String())
+	}
+	got, err := os.ReadFile(unformatted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "SELECT 1;\n" {
+		t.Errorf("Fmt(-w) wrote %q, want %q", got, "SELECT 1;\n")
+	}
+}
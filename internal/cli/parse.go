@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/sanemat/go-sql-parser/lexer"
+	"github.com/sanemat/go-sql-parser/parser"
+)
+
+// parseInput lexes and parses src, returning the statements or an error
+// located as file:line:col in the input named name.
+func parseInput(name, src string) ([]parser.Statement, error) {
+	stmts, err := parser.NewParser(lexer.NewLexer(src).Lex()).Parse()
+	if err != nil {
+		return nil, inputError(name, src, err)
+	}
+	return stmts, nil
+}
+
+// inputError returns err, raised for src, prefixed with the input named name,
+// and with the line and column if it is a syntax error.
+func inputError(name, src string, err error) error {
+	var syntaxErr *parser.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		line, column := position(src, syntaxErr.Pos)
+		return fmt.Errorf("%s:%d:%d: %v", displayName(name), line, column, syntaxErr.Err)
+	}
+	return fmt.Errorf("%s: %v", displayName(name), err)
+}
+
+// Parse prints the syntax trees of the inputs named in args, as a JSON object
+// per file holding its name and statements, or as an indented tree, and
+// returns the exit status, which is 1 if an input does not parse.
+func Parse(name string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: %s [flags] [file ...]\n", name)
+		flags.PrintDefaults()
+	}
+	format := flags.String("format", "json", "output `format`: json or tree")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *format != "json" && *format != "tree" {
+		return usageError(stderr, name, fmt.Errorf("unknown format %q", *format))
+	}
+	inputs, err := expandInputs(flags.Args())
+	if err != nil {
+		return usageError(stderr, name, err)
+	}
+
+	status := 0
+	for _, input := range inputs {
+		src, err := readInput(input, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			status = 1
+			continue
+		}
+		stmts, err := parseInput(input, string(src))
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			status = 1
+			continue
+		}
+		converted := make([]any, len(stmts))
+		for i, stmt := range stmts {
+			converted[i] = convert(reflect.ValueOf(stmt))
+		}
+		if *format == "tree" {
+			if len(inputs) > 1 {
+				fmt.Fprintf(stdout, "%s:\n", displayName(input))
+			}
+			t := &treeWriter{w: stdout, src: string(src)}
+			for _, stmt := range converted {
+				t.object(stmt.(*object), "")
+			}
+			continue
+		}
+		encoder := json.NewEncoder(stdout)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(struct {
+			File       string `json:"file"`
+			Statements []any  `json:"statements"`
+		}{displayName(input), converted})
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			return 1
+		}
+	}
+	return status
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/sanemat/go-sql-parser/lexer"
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// jsonToken is a token as written by Tokens -format json.
+type jsonToken struct {
+	Type    string `json:"type"`
+	Literal string `json:"literal"`
+	Pos     int    `json:"pos"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+}
+
+// Tokens prints the tokens the lexer reads from the inputs named in args, one
+// per line as file:line:col, type and quoted literal, or as JSON, and returns
+// the exit status, which is 1 if the lexer reports an error.
+func Tokens(name string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: %s [flags] [file ...]\n", name)
+		flags.PrintDefaults()
+	}
+	format := flags.String("format", "text", "output `format`: text or json, one array of tokens per file")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *format != "text" && *format != "json" {
+		return usageError(stderr, name, fmt.Errorf("unknown format %q", *format))
+	}
+	inputs, err := expandInputs(flags.Args())
+	if err != nil {
+		return usageError(stderr, name, err)
+	}
+
+	status := 0
+	for _, input := range inputs {
+		src, err := readInput(input, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			status = 1
+			continue
+		}
+		lexed := lexer.NewLexer(string(src)).Lex()
+		var list []jsonToken
+		for _, token := range lexed {
+			line, column := position(string(src), token.Pos)
+			if token.Type == tokens.TokenError {
+				fmt.Fprintf(stderr, "%s:%d:%d: cannot lex %q\n", displayName(input), line, column, token.Literal)
+				status = 1
+			}
+			if *format == "json" {
+				list = append(list, jsonToken{token.Type.String(), token.Literal, token.Pos, line, column})
+				continue
+			}
+			fmt.Fprintf(stdout, "%s:%d:%d\t%s\t%q\n", displayName(input), line, column, token.Type, token.Literal)
+		}
+		if *format == "json" {
+			out, err := marshal(list)
+			if err != nil {
+				fmt.Fprintf(stderr, "%s: %v\n", name, err)
+				return 1
+			}
+			fmt.Fprintf(stdout, "%s\n", out)
+		}
+	}
+	return status
+}
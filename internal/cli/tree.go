@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/parser"
+)
+
+// object is a node, or another struct of the syntax tree, prepared for output
+// with its fields in the order they are declared.
+type object struct {
+	typ      string
+	spanned  bool
+	pos, end int
+	fields   []field
+}
+
+// field is a named value of an object. The value is nil, a scalar, an *object or a []any.
+type field struct {
+	name  string
+	value any
+}
+
+var (
+	nodeType     = reflect.TypeFor[parser.Node]()
+	stringerType = reflect.TypeFor[fmt.Stringer]()
+)
+
+// convert returns v prepared for output, or nil if it is empty: nil pointers,
+// interfaces and slices, false and the empty string are left out of the output.
+func convert(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return convert(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Implements(nodeType) && v.Elem().Kind() == reflect.Struct {
+			node := v.Interface().(parser.Node)
+			o := convert(v.Elem()).(*object)
+			o.spanned, o.pos, o.end = true, node.Pos(), node.End()
+			return o
+		}
+		return convert(v.Elem())
+	case reflect.Struct:
+		o := &object{typ: v.Type().Name()}
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if value := convert(v.Field(i)); value != nil {
+				o.fields = append(o.fields, field{v.Type().Field(i).Name, value})
+			}
+		}
+		return o
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return nil
+		}
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = convert(v.Index(i))
+		}
+		return items
+	case reflect.Bool:
+		if !v.Bool() {
+			return nil
+		}
+	case reflect.String:
+		if v.String() == "" {
+			return nil
+		}
+	}
+	// Enumerations such as DropBehavior and TokenType are written by name.
+	if v.Type().Implements(stringerType) {
+		return v.Interface().(fmt.Stringer).String()
+	}
+	return v.Interface()
+}
+
+// MarshalJSON writes the object with a "type" member naming its Go type, and
+// for nodes "pos" and "end" members holding the byte offsets of its span.
+func (o *object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	typ, _ := marshal(o.typ)
+	fmt.Fprintf(&b, `{"type":%s`, typ)
+	if o.spanned {
+		fmt.Fprintf(&b, `,"pos":%d,"end":%d`, o.pos, o.end)
+	}
+	for _, f := range o.fields {
+		name, _ := marshal(f.name)
+		value, err := marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, ",%s:%s", name, value)
+	}
+	b.WriteString("}")
+	return b.Bytes(), nil
+}
+
+// marshal returns v as compact JSON, leaving the characters that are special in HTML unescaped.
+func marshal(v any) ([]byte, error) {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// treeWriter writes converted values as an indented tree, locating spans in src.
+type treeWriter struct {
+	w   io.Writer
+	src string
+}
+
+// header returns the type of o with the lines and columns of its span, if it has one.
+func (t *treeWriter) header(o *object) string {
+	if !o.spanned {
+		return o.typ
+	}
+	line, column := position(t.src, o.pos)
+	endLine, endColumn := position(t.src, o.end)
+	return fmt.Sprintf("%s (%d:%d-%d:%d)", o.typ, line, column, endLine, endColumn)
+}
+
+// object writes the header of o followed by its fields indented below it.
+func (t *treeWriter) object(o *object, indent string) {
+	fmt.Fprintf(t.w, "%s%s\n", indent, t.header(o))
+	t.fields(o, indent+"  ")
+}
+
+func (t *treeWriter) fields(o *object, indent string) {
+	for _, f := range o.fields {
+		switch value := f.value.(type) {
+		case *object:
+			fmt.Fprintf(t.w, "%s%s: %s\n", indent, f.name, t.header(value))
+			t.fields(value, indent+"  ")
+		case []any:
+			if _, ok := value[0].(*object); !ok {
+				fmt.Fprintf(t.w, "%s%s: %s\n", indent, f.name, scalars(value))
+				continue
+			}
+			fmt.Fprintf(t.w, "%s%s:\n", indent, f.name)
+			for _, item := range value {
+				if item, ok := item.(*object); ok {
+					t.object(item, indent+"  ")
+				} else {
+					fmt.Fprintf(t.w, "%s  %s\n", indent, scalar(item))
+				}
+			}
+		default:
+			fmt.Fprintf(t.w, "%s%s: %s\n", indent, f.name, scalar(value))
+		}
+	}
+}
+
+// scalars returns a list of scalars written on one line.
+func scalars(values []any) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = scalar(value)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// scalar returns a value written in the tree, quoting strings.
+func scalar(value any) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(value)
+}
@@ -10,6 +10,19 @@ func isDigit(r rune) bool {
 	return unicode.IsDigit(r)
 }
 
+func isHexDigit(r rune) bool {
+	return '0' <= r && r <= '9' || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F'
+}
+
+// isStringPrefix reports whether r can prefix a string literal, as in E'a\tb', X'1F', B'101' or N'text'.
+func isStringPrefix(r rune) bool {
+	switch r {
+	case 'e', 'E', 'x', 'X', 'b', 'B', 'n', 'N':
+		return true
+	}
+	return false
+}
+
 // Helper functions to classify characters
 func isWhitespace(r rune) bool {
 	return unicode.IsSpace(r)
@@ -10,24 +10,91 @@ const eof = -1
 
 // keywords defines SQL keywords to be recognized.
 var keywords = map[string]tokens.TokenType{
-	"SELECT": tokens.TokenSelect,
-	"FROM":   tokens.TokenFrom,
-	"NULL":   tokens.TokenNull,
-	"TRUE":   tokens.TokenBooleanLiteral,
-	"FALSE":  tokens.TokenBooleanLiteral,
+	"SELECT":     tokens.TokenSelect,
+	"FROM":       tokens.TokenFrom,
+	"NULL":       tokens.TokenNull,
+	"TRUE":       tokens.TokenBooleanLiteral,
+	"FALSE":      tokens.TokenBooleanLiteral,
+	"WHERE":      tokens.TokenWhere,
+	"GROUP":      tokens.TokenGroup,
+	"HAVING":     tokens.TokenHaving,
+	"ORDER":      tokens.TokenOrder,
+	"BY":         tokens.TokenBy,
+	"ASC":        tokens.TokenAsc,
+	"DESC":       tokens.TokenDesc,
+	"LIMIT":      tokens.TokenLimit,
+	"OFFSET":     tokens.TokenOffset,
+	"FETCH":      tokens.TokenFetch,
+	"AND":        tokens.TokenAnd,
+	"OR":         tokens.TokenOr,
+	"NOT":        tokens.TokenNot,
+	"COLLATE":    tokens.TokenCollate,
+	"ALL":        tokens.TokenAll,
+	"WITH":       tokens.TokenWith,
+	"UNION":      tokens.TokenUnion,
+	"INTERSECT":  tokens.TokenIntersect,
+	"EXCEPT":     tokens.TokenExcept,
+	"DISTINCT":   tokens.TokenDistinct,
+	"AS":         tokens.TokenAs,
+	"SET":        tokens.TokenSet,
+	"TO":         tokens.TokenTo,
+	"DEFAULT":    tokens.TokenDefault,
+	"USING":      tokens.TokenUsing,
+	"WINDOW":     tokens.TokenWindow,
+	"BETWEEN":    tokens.TokenBetween,
+	"CASE":       tokens.TokenCase,
+	"WHEN":       tokens.TokenWhen,
+	"THEN":       tokens.TokenThen,
+	"ELSE":       tokens.TokenElse,
+	"END":        tokens.TokenEnd,
+	"CAST":       tokens.TokenCast,
+	"IS":         tokens.TokenIs,
+	"IN":         tokens.TokenIn,
+	"LIKE":       tokens.TokenLike,
+	"ILIKE":      tokens.TokenILike,
+	"FOR":        tokens.TokenFor,
+	"INSERT":     tokens.TokenInsert,
+	"INTO":       tokens.TokenInto,
+	"VALUES":     tokens.TokenValues,
+	"ON":         tokens.TokenOn,
+	"UPDATE":     tokens.TokenUpdate,
+	"RETURNING":  tokens.TokenReturning,
+	"CONSTRAINT": tokens.TokenConstraint,
+	"DELETE":     tokens.TokenDelete,
+	"TRUNCATE":   tokens.TokenTruncate,
+	"TABLE":      tokens.TokenTable,
+	"CREATE":     tokens.TokenCreate,
+	"PRIMARY":    tokens.TokenPrimary,
+	"UNIQUE":     tokens.TokenUnique,
+	"CHECK":      tokens.TokenCheck,
+	"REFERENCES": tokens.TokenReferences,
+	"FOREIGN":    tokens.TokenForeign,
+	"ALTER":      tokens.TokenAlter,
+	"DROP":       tokens.TokenDrop,
 	// Add more SQL keywords here...
 }
 
 var symbols = map[string]tokens.TokenType{
 	";":  tokens.TokenSemicolon,
 	",":  tokens.TokenComma,
-	"(":  tokens.TokenSymbol,
-	")":  tokens.TokenSymbol,
-	"=":  tokens.TokenSymbol,
+	"(":  tokens.TokenLeftParen,
+	")":  tokens.TokenRightParen,
+	"=":  tokens.TokenEqual,
 	"*":  tokens.TokenSymbol,
-	"+":  tokens.TokenSymbol,
-	"-":  tokens.TokenSymbol,
-	"/":  tokens.TokenSymbol,
+	"@":  tokens.TokenSymbol,
+	"?":  tokens.TokenParameter,
+	"+":  tokens.TokenPlus,
+	"-":  tokens.TokenMinus,
+	"/":  tokens.TokenSlash,
+	"%":  tokens.TokenPercent,
+	".":  tokens.TokenDot,
+	"[":  tokens.TokenLeftBracket,
+	"]":  tokens.TokenRightBracket,
+	"<>": tokens.TokenNotEqual,
+	"!=": tokens.TokenNotEqual,
+	"||": tokens.TokenConcat,
+	"&&": tokens.TokenSymbol,
+	"::": tokens.TokenDoubleColon,
 	"<=": tokens.TokenLessThanOrEqual,
 	"<":  tokens.TokenLessThan,
 	">=": tokens.TokenGreaterThanOrEqual,
@@ -56,7 +123,7 @@ func (l *Lexer) Lex() []tokens.Token {
 }
 
 func (l *Lexer) emit(t tokens.TokenType) {
-	l.tokens = append(l.tokens, tokens.Token{Type: t, Literal: l.input[l.start:l.position]})
+	l.tokens = append(l.tokens, tokens.Token{Type: t, Literal: l.input[l.start:l.position], Pos: l.start})
 	l.start = l.position
 }
 
@@ -102,7 +169,7 @@ func couldBeSymbol(l *Lexer) bool {
 
 // emitToken is a helper to emit tokens with specific literals, simplifying token emission
 func (l *Lexer) emitToken(t tokens.TokenType, literal string) {
-	l.tokens = append(l.tokens, tokens.Token{Type: t, Literal: literal})
+	l.tokens = append(l.tokens, tokens.Token{Type: t, Literal: literal, Pos: l.start})
 	l.start = l.position // Reset the start position for the next token
 }
 
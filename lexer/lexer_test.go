@@ -17,190 +17,488 @@ func TestLexer(t *testing.T) {
 			"select lower case",
 			"select",
 			[]tokens.Token{
-				{Type: tokens.TokenSelect, Literal: "select"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 6},
 			},
 		},
 		{
 			"select upper case",
 			"SELECT",
 			[]tokens.Token{
-				{Type: tokens.TokenSelect, Literal: "SELECT"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenSelect, Literal: "SELECT", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 6},
 			},
 		},
 		{
 			"table name",
 			"tablename",
 			[]tokens.Token{
-				{Type: tokens.TokenIdentifier, Literal: "tablename"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenIdentifier, Literal: "tablename", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 9},
 			},
 		},
 		{
 			"asterisk",
 			"*",
 			[]tokens.Token{
-				{Type: tokens.TokenSymbol, Literal: "*"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenSymbol, Literal: "*", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 1},
 			},
 		},
 		{
 			"simple select sql",
 			"select * from tablename;",
 			[]tokens.Token{
-				{Type: tokens.TokenSelect, Literal: "select"},
-				{Type: tokens.TokenSymbol, Literal: "*"},
-				{Type: tokens.TokenFrom, Literal: "from"},
-				{Type: tokens.TokenIdentifier, Literal: "tablename"},
-				{Type: tokens.TokenSemicolon, Literal: ";"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 0},
+				{Type: tokens.TokenSymbol, Literal: "*", Pos: 7},
+				{Type: tokens.TokenFrom, Literal: "from", Pos: 9},
+				{Type: tokens.TokenIdentifier, Literal: "tablename", Pos: 14},
+				{Type: tokens.TokenSemicolon, Literal: ";", Pos: 23},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 24},
 			},
 		},
 		{
 			"invalid syntax",
 			"select # invalid syntax;",
 			[]tokens.Token{
-				{Type: tokens.TokenSelect, Literal: "select"},
-				{Type: tokens.TokenError, Literal: ""},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 0},
+				{Type: tokens.TokenError, Literal: "", Pos: 7},
 			},
 		},
 		{
 			"multiple columns",
 			"select id, title from table1;",
 			[]tokens.Token{
-				{Type: tokens.TokenSelect, Literal: "select"},
-				{Type: tokens.TokenIdentifier, Literal: "id"},
-				{Type: tokens.TokenComma, Literal: ","},
-				{Type: tokens.TokenIdentifier, Literal: "title"},
-				{Type: tokens.TokenFrom, Literal: "from"},
-				{Type: tokens.TokenIdentifier, Literal: "table1"},
-				{Type: tokens.TokenSemicolon, Literal: ";"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 0},
+				{Type: tokens.TokenIdentifier, Literal: "id", Pos: 7},
+				{Type: tokens.TokenComma, Literal: ",", Pos: 9},
+				{Type: tokens.TokenIdentifier, Literal: "title", Pos: 11},
+				{Type: tokens.TokenFrom, Literal: "from", Pos: 17},
+				{Type: tokens.TokenIdentifier, Literal: "table1", Pos: 22},
+				{Type: tokens.TokenSemicolon, Literal: ";", Pos: 28},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 29},
 			},
 		},
 		{
 			"null value",
 			"null",
 			[]tokens.Token{
-				{Type: tokens.TokenNull, Literal: "null"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenNull, Literal: "null", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 4},
 			},
 		},
 		{
 			"true value",
 			"true",
 			[]tokens.Token{
-				{Type: tokens.TokenBooleanLiteral, Literal: "true"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenBooleanLiteral, Literal: "true", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 4},
 			},
 		},
 		{
 			"false value",
 			"false",
 			[]tokens.Token{
-				{Type: tokens.TokenBooleanLiteral, Literal: "false"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenBooleanLiteral, Literal: "false", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 5},
 			},
 		},
 		{
 			"number",
 			"123",
 			[]tokens.Token{
-				{Type: tokens.TokenNumericLiteral, Literal: "123"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenNumericLiteral, Literal: "123", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 3},
 			},
 		},
 		{
 			"big number",
 			"1230000000000000000000000000000000000000000",
 			[]tokens.Token{
-				{Type: tokens.TokenNumericLiteral, Literal: "1230000000000000000000000000000000000000000"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenNumericLiteral, Literal: "1230000000000000000000000000000000000000000", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 43},
+			},
+		},
+		{
+			"user variable",
+			"@total_1",
+			[]tokens.Token{
+				{Type: tokens.TokenVariable, Literal: "@total_1", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 8},
+			},
+		},
+		{
+			"exponent and hexadecimal numbers",
+			"1.5e3 2E-4 0x1F",
+			[]tokens.Token{
+				{Type: tokens.TokenNumericLiteral, Literal: "1.5e3", Pos: 0},
+				{Type: tokens.TokenNumericLiteral, Literal: "2E-4", Pos: 6},
+				{Type: tokens.TokenNumericLiteral, Literal: "0x1F", Pos: 11},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 15},
+			},
+		},
+		{
+			"number followed by a letter",
+			"1.5e3x",
+			[]tokens.Token{
+				{Type: tokens.TokenError, Literal: "1.5e3", Pos: 0},
+			},
+		},
+		{
+			"prefixed strings",
+			`x'ff' E'it\'s' n'a'`,
+			[]tokens.Token{
+				{Type: tokens.TokenStringLiteral, Literal: "x'ff'", Pos: 0},
+				{Type: tokens.TokenStringLiteral, Literal: `E'it\'s'`, Pos: 6},
+				{Type: tokens.TokenStringLiteral, Literal: "n'a'", Pos: 15},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 19},
+			},
+		},
+		{
+			"mysql placeholder",
+			"a = ?",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "a", Pos: 0},
+				{Type: tokens.TokenEqual, Literal: "=", Pos: 2},
+				{Type: tokens.TokenParameter, Literal: "?", Pos: 4},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 5},
+			},
+		},
+		{
+			"mysql account",
+			"'app'@'%'",
+			[]tokens.Token{
+				{Type: tokens.TokenStringLiteral, Literal: "'app'", Pos: 0},
+				{Type: tokens.TokenSymbol, Literal: "@", Pos: 5},
+				{Type: tokens.TokenStringLiteral, Literal: "'%'", Pos: 6},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 9},
 			},
 		},
 		{
 			"decimal points",
 			"1.23",
 			[]tokens.Token{
-				{Type: tokens.TokenNumericLiteral, Literal: "1.23"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenNumericLiteral, Literal: "1.23", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 4},
 			},
 		},
 		{
 			"multiple statements",
 			"select 1; select 2;",
 			[]tokens.Token{
-				{Type: tokens.TokenSelect, Literal: "select"},
-				{Type: tokens.TokenNumericLiteral, Literal: "1"},
-				{Type: tokens.TokenSemicolon, Literal: ";"},
-				{Type: tokens.TokenSelect, Literal: "select"},
-				{Type: tokens.TokenNumericLiteral, Literal: "2"},
-				{Type: tokens.TokenSemicolon, Literal: ";"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 0},
+				{Type: tokens.TokenNumericLiteral, Literal: "1", Pos: 7},
+				{Type: tokens.TokenSemicolon, Literal: ";", Pos: 8},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 10},
+				{Type: tokens.TokenNumericLiteral, Literal: "2", Pos: 17},
+				{Type: tokens.TokenSemicolon, Literal: ";", Pos: 18},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 19},
 			},
 		},
 		{
 			"string",
 			"'text'",
 			[]tokens.Token{
-				{Type: tokens.TokenStringLiteral, Literal: "'text'"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenStringLiteral, Literal: "'text'", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 6},
 			},
 		},
 		{
 			"string with escaped quote",
 			"'O''Reilly'",
 			[]tokens.Token{
-				{Type: tokens.TokenStringLiteral, Literal: "'O''Reilly'"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenStringLiteral, Literal: "'O''Reilly'", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 11},
 			},
 		},
 		{
 			"unterminated string",
 			"'this string has no end",
 			[]tokens.Token{
-				{Type: tokens.TokenError, Literal: "'this string has no end"},
+				{Type: tokens.TokenError, Literal: "'this string has no end", Pos: 0},
 			},
 		},
 		{
 			"empty string",
 			"''",
 			[]tokens.Token{
-				{Type: tokens.TokenStringLiteral, Literal: "''"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenStringLiteral, Literal: "''", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 2},
 			},
 		},
 		{
 			"less than or equal to",
 			"<=",
 			[]tokens.Token{
-				{Type: tokens.TokenLessThanOrEqual, Literal: "<="},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenLessThanOrEqual, Literal: "<=", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 2},
 			},
 		},
 		{
 			"less than",
 			"<",
 			[]tokens.Token{
-				{Type: tokens.TokenLessThan, Literal: "<"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenLessThan, Literal: "<", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 1},
 			},
 		},
 		{
 			"greater than or equal to",
 			">=",
 			[]tokens.Token{
-				{Type: tokens.TokenGreaterThanOrEqual, Literal: ">="},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenGreaterThanOrEqual, Literal: ">=", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 2},
 			},
 		},
 		{
 			"greater than",
 			">",
 			[]tokens.Token{
-				{Type: tokens.TokenGreaterThan, Literal: ">"},
-				{Type: tokens.TokenEOF, Literal: ""},
+				{Type: tokens.TokenGreaterThan, Literal: ">", Pos: 0},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 1},
+			},
+		},
+		{
+			"not equal",
+			"a <> b != c",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "a", Pos: 0},
+				{Type: tokens.TokenNotEqual, Literal: "<>", Pos: 2},
+				{Type: tokens.TokenIdentifier, Literal: "b", Pos: 5},
+				{Type: tokens.TokenNotEqual, Literal: "!=", Pos: 7},
+				{Type: tokens.TokenIdentifier, Literal: "c", Pos: 10},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 11},
+			},
+		},
+		{
+			"qualified column",
+			"t.id",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "t", Pos: 0},
+				{Type: tokens.TokenDot, Literal: ".", Pos: 1},
+				{Type: tokens.TokenIdentifier, Literal: "id", Pos: 2},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 4},
+			},
+		},
+		{
+			"quoted identifiers",
+			"\"Order \"\"Id\"\"\" `name`",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "\"Order \"\"Id\"\"\"", Pos: 0},
+				{Type: tokens.TokenIdentifier, Literal: "`name`", Pos: 15},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 21},
+			},
+		},
+		{
+			"unterminated quoted identifier",
+			"\"name",
+			[]tokens.Token{
+				{Type: tokens.TokenError, Literal: "\"name", Pos: 0},
+			},
+		},
+		{
+			"order by and limit",
+			"order by id desc limit 10 offset 20",
+			[]tokens.Token{
+				{Type: tokens.TokenOrder, Literal: "order", Pos: 0},
+				{Type: tokens.TokenBy, Literal: "by", Pos: 6},
+				{Type: tokens.TokenIdentifier, Literal: "id", Pos: 9},
+				{Type: tokens.TokenDesc, Literal: "desc", Pos: 12},
+				{Type: tokens.TokenLimit, Literal: "limit", Pos: 17},
+				{Type: tokens.TokenNumericLiteral, Literal: "10", Pos: 23},
+				{Type: tokens.TokenOffset, Literal: "offset", Pos: 26},
+				{Type: tokens.TokenNumericLiteral, Literal: "20", Pos: 33},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 35},
+			},
+		},
+		{
+			"set operators",
+			"union intersect except distinct",
+			[]tokens.Token{
+				{Type: tokens.TokenUnion, Literal: "union", Pos: 0},
+				{Type: tokens.TokenIntersect, Literal: "intersect", Pos: 6},
+				{Type: tokens.TokenExcept, Literal: "except", Pos: 16},
+				{Type: tokens.TokenDistinct, Literal: "distinct", Pos: 23},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 31},
+			},
+		},
+		{
+			"with clause keywords",
+			"with t as (select 1)",
+			[]tokens.Token{
+				{Type: tokens.TokenWith, Literal: "with", Pos: 0},
+				{Type: tokens.TokenIdentifier, Literal: "t", Pos: 5},
+				{Type: tokens.TokenAs, Literal: "as", Pos: 7},
+				{Type: tokens.TokenLeftParen, Literal: "(", Pos: 10},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 11},
+				{Type: tokens.TokenNumericLiteral, Literal: "1", Pos: 18},
+				{Type: tokens.TokenRightParen, Literal: ")", Pos: 19},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 20},
+			},
+		},
+		{
+			"cast and concatenation operators",
+			"a::int || b",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "a", Pos: 0},
+				{Type: tokens.TokenDoubleColon, Literal: "::", Pos: 1},
+				{Type: tokens.TokenIdentifier, Literal: "int", Pos: 3},
+				{Type: tokens.TokenConcat, Literal: "||", Pos: 7},
+				{Type: tokens.TokenIdentifier, Literal: "b", Pos: 10},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 11},
+			},
+		},
+		{
+			"array brackets",
+			"int[3]",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "int", Pos: 0},
+				{Type: tokens.TokenLeftBracket, Literal: "[", Pos: 3},
+				{Type: tokens.TokenNumericLiteral, Literal: "3", Pos: 4},
+				{Type: tokens.TokenRightBracket, Literal: "]", Pos: 5},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 6},
+			},
+		},
+		{
+			"upsert keywords",
+			"on conflict on constraint c do update returning",
+			[]tokens.Token{
+				{Type: tokens.TokenOn, Literal: "on", Pos: 0},
+				{Type: tokens.TokenIdentifier, Literal: "conflict", Pos: 3},
+				{Type: tokens.TokenOn, Literal: "on", Pos: 12},
+				{Type: tokens.TokenConstraint, Literal: "constraint", Pos: 15},
+				{Type: tokens.TokenIdentifier, Literal: "c", Pos: 26},
+				{Type: tokens.TokenIdentifier, Literal: "do", Pos: 28},
+				{Type: tokens.TokenUpdate, Literal: "update", Pos: 31},
+				{Type: tokens.TokenReturning, Literal: "returning", Pos: 38},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 47},
+			},
+		},
+		{
+			"delete and truncate keywords",
+			"delete truncate table",
+			[]tokens.Token{
+				{Type: tokens.TokenDelete, Literal: "delete", Pos: 0},
+				{Type: tokens.TokenTruncate, Literal: "truncate", Pos: 7},
+				{Type: tokens.TokenTable, Literal: "table", Pos: 16},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 21},
+			},
+		},
+		{
+			"constraint keywords and overlap operator",
+			"create primary unique check references foreign &&",
+			[]tokens.Token{
+				{Type: tokens.TokenCreate, Literal: "create", Pos: 0},
+				{Type: tokens.TokenPrimary, Literal: "primary", Pos: 7},
+				{Type: tokens.TokenUnique, Literal: "unique", Pos: 15},
+				{Type: tokens.TokenCheck, Literal: "check", Pos: 22},
+				{Type: tokens.TokenReferences, Literal: "references", Pos: 28},
+				{Type: tokens.TokenForeign, Literal: "foreign", Pos: 39},
+				{Type: tokens.TokenSymbol, Literal: "&&", Pos: 47},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 49},
+			},
+		},
+		{
+			"alter and drop keywords",
+			"alter table t drop c",
+			[]tokens.Token{
+				{Type: tokens.TokenAlter, Literal: "alter", Pos: 0},
+				{Type: tokens.TokenTable, Literal: "table", Pos: 6},
+				{Type: tokens.TokenIdentifier, Literal: "t", Pos: 12},
+				{Type: tokens.TokenDrop, Literal: "drop", Pos: 14},
+				{Type: tokens.TokenIdentifier, Literal: "c", Pos: 19},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 20},
+			},
+		},
+		{
+			"dollar-quoted strings",
+			"$$it's $x$$ $fn$a $$ b$fn$",
+			[]tokens.Token{
+				{Type: tokens.TokenStringLiteral, Literal: "$$it's $x$$", Pos: 0},
+				{Type: tokens.TokenStringLiteral, Literal: "$fn$a $$ b$fn$", Pos: 12},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 26},
+			},
+		},
+		{
+			"unterminated dollar-quoted string",
+			"$body$ no end",
+			[]tokens.Token{
+				{Type: tokens.TokenError, Literal: "$body$ no end", Pos: 0},
+			},
+		},
+		{
+			"block comments",
+			"select /* one\n/* nested */ line */ 1 /**/",
+			[]tokens.Token{
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 0},
+				{Type: tokens.TokenComment, Literal: "/* one\n/* nested */ line */", Pos: 7},
+				{Type: tokens.TokenNumericLiteral, Literal: "1", Pos: 35},
+				{Type: tokens.TokenComment, Literal: "/**/", Pos: 37},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 41},
+			},
+		},
+		{
+			"unterminated block comment",
+			"/* no /* end */",
+			[]tokens.Token{
+				{Type: tokens.TokenError, Literal: "/* no /* end */", Pos: 0},
+			},
+		},
+		{
+			"positional parameters",
+			"$1 + $12",
+			[]tokens.Token{
+				{Type: tokens.TokenParameter, Literal: "$1", Pos: 0},
+				{Type: tokens.TokenPlus, Literal: "+", Pos: 3},
+				{Type: tokens.TokenParameter, Literal: "$12", Pos: 5},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 8},
+			},
+		},
+		{
+			"copy from stdin data",
+			"COPY t (a) FROM stdin; -- rows follow\n1\tx\n2\t'y\n\\.\nselect",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "COPY", Pos: 0},
+				{Type: tokens.TokenIdentifier, Literal: "t", Pos: 5},
+				{Type: tokens.TokenLeftParen, Literal: "(", Pos: 7},
+				{Type: tokens.TokenIdentifier, Literal: "a", Pos: 8},
+				{Type: tokens.TokenRightParen, Literal: ")", Pos: 9},
+				{Type: tokens.TokenFrom, Literal: "FROM", Pos: 11},
+				{Type: tokens.TokenIdentifier, Literal: "stdin", Pos: 16},
+				{Type: tokens.TokenSemicolon, Literal: ";", Pos: 21},
+				{Type: tokens.TokenCopyData, Literal: "1\tx\n2\t'y\n", Pos: 38},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 50},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 56},
+			},
+		},
+		{
+			"copy data until eof",
+			"copy t from stdin;\n1\n2",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "copy", Pos: 0},
+				{Type: tokens.TokenIdentifier, Literal: "t", Pos: 5},
+				{Type: tokens.TokenFrom, Literal: "from", Pos: 7},
+				{Type: tokens.TokenIdentifier, Literal: "stdin", Pos: 12},
+				{Type: tokens.TokenSemicolon, Literal: ";", Pos: 17},
+				{Type: tokens.TokenCopyData, Literal: "1\n2", Pos: 19},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 22},
+			},
+		},
+		{
+			"copy query reading from a table named stdin",
+			"copy (select a from stdin) to stdout;",
+			[]tokens.Token{
+				{Type: tokens.TokenIdentifier, Literal: "copy", Pos: 0},
+				{Type: tokens.TokenLeftParen, Literal: "(", Pos: 5},
+				{Type: tokens.TokenSelect, Literal: "select", Pos: 6},
+				{Type: tokens.TokenIdentifier, Literal: "a", Pos: 13},
+				{Type: tokens.TokenFrom, Literal: "from", Pos: 15},
+				{Type: tokens.TokenIdentifier, Literal: "stdin", Pos: 20},
+				{Type: tokens.TokenRightParen, Literal: ")", Pos: 25},
+				{Type: tokens.TokenTo, Literal: "to", Pos: 27},
+				{Type: tokens.TokenIdentifier, Literal: "stdout", Pos: 30},
+				{Type: tokens.TokenSemicolon, Literal: ";", Pos: 36},
+				{Type: tokens.TokenEOF, Literal: "", Pos: 37},
 			},
 		},
 	}
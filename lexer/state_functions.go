@@ -15,6 +15,10 @@ func lexText(l *Lexer) stateFn {
 		switch {
 		case l.peek() == '-' && l.peekAhead(1) == '-':
 			return lexComment
+		case l.peek() == '/' && l.peekAhead(1) == '*':
+			return lexBlockComment
+		case isStringPrefix(l.peek()) && l.peekAhead(1) == '\'': // Handle E'...', X'...', B'...' and N'...'
+			return lexPrefixedString
 		case isLetter(l.peek()):
 			return lexIdentifier
 		case isDigit(l.peek()):
@@ -23,6 +27,14 @@ func lexText(l *Lexer) stateFn {
 			return lexWhitespace
 		case l.peek() == '\'': // Handle string literals
 			return lexString
+		case l.peek() == '"' || l.peek() == '`': // Handle quoted identifiers
+			return lexQuotedIdentifier
+		case l.peek() == '$' && dollarQuoteTag(l) != "": // Handle PostgreSQL dollar-quoted strings
+			return lexDollarQuotedString
+		case l.peek() == '$' && isDigit(l.peekAhead(1)): // Handle positional parameters
+			return lexParameter
+		case l.peek() == '@' && isLetter(l.peekAhead(1)): // Handle MySQL user variables
+			return lexVariable
 		case l.peek() == eof:
 			l.emit(tokens.TokenEOF)
 			return nil
@@ -49,19 +61,44 @@ func lexIdentifier(l *Lexer) stateFn {
 	return lexText
 }
 
-// lexNumeric scans a numeric identifier.
+// lexNumeric scans a numeric literal: an integer, a decimal with an optional
+// exponent such as 1.5e3, or a hexadecimal integer such as 0x1F. A number
+// directly followed by a letter is an error rather than a number and an alias.
 func lexNumeric(l *Lexer) stateFn {
-	seenDecimal := false
-	for {
-		r := l.peek()
-		if isDigit(r) {
-			l.next()
-		} else if r == '.' && !seenDecimal {
-			seenDecimal = true
+	if l.peek() == '0' && (l.peekAhead(1) == 'x' || l.peekAhead(1) == 'X') && isHexDigit(l.peekAhead(2)) {
+		l.position += 2 // Skip "0x"
+		for isHexDigit(l.peek()) {
 			l.next()
-		} else {
-			break
 		}
+	} else {
+		seenDecimal := false
+		for {
+			r := l.peek()
+			if isDigit(r) {
+				l.next()
+			} else if r == '.' && !seenDecimal {
+				seenDecimal = true
+				l.next()
+			} else {
+				break
+			}
+		}
+		if r := l.peek(); r == 'e' || r == 'E' {
+			digits := 1
+			if sign := l.peekAhead(1); sign == '+' || sign == '-' {
+				digits = 2
+			}
+			if isDigit(l.peekAhead(digits)) {
+				l.position += digits // Skip the exponent marker and sign
+				for isDigit(l.peek()) {
+					l.next()
+				}
+			}
+		}
+	}
+	if isLetter(l.peek()) {
+		l.emit(tokens.TokenError)
+		return nil
 	}
 	num := l.input[l.start:l.position]
 	l.emitToken(tokens.TokenNumericLiteral, num)
@@ -109,6 +146,9 @@ func lexSymbol(l *Lexer) stateFn {
 			l.next() // Now actually consume the characters.
 		}
 		l.emitToken(matchType, longestMatch)
+		if matchType == tokens.TokenSemicolon && l.endsCopyFromStdin() {
+			return lexCopyData
+		}
 		return lexText // Return to the main lexer loop.
 	}
 
@@ -131,6 +171,29 @@ func lexComment(l *Lexer) stateFn {
 	return lexText
 }
 
+// lexBlockComment scans a comment enclosed in /* and */, which may span lines.
+// Comments nest as they do in PostgreSQL, so /* a /* b */ c */ is a single comment.
+func lexBlockComment(l *Lexer) stateFn {
+	l.position += 2 // Skip "/*"
+	for depth := 1; depth > 0; {
+		switch {
+		case l.position >= len(l.input):
+			l.emit(tokens.TokenError)
+			return nil
+		case strings.HasPrefix(l.input[l.position:], "/*"):
+			depth++
+			l.position += 2
+		case strings.HasPrefix(l.input[l.position:], "*/"):
+			depth--
+			l.position += 2
+		default:
+			l.next()
+		}
+	}
+	l.emit(tokens.TokenComment)
+	return lexText
+}
+
 // lexString scans a string literal enclosed in single quotes.
 func lexString(l *Lexer) stateFn {
 	l.next() // Skip the initial single quote
@@ -155,3 +218,160 @@ func lexString(l *Lexer) stateFn {
 		}
 	}
 }
+
+// lexPrefixedString scans a string literal preceded by a prefix letter: an
+// escape string E'...', in which a backslash escapes the next character, a
+// hexadecimal X'...' or bit B'...' string, or a national N'...' string.
+func lexPrefixedString(l *Lexer) stateFn {
+	prefix := l.next()
+	if prefix != 'e' && prefix != 'E' {
+		return lexString
+	}
+	l.next() // Skip the initial single quote
+	for {
+		switch r := l.next(); {
+		case r == eof:
+			// EOF before closing quote
+			l.emit(tokens.TokenError)
+			return nil
+		case r == '\\' && l.peek() != eof:
+			l.next() // Escaped character
+		case r == '\'' && l.peek() == '\'':
+			l.next() // Escaped quote
+		case r == '\'':
+			l.emit(tokens.TokenStringLiteral)
+			return lexText
+		}
+	}
+}
+
+// lexQuotedIdentifier scans an identifier enclosed in double quotes or backticks.
+// A doubled closing quote inside the identifier is an escaped quote.
+func lexQuotedIdentifier(l *Lexer) stateFn {
+	quote := l.next() // Consume the opening quote
+	for {
+		switch r := l.next(); {
+		case r == eof:
+			// EOF before closing quote
+			l.emit(tokens.TokenError)
+			return nil
+		case r == quote && l.peek() == quote:
+			l.next() // Escaped quote
+		case r == quote:
+			l.emit(tokens.TokenIdentifier)
+			return lexText
+		}
+	}
+}
+
+// dollarQuoteTag returns the opening delimiter of a dollar-quoted string, such as
+// $$ or $body$, if one starts at the current position, or "" otherwise.
+func dollarQuoteTag(l *Lexer) string {
+	rest := l.input[l.position:]
+	for i, r := range rest[1:] {
+		switch {
+		case r == '$':
+			return rest[:i+2]
+		case isLetter(r) || (i > 0 && isDigit(r)):
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// lexDollarQuotedString scans a string enclosed in matching dollar-quote
+// delimiters, such as a function body written as $$ ... $$ or $fn$ ... $fn$.
+func lexDollarQuotedString(l *Lexer) stateFn {
+	tag := dollarQuoteTag(l)
+	end := strings.Index(l.input[l.position+len(tag):], tag)
+	if end < 0 {
+		// EOF before the closing delimiter
+		l.position = len(l.input)
+		l.emit(tokens.TokenError)
+		return nil
+	}
+	l.position += len(tag) + end + len(tag)
+	l.emit(tokens.TokenStringLiteral)
+	return lexText
+}
+
+// lexParameter scans a positional parameter such as $1.
+func lexParameter(l *Lexer) stateFn {
+	l.next() // Consume the dollar sign
+	for isDigit(l.peek()) {
+		l.next()
+	}
+	l.emit(tokens.TokenParameter)
+	return lexText
+}
+
+// lexVariable scans a MySQL user variable such as @total.
+func lexVariable(l *Lexer) stateFn {
+	l.next() // Consume the at sign
+	for isLetter(l.peek()) || isDigit(l.peek()) {
+		l.next()
+	}
+	l.emit(tokens.TokenVariable)
+	return lexText
+}
+
+// endsCopyFromStdin reports whether the statement terminated by the semicolon
+// just emitted is a COPY ... FROM STDIN, whose data rows follow on the next line.
+func (l *Lexer) endsCopyFromStdin() bool {
+	start := len(l.tokens) - 1
+	for start > 0 && l.tokens[start-1].Type != tokens.TokenSemicolon && l.tokens[start-1].Type != tokens.TokenCopyData {
+		start--
+	}
+	statement := l.tokens[start : len(l.tokens)-1]
+	for len(statement) > 0 && statement[0].Type == tokens.TokenComment {
+		statement = statement[1:]
+	}
+	if len(statement) == 0 || !strings.EqualFold(statement[0].Literal, "COPY") {
+		return false
+	}
+	depth := 0
+	for i, token := range statement {
+		switch {
+		case token.Type == tokens.TokenLeftParen:
+			depth++
+		case token.Type == tokens.TokenRightParen:
+			depth--
+		case token.Type == tokens.TokenFrom && depth == 0 && i+1 < len(statement):
+			next := statement[i+1]
+			return next.Type == tokens.TokenIdentifier && strings.EqualFold(next.Literal, "STDIN")
+		}
+	}
+	return false
+}
+
+// lexCopyData scans the data rows that follow COPY ... FROM STDIN, starting on the
+// line after the statement and ending at a line consisting of \. or at EOF.
+// The rows are emitted as a single TokenCopyData and the terminator is skipped.
+func lexCopyData(l *Lexer) stateFn {
+	// Skip the remainder of the line holding the COPY statement.
+	if newline := strings.IndexByte(l.input[l.position:], '\n'); newline >= 0 {
+		l.position += newline + 1
+	} else {
+		l.position = len(l.input)
+	}
+	l.ignore()
+	for l.position < len(l.input) {
+		line := l.input[l.position:]
+		end := strings.IndexByte(line, '\n')
+		if end < 0 {
+			end = len(line)
+		} else {
+			line = line[:end]
+		}
+		if strings.TrimSuffix(line, "\r") == `\.` {
+			l.emit(tokens.TokenCopyData)
+			l.position = min(l.position+end+1, len(l.input))
+			l.ignore()
+			return lexText
+		}
+		l.position = min(l.position+end+1, len(l.input))
+	}
+	l.emit(tokens.TokenCopyData)
+	return lexText
+}
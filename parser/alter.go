@@ -0,0 +1,25 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseAlter parses an ALTER statement, dispatching on the kind of object altered.
+func (p *Parser) parseAlter() (Statement, error) {
+	p.pos++ // Skip the ALTER token
+	switch {
+	case p.peek().Type == tokens.TokenTable:
+		return p.parseAlterTable()
+	case p.peekWord("SEQUENCE"):
+		return p.parseAlterSequence()
+	case p.peekWord("ROLE") || p.peekWord("USER"):
+		return p.parseAlterRole()
+	case p.peekWord("TYPE"):
+		return p.parseAlterType()
+	case p.peek().Type == tokens.TokenDefault:
+		return p.parseAlterDefaultPrivileges()
+	}
+	return nil, fmt.Errorf("unsupported ALTER statement: %s, at position %d", p.peek().Literal, p.pos)
+}
@@ -0,0 +1,327 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseAlterTable parses ALTER TABLE [IF EXISTS] [ONLY] name action [, ...].
+func (p *Parser) parseAlterTable() (*AlterTableStatement, error) {
+	p.pos++ // Skip the TABLE token
+	stmt := &AlterTableStatement{IfExists: p.parseIfExists(), Only: p.consumeWord("ONLY")}
+	var err error
+	stmt.Name, err = p.parseQualifiedName("table name")
+	if err != nil {
+		return nil, err
+	}
+	for {
+		action, err := p.parseAlterTableAction()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ALTER TABLE %s: %w", stmt.Name, err)
+		}
+		stmt.Actions = append(stmt.Actions, action)
+
+		if !p.consume(tokens.TokenComma) {
+			return stmt, nil
+		}
+	}
+}
+
+// parseAlterTableAction parses a single action of ALTER TABLE.
+func (p *Parser) parseAlterTableAction() (*AlterTableAction, error) {
+	pos := p.peek().Pos
+	action := &AlterTableAction{}
+	var err error
+	switch {
+	case p.consumeWord("ADD"):
+		err = p.parseAlterAdd(action)
+	case p.consume(tokens.TokenDrop):
+		err = p.parseAlterDrop(action)
+	case p.consume(tokens.TokenAlter):
+		p.consumeWord("COLUMN")
+		err = p.parseAlterColumn(action)
+	case p.consumeWord("RENAME"):
+		err = p.parseAlterRename(action)
+	case p.consumeWord("VALIDATE"):
+		action.Kind = AlterValidateConstraint
+		if _, err := p.expect(tokens.TokenConstraint, "CONSTRAINT after VALIDATE"); err != nil {
+			return nil, err
+		}
+		action.Name, err = p.parseIdentifier("constraint name")
+	case p.consume(tokens.TokenSet):
+		action.Kind = AlterSetSchema
+		if err := p.expectWord("SCHEMA"); err != nil {
+			return nil, err
+		}
+		action.NewName, err = p.parseIdentifier("schema name")
+	case p.consumeWord("OWNER"):
+		action.Kind = AlterOwnerTo
+		if _, err := p.expect(tokens.TokenTo, "TO after OWNER"); err != nil {
+			return nil, err
+		}
+		action.NewName, err = p.parseIdentifier("role name")
+	case p.consumeWord("ATTACH"):
+		action.Kind = AlterAttachPartition
+		if err := p.expectWord("PARTITION"); err != nil {
+			return nil, err
+		}
+		action.Name, err = p.parseQualifiedName("partition name")
+		if err != nil {
+			return nil, err
+		}
+		action.Bound, err = p.parsePartitionBound()
+	case p.consumeWord("DETACH"):
+		action.Kind = AlterDetachPartition
+		if err := p.expectWord("PARTITION"); err != nil {
+			return nil, err
+		}
+		action.Name, err = p.parseQualifiedName("partition name")
+		action.Concurrent = p.consumeWord("CONCURRENTLY")
+		action.Finalize = !action.Concurrent && p.consumeWord("FINALIZE")
+	case p.consumeWord("MODIFY"):
+		action.Kind = AlterModifyColumn
+		p.consumeWord("COLUMN")
+		err = p.parseAlterColumnDefinition(action)
+	case p.consumeWord("CHANGE"):
+		action.Kind = AlterChangeColumn
+		p.consumeWord("COLUMN")
+		action.Name, err = p.parseIdentifier("column name")
+		if err != nil {
+			return nil, err
+		}
+		err = p.parseAlterColumnDefinition(action)
+	default:
+		return nil, fmt.Errorf("expected ALTER TABLE action, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, action), nil
+}
+
+// parseAlterAdd parses ADD [COLUMN] [IF NOT EXISTS] definition, ADD table_constraint [NOT VALID]
+// or MySQL's ADD {INDEX | KEY} [name] (columns).
+func (p *Parser) parseAlterAdd(action *AlterTableAction) error {
+	var err error
+	if p.peekIndexDefinition() {
+		action.Kind = AlterAddIndex
+		action.Index, err = p.parseIndexDefinition()
+		return err
+	}
+	if p.peekTableConstraint() {
+		action.Kind = AlterAddConstraint
+		action.Constraint, err = p.parseTableConstraint()
+		if err != nil {
+			return err
+		}
+		if p.peek().Type == tokens.TokenNot && strings.EqualFold(p.peekAhead(1).Literal, "VALID") {
+			p.pos += 2 // Skip NOT VALID
+			action.NotValid = true
+		}
+		return nil
+	}
+	action.Kind = AlterAddColumn
+	p.consumeWord("COLUMN")
+	action.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return err
+	}
+	return p.parseAlterColumnDefinition(action)
+}
+
+// parseAlterColumnDefinition parses a column definition followed by MySQL's optional FIRST or AFTER column.
+func (p *Parser) parseAlterColumnDefinition(action *AlterTableAction) error {
+	column, err := p.parseColumnDefinition()
+	if err != nil {
+		return err
+	}
+	if column.Type == nil {
+		return fmt.Errorf("missing data type for column %s", column.Name)
+	}
+	action.Column = column
+	switch {
+	case p.consumeWord("FIRST"):
+		action.First = true
+	case p.consumeWord("AFTER"):
+		after, err := p.parseIdentifier("column name after AFTER")
+		if err != nil {
+			return err
+		}
+		action.After = &after
+	}
+	return nil
+}
+
+// parseAlterDrop parses DROP CONSTRAINT [IF EXISTS] name or DROP [COLUMN] [IF EXISTS] name,
+// each followed by an optional CASCADE or RESTRICT.
+func (p *Parser) parseAlterDrop(action *AlterTableAction) error {
+	if p.consume(tokens.TokenConstraint) {
+		action.Kind = AlterDropConstraint
+	} else {
+		action.Kind = AlterDropColumn
+		p.consumeWord("COLUMN")
+	}
+	action.IfExists = p.parseIfExists()
+	var err error
+	action.Name, err = p.parseIdentifier("name to drop")
+	if err != nil {
+		return err
+	}
+	action.Behavior = p.parseDropBehavior()
+	return nil
+}
+
+// parseAlterColumn parses the column name and change of ALTER [COLUMN]. The ALTER [COLUMN] tokens have already been consumed.
+func (p *Parser) parseAlterColumn(action *AlterTableAction) error {
+	var err error
+	action.Name, err = p.parseIdentifier("column name")
+	if err != nil {
+		return err
+	}
+	switch {
+	case p.consume(tokens.TokenSet):
+		switch {
+		case p.consume(tokens.TokenDefault):
+			action.Kind = AlterColumnSetDefault
+			action.Default, err = p.parseExpression()
+			return err
+		case p.consume(tokens.TokenNot):
+			action.Kind = AlterColumnSetNotNull
+			_, err = p.expect(tokens.TokenNull, "NULL after SET NOT")
+			return err
+		case p.consumeWord("DATA"):
+			if err := p.expectWord("TYPE"); err != nil {
+				return err
+			}
+			return p.parseAlterColumnType(action)
+		}
+	case p.consume(tokens.TokenDrop):
+		switch {
+		case p.consume(tokens.TokenDefault):
+			action.Kind = AlterColumnDropDefault
+			return nil
+		case p.consume(tokens.TokenNot):
+			action.Kind = AlterColumnDropNotNull
+			_, err = p.expect(tokens.TokenNull, "NULL after DROP NOT")
+			return err
+		}
+	case p.consumeWord("TYPE"):
+		return p.parseAlterColumnType(action)
+	}
+	return fmt.Errorf("expected TYPE, SET or DROP after ALTER COLUMN %s, found %q at position %d", action.Name, p.peek().Literal, p.pos)
+}
+
+// parseAlterColumnType parses the new type of ALTER COLUMN ... TYPE and its optional USING expression.
+func (p *Parser) parseAlterColumnType(action *AlterTableAction) error {
+	action.Kind = AlterColumnType
+	var err error
+	action.Type, err = p.parseDataType()
+	if err != nil {
+		return err
+	}
+	if p.consume(tokens.TokenUsing) {
+		action.Using, err = p.parseExpression()
+		if err != nil {
+			return fmt.Errorf("error parsing USING expression: %w", err)
+		}
+	}
+	return nil
+}
+
+// parseAlterRename parses RENAME TO name, RENAME CONSTRAINT a TO b and RENAME [COLUMN] a TO b.
+func (p *Parser) parseAlterRename(action *AlterTableAction) error {
+	var err error
+	switch {
+	case p.peek().Type == tokens.TokenTo:
+		action.Kind = AlterRenameTable
+	case p.consume(tokens.TokenConstraint):
+		action.Kind = AlterRenameConstraint
+		action.Name, err = p.parseIdentifier("constraint name")
+	default:
+		action.Kind = AlterRenameColumn
+		p.consumeWord("COLUMN")
+		action.Name, err = p.parseIdentifier("column name")
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := p.expect(tokens.TokenTo, "TO after RENAME"); err != nil {
+		return err
+	}
+	action.NewName, err = p.parseIdentifier("new name")
+	return err
+}
+
+// parsePartitionBound parses DEFAULT or FOR VALUES {FROM (...) TO (...) | IN (...) | WITH (MODULUS m, REMAINDER r)}.
+func (p *Parser) parsePartitionBound() (*PartitionBound, error) {
+	pos := p.peek().Pos
+	if p.consume(tokens.TokenDefault) {
+		return finishNode(p, pos, &PartitionBound{Default: true}), nil
+	}
+	if _, err := p.expect(tokens.TokenFor, "FOR VALUES or DEFAULT after partition name"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenValues, "VALUES after FOR"); err != nil {
+		return nil, err
+	}
+	bound := &PartitionBound{}
+	var err error
+	switch {
+	case p.consume(tokens.TokenFrom):
+		bound.From, err = p.parseParenthesizedExpressions("FROM")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenTo, "TO after partition lower bound"); err != nil {
+			return nil, err
+		}
+		bound.To, err = p.parseParenthesizedExpressions("TO")
+	case p.consume(tokens.TokenIn):
+		bound.In, err = p.parseParenthesizedExpressions("IN")
+	case p.consume(tokens.TokenWith):
+		if _, err := p.expect(tokens.TokenLeftParen, "( after WITH"); err != nil {
+			return nil, err
+		}
+		if err := p.expectWord("MODULUS"); err != nil {
+			return nil, err
+		}
+		bound.Modulus, err = p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenComma, ", after MODULUS"); err != nil {
+			return nil, err
+		}
+		if err := p.expectWord("REMAINDER"); err != nil {
+			return nil, err
+		}
+		bound.Remainder, err = p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		_, err = p.expect(tokens.TokenRightParen, ") after REMAINDER")
+	default:
+		return nil, fmt.Errorf("expected FROM, IN or WITH after FOR VALUES, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, bound), nil
+}
+
+// parseParenthesizedExpressions parses a parenthesized, comma-separated list of expressions, as required after keyword.
+func (p *Parser) parseParenthesizedExpressions(keyword string) ([]Expression, error) {
+	if _, err := p.expect(tokens.TokenLeftParen, "( after "+keyword); err != nil {
+		return nil, err
+	}
+	expressions, err := p.parseExpressions()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after "+keyword+" list"); err != nil {
+		return nil, err
+	}
+	return expressions, nil
+}
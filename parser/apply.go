@@ -0,0 +1,257 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// An ApplyFunc is invoked by Apply for each node n, even if n is nil,
+// before and/or after the node's children, using a Cursor describing
+// the current node and providing operations on it. n is nil for a field
+// that may hold a node but holds none, such as the WHERE condition of a
+// statement without one; Cursor.Replace fills such a field in.
+//
+// The return value of ApplyFunc controls the syntax tree traversal.
+// See Apply for details.
+type ApplyFunc func(*Cursor) bool
+
+// Apply traverses a syntax tree recursively, starting with root,
+// and calling pre and post for each node as described below.
+// Apply returns the syntax tree, possibly modified.
+//
+// If pre is not nil, it is called for each node before the node's
+// children are traversed (pre-order). If pre returns false, no
+// children are traversed, and post is not called for that node.
+//
+// If post is not nil, and a prior call of pre didn't return false,
+// post is called for each node after its children are traversed
+// (post-order). If post returns false, traversal is terminated and
+// Apply returns immediately.
+//
+// Children are traversed in the order of the fields that hold them, which
+// follows the source order, including the fields that hold no node. Only
+// nodes are traversed: names, options and other plain values are left to the
+// callbacks of the node holding them, and empty lists to those of their parent.
+// Nodes inserted or replaced by the callbacks keep whatever span they carry,
+// so Pos and End are only meaningful for nodes that came from the parser.
+func Apply(root Node, pre, post ApplyFunc) (result Node) {
+	parent := &struct{ Node }{root}
+	defer func() {
+		if r := recover(); r != nil && r != abort {
+			panic(r)
+		}
+		result = parent.Node
+	}()
+	a := &application{pre: pre, post: post}
+	a.apply(nil, "Node", reflect.ValueOf(parent).Elem().Field(0), nil, root)
+	return
+}
+
+var abort = new(int) // singleton, to signal termination of Apply
+
+// A Cursor describes a node encountered during Apply.
+// Information about the node and its parent is available
+// from the Node, Parent, Name, and Index methods.
+//
+// If p is a variable of type and value of the current parent node
+// c.Parent(), and f is the field identifier with name c.Name(),
+// the following invariants hold:
+//
+//	p.f            == c.Node()  if c.Index() <  0
+//	p.f[c.Index()] == c.Node()  if c.Index() >= 0
+//
+// The methods Replace, Delete, InsertBefore, and InsertAfter
+// can be used to change the AST without disrupting Apply.
+type Cursor struct {
+	parent Node
+	name   string
+	field  reflect.Value // the field holding the node, or the list containing it
+	iter   *iterator     // valid if the node is in a list
+	node   Node
+}
+
+// Node returns the current Node.
+func (c *Cursor) Node() Node { return c.node }
+
+// Parent returns the parent of the current Node, or nil for the root.
+func (c *Cursor) Parent() Node { return c.parent }
+
+// Name returns the name of the parent Node field that contains the current Node.
+// If the parent holds a list of lists, such as the rows of VALUES, the name
+// includes the index of the inner list, as in "Values[1]".
+func (c *Cursor) Name() string { return c.name }
+
+// Index reports the index >= 0 of the current Node in the list of nodes that
+// contains it, or a value < 0 if the current Node is not part of a list.
+func (c *Cursor) Index() int {
+	if c.iter != nil {
+		return c.iter.index
+	}
+	return -1
+}
+
+// Replace replaces the current Node with n. A nil n clears a field that is
+// not part of a list, such as the WHERE condition of a statement. Replace
+// panics if n cannot be stored in the field holding the current Node.
+func (c *Cursor) Replace(n Node) {
+	if i := c.Index(); i >= 0 {
+		c.field.Index(i).Set(c.value(n))
+	} else {
+		c.field.Set(c.value(n))
+	}
+	c.node = n
+}
+
+// Delete deletes the current Node from its containing list.
+// If the current Node is not part of a list, Delete panics.
+func (c *Cursor) Delete() {
+	i := c.Index()
+	if i < 0 {
+		panic("Delete node not contained in list")
+	}
+	v := c.field
+	l := v.Len()
+	reflect.Copy(v.Slice(i, l), v.Slice(i+1, l))
+	v.Index(l - 1).Set(reflect.Zero(v.Type().Elem()))
+	v.SetLen(l - 1)
+	c.iter.step--
+}
+
+// InsertAfter inserts n after the current Node in its containing list.
+// If the current Node is not part of a list, InsertAfter panics.
+// Apply does not walk n.
+func (c *Cursor) InsertAfter(n Node) {
+	i := c.Index()
+	if i < 0 {
+		panic("InsertAfter node not contained in list")
+	}
+	v := c.field
+	value := c.value(n)
+	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	l := v.Len()
+	reflect.Copy(v.Slice(i+2, l), v.Slice(i+1, l))
+	v.Index(i + 1).Set(value)
+	c.iter.step++
+}
+
+// InsertBefore inserts n before the current Node in its containing list.
+// If the current Node is not part of a list, InsertBefore panics.
+// Apply will not walk n.
+func (c *Cursor) InsertBefore(n Node) {
+	i := c.Index()
+	if i < 0 {
+		panic("InsertBefore node not contained in list")
+	}
+	v := c.field
+	value := c.value(n)
+	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	l := v.Len()
+	reflect.Copy(v.Slice(i+1, l), v.Slice(i, l))
+	v.Index(i).Set(value)
+	c.iter.index++
+}
+
+// value converts n to a value that can be stored in the current field or list element.
+func (c *Cursor) value(n Node) reflect.Value {
+	t := c.field.Type()
+	if c.iter != nil {
+		t = t.Elem()
+	}
+	if n == nil {
+		return reflect.Zero(t)
+	}
+	value := reflect.ValueOf(n)
+	if !value.Type().AssignableTo(t) {
+		panic(fmt.Sprintf("cannot use %T as %s in field %s", n, t, c.name))
+	}
+	return value
+}
+
+// application carries the state of a single call of Apply.
+type application struct {
+	pre, post ApplyFunc
+	cursor    Cursor
+	iter      iterator
+}
+
+// iterator tracks the position in a list while its elements are inserted or deleted.
+type iterator struct {
+	index, step int
+}
+
+var nodeType = reflect.TypeOf((*Node)(nil)).Elem()
+
+func (a *application) apply(parent Node, name string, field reflect.Value, iter *iterator, n Node) {
+	// avoid heap-allocating a new cursor for each apply call; reuse a.cursor instead
+	saved := a.cursor
+	a.cursor = Cursor{parent: parent, name: name, field: field, iter: iter, node: n}
+
+	if a.pre != nil && !a.pre(&a.cursor) {
+		a.cursor = saved
+		return
+	}
+
+	if n = a.cursor.node; !isNilNode(n) {
+		a.applyChildren(n)
+	}
+
+	if a.post != nil && !a.post(&a.cursor) {
+		panic(abort)
+	}
+
+	a.cursor = saved
+}
+
+// applyChildren applies to each node held by the fields of n, which is a
+// pointer to one of the node structs.
+func (a *application) applyChildren(n Node) {
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		value := v.Field(i)
+		switch t := field.Type; {
+		case t.Implements(nodeType):
+			var child Node
+			if node, _ := value.Interface().(Node); !isNilNode(node) {
+				child = node
+			}
+			a.apply(n, field.Name, value, nil, child)
+		case t.Kind() == reflect.Slice && t.Elem().Implements(nodeType):
+			a.applyList(n, field.Name, value)
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Slice && t.Elem().Elem().Implements(nodeType):
+			for j := 0; j < value.Len(); j++ {
+				a.applyList(n, fmt.Sprintf("%s[%d]", field.Name, j), value.Index(j))
+			}
+		}
+	}
+}
+
+// applyList applies to each element of list, the settable value of a slice of nodes.
+func (a *application) applyList(parent Node, name string, list reflect.Value) {
+	// avoid heap-allocating a new iterator for each applyList call; reuse a.iter instead
+	saved := a.iter
+	a.iter.index = 0
+	for a.iter.index < list.Len() {
+		// element x may be nil in a bad AST or in a list with optional entries, such as ArrayBounds - be cautious
+		a.iter.step = 1
+		var x Node
+		if node, _ := list.Index(a.iter.index).Interface().(Node); !isNilNode(node) {
+			x = node
+		}
+		a.apply(parent, name, list, &a.iter, x)
+		a.iter.index += a.iter.step
+	}
+	a.iter = saved
+}
+
+// isNilNode reports whether n is nil or holds a nil pointer.
+func isNilNode(n Node) bool {
+	if n == nil {
+		return true
+	}
+	value := reflect.ValueOf(n)
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
@@ -0,0 +1,240 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+
+	"strings"
+	"testing"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// tenantFilter returns the predicate tenant_id = 42.
+func tenantFilter() Expression {
+	return &BinaryExpression{
+		Left:     &ColumnExpression{Name: "tenant_id"},
+		Operator: tokens.TokenEqual,
+		Right:    &NumericLiteral{Value: 42, Text: "42"},
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		pre   ApplyFunc
+		post  ApplyFunc
+		want  string
+	}{
+		{
+			name:  "inject a tenant predicate into WHERE",
+			input: "select a from t where b = 1 or c = 2",
+			pre: func(c *Cursor) bool {
+				if stmt, ok := c.Node().(*SelectStatement); ok {
+					if stmt.Where == nil {
+						stmt.Where = tenantFilter()
+					} else {
+						stmt.Where = &BinaryExpression{Left: tenantFilter(), Operator: tokens.TokenAnd, Right: stmt.Where}
+					}
+				}
+				return true
+			},
+			want: "select a from t where tenant_id = 42 and (b = 1 or c = 2)",
+		},
+		{
+			name:  "rename tables for shard routing",
+			input: "update orders o set total = 0 from customers c where o.customer = c.id",
+			pre: func(c *Cursor) bool {
+				if table, ok := c.Node().(*TableName); ok {
+					c.Replace(&TableName{Name: "shard_7." + table.Name, Alias: table.Alias})
+				}
+				return true
+			},
+			want: "update shard_7.orders o set total = 0 from shard_7.customers c where o.customer = c.id",
+		},
+		{
+			name:  "strip ORDER BY from a count query",
+			input: "select count(*) from t where a = 1 order by b desc, c",
+			pre: func(c *Cursor) bool {
+				if c.Name() == "OrderBy" {
+					c.Delete()
+				}
+				return true
+			},
+			want: "select count(*) from t where a = 1",
+		},
+		{
+			name:  "insert around a select item",
+			input: "select a, b from t",
+			pre: func(c *Cursor) bool {
+				if column, ok := c.Node().(*ColumnExpression); ok && column.Name == "a" {
+					c.InsertBefore(&ColumnExpression{Name: "first"})
+					c.InsertAfter(&ColumnExpression{Name: "second"})
+				}
+				return true
+			},
+			want: "select first, a, second, b from t",
+		},
+		{
+			name:  "delete from a row of values",
+			input: "insert into t values (1, 2, 3), (4, 5, 6)",
+			pre: func(c *Cursor) bool {
+				if number, ok := c.Node().(*NumericLiteral); ok && c.Name() == "Values[1]" && number.Value == 5 {
+
+					c.Delete()
+				}
+				return true
+			},
+			want: "insert into t values (1, 2, 3), (4, 6)",
+		},
+		{
+			name:  "fill in a missing WHERE",
+			input: "update t set a = 1",
+			pre: func(c *Cursor) bool {
+				if c.Name() == "Where" && c.Node() == nil {
+					c.Replace(tenantFilter())
+				}
+				return true
+			},
+			want: "update t set a = 1 where tenant_id = 42",
+		},
+		{
+			name:  "clear an optional field",
+			input: "delete from t where a = 1",
+			pre: func(c *Cursor) bool {
+				if c.Name() == "Where" {
+					c.Replace(nil)
+				}
+				return true
+			},
+			want: "delete from t",
+		},
+		{
+			name:  "replace the root",
+			input: "select 1",
+			post: func(c *Cursor) bool {
+				if c.Parent() == nil {
+					c.Replace(&SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 2, Text: "2"}}})
+				}
+				return true
+			},
+			want: "select 2",
+		},
+		{
+			name:  "replace after the children were rewritten",
+			input: "select -a, -(b + 1) from t",
+			post: func(c *Cursor) bool {
+				if unary, ok := c.Node().(*UnaryExpression); ok {
+					if column, ok := unary.Operand.(*ColumnExpression); ok {
+						c.Replace(&ColumnExpression{Name: "minus_" + column.Name})
+					}
+				}
+				return true
+			},
+			want: "select minus_a, -(b + 1) from t",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Apply(parseOne(t, tt.input), tt.pre, tt.post)
+			clearSpan(got)
+			want := parseOne(t, tt.want)
+			clearSpan(want)
+			if !reflect.DeepEqual(got.String(), want.String()) {
+				t.Errorf("Apply() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplyCursor(t *testing.T) {
+	stmt := parseOne(t, "select a, b from t where c order by d")
+	var got []string
+	Apply(stmt, func(c *Cursor) bool {
+		parent := "nil"
+		if c.Parent() != nil {
+			parent = strings.TrimPrefix(reflect.TypeOf(c.Parent()).String(), "*parser.")
+		}
+		cursor := fmt.Sprintf("%s.%s[%d]", parent, c.Name(), c.Index())
+		if c.Node() == nil {
+			cursor += " nil"
+		}
+		got = append(got, cursor)
+		return true
+	}, nil)
+	want := []string{
+		"nil.Node[-1]",
+		"SelectStatement.With[-1] nil",
+		"SelectStatement.Expressions[0]",
+		"SelectStatement.Expressions[1]",
+		"SelectStatement.From[0]",
+		"SelectStatement.Where[-1]",
+		"SelectStatement.Having[-1] nil",
+		"SelectStatement.OrderBy[0]",
+		"OrderByItem.Expression[-1]",
+		"SelectStatement.Limit[-1] nil",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cursors = %v, want %v", got, want)
+	}
+}
+
+func TestApplyStops(t *testing.T) {
+	stmt := parseOne(t, "select a, b, c from t")
+	var visited []string
+	result := Apply(stmt, nil, func(c *Cursor) bool {
+		if column, ok := c.Node().(*ColumnExpression); ok {
+			visited = append(visited, column.Name)
+			return column.Name != "b"
+		}
+		return true
+	})
+	if want := []string{"a", "b"}; !reflect.DeepEqual(visited, want) {
+		t.Errorf("visited = %v, want %v", visited, want)
+	}
+	if result != stmt {
+		t.Errorf("Apply() = %v, want the root %v", result, stmt)
+	}
+}
+
+func TestApplyPanics(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		pre   ApplyFunc
+	}{
+		{
+			name:  "replace with a node of the wrong type",
+			input: "delete from t where a",
+			pre: func(c *Cursor) bool {
+				if c.Name() == "Where" {
+					c.Replace(&OrderByItem{})
+				}
+				return true
+			},
+		},
+		{
+			name:  "delete outside a list",
+			input: "delete from t where a",
+			pre: func(c *Cursor) bool {
+				if c.Name() == "Where" {
+					c.Delete()
+				}
+				return true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Apply() did not panic")
+				}
+			}()
+			Apply(parseOne(t, tt.input), tt.pre, nil)
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package parser
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// copyOptionWords lists the legacy COPY options written as a bare word.
+var copyOptionWords = []string{"BINARY", "CSV", "HEADER", "FREEZE"}
+
+// copyOptionValues lists the legacy COPY options followed by [AS] and a string.
+var copyOptionValues = []string{"DELIMITER", "NULL", "QUOTE", "ESCAPE", "ENCODING"}
+
+// parseCopy parses COPY {table [(column, ...)] | (query)} {FROM | TO} {'file' | PROGRAM 'command' | STDIN | STDOUT}
+// [[WITH] (option [value], ...) | legacy options] [WHERE condition].
+func (p *Parser) parseCopy() (*CopyStatement, error) {
+	p.pos++ // Skip COPY
+	stmt := &CopyStatement{}
+	var err error
+	if p.consume(tokens.TokenLeftParen) {
+		stmt.Query, err = p.parseQuery()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing COPY query: %w", err)
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after COPY query"); err != nil {
+			return nil, err
+		}
+	} else {
+		stmt.Table, err = p.parseQualifiedName("table name")
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().Type == tokens.TokenLeftParen {
+			stmt.Columns, err = p.parseIdentifierList()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	switch {
+	case p.consume(tokens.TokenFrom):
+		if stmt.Query != nil {
+			return nil, fmt.Errorf("expected TO after COPY query, found FROM at position %d", p.pos-1)
+		}
+	case p.consume(tokens.TokenTo):
+		stmt.To = true
+	default:
+		return nil, fmt.Errorf("expected FROM or TO, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	stdio := "STDIN"
+	if stmt.To {
+		stdio = "STDOUT"
+	}
+	if !p.consumeWord(stdio) {
+		stmt.Program = p.consumeWord("PROGRAM")
+		file, err := p.expect(tokens.TokenStringLiteral, "file name, PROGRAM or "+stdio)
+		if err != nil {
+			return nil, err
+		}
+		name := file.RawValue()
+		stmt.File = &name
+	}
+	stmt.Options, err = p.parseCopyOptions()
+	if err != nil {
+		return nil, err
+	}
+	if !stmt.To && p.consume(tokens.TokenWhere) {
+		stmt.Where, err = p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing WHERE clause: %w", err)
+		}
+	}
+	return stmt, nil
+}
+
+// parseCopyOptions parses the optional WITH followed by either a parenthesized option list
+// or the legacy options written without parentheses.
+func (p *Parser) parseCopyOptions() ([]*UtilityOption, error) {
+	p.consume(tokens.TokenWith)
+	if p.peek().Type == tokens.TokenLeftParen {
+		return p.parseCopyOptionList()
+	}
+	var options []*UtilityOption
+	for {
+		pos := p.peek().Pos
+		option := p.parseCopyOption()
+		if option == nil {
+			return options, nil
+		}
+		if !slices.Contains(copyOptionWords, option.Name) {
+			p.consume(tokens.TokenAs)
+			value, err := p.expect(tokens.TokenStringLiteral, option.Name+" string")
+			if err != nil {
+				return nil, err
+			}
+			literal := value.RawValue()
+			option.Value, option.Quoted = &literal, true
+		}
+		options = append(options, finishNode(p, pos, option))
+	}
+}
+
+// parseCopyOptionList parses the parenthesized options of COPY. Unlike the options of EXPLAIN
+// and VACUUM their names may be keywords, as in NULL ” and DEFAULT ”, and their values may be
+// a column list or *, as in FORCE_NULL (a) and FORCE_QUOTE *.
+func (p *Parser) parseCopyOptionList() ([]*UtilityOption, error) {
+	p.pos++ // Skip the opening parenthesis
+	var options []*UtilityOption
+	for {
+		name := p.next()
+		if !isWordToken(name) {
+			return nil, fmt.Errorf("expected option name, found %q at position %d", name.Literal, p.pos-1)
+		}
+		option := &UtilityOption{Name: strings.ToUpper(name.Literal)}
+		switch value := p.peek(); {
+		case value.Type == tokens.TokenLeftParen:
+			columns, err := p.parseIdentifierList()
+			if err != nil {
+				return nil, err
+			}
+			option.Columns = columns
+		case value.Type == tokens.TokenSymbol && value.Literal == "*":
+			p.pos++
+			option.AllColumns = true
+		case value.Type == tokens.TokenStringLiteral || value.Type == tokens.TokenNumericLiteral || isWordToken(value):
+			p.pos++
+			literal := value.RawValue()
+			option.Value = &literal
+			option.Quoted = value.Type == tokens.TokenStringLiteral
+		}
+		options = append(options, finishNode(p, name.Pos, option))
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after options"); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// isWordToken reports whether token is a bare word, either an identifier or a keyword.
+func isWordToken(token tokens.Token) bool {
+	if token.Literal == "" || token.Type == tokens.TokenStringLiteral {
+		return false
+	}
+	r := rune(token.Literal[0])
+	return r == '_' || unicode.IsLetter(r)
+}
+
+// parseCopyOption consumes the name of a legacy COPY option, returning nil if none is found.
+func (p *Parser) parseCopyOption() *UtilityOption {
+	for _, names := range [][]string{copyOptionWords, copyOptionValues} {
+		for _, name := range names {
+			if p.consumeKeywords(name) {
+				return &UtilityOption{Name: name}
+			}
+		}
+	}
+	return nil
+}
+
+// parseLoadData parses MySQL's LOAD DATA [LOCAL] INFILE 'file' [REPLACE | IGNORE] INTO TABLE table
+// [CHARACTER SET charset] [{FIELDS | COLUMNS} ...] [LINES ...] [IGNORE n {LINES | ROWS}]
+// [(column, ...)] [SET column = value, ...].
+func (p *Parser) parseLoadData() (*LoadDataStatement, error) {
+	p.pos++ // Skip LOAD
+	if err := p.expectWord("DATA"); err != nil {
+		return nil, err
+	}
+	stmt := &LoadDataStatement{Local: p.consumeWord("LOCAL")}
+	if err := p.expectWord("INFILE"); err != nil {
+		return nil, err
+	}
+	file, err := p.expect(tokens.TokenStringLiteral, "file name after INFILE")
+	if err != nil {
+		return nil, err
+	}
+	stmt.File = file.RawValue()
+	if p.peekWord("REPLACE") || p.peekWord("IGNORE") {
+		stmt.Duplicates = strings.ToUpper(p.next().Literal)
+	}
+	if _, err := p.expect(tokens.TokenInto, "INTO"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenTable, "TABLE after INTO"); err != nil {
+		return nil, err
+	}
+	stmt.Table, err = p.parseQualifiedName("table name")
+	if err != nil {
+		return nil, err
+	}
+	if p.consumeKeywords("CHARACTER", "SET") {
+		charset, err := p.parseIdentifier("character set name")
+		if err != nil {
+			return nil, err
+		}
+		stmt.CharacterSet = &charset
+	}
+	if p.consumeWord("FIELDS") || p.consumeWord("COLUMNS") {
+		if err := p.parseLoadDataFields(stmt); err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeWord("LINES") {
+		if err := p.parseLoadDataLines(stmt); err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeWord("IGNORE") {
+		count, err := p.expect(tokens.TokenNumericLiteral, "number of lines after IGNORE")
+		if err != nil {
+			return nil, err
+		}
+		stmt.IgnoreLines, err = strconv.Atoi(count.Literal)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing IGNORE count %s: %w", count.Literal, err)
+		}
+		if !p.consumeWord("LINES") && !p.consumeWord("ROWS") {
+			return nil, fmt.Errorf("expected LINES or ROWS after IGNORE %d, found %q at position %d", stmt.IgnoreLines, p.peek().Literal, p.pos)
+		}
+	}
+	if p.peek().Type == tokens.TokenLeftParen {
+		stmt.Columns, err = p.parseLoadDataColumns()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consume(tokens.TokenSet) {
+		stmt.Assignments, err = p.parseAssignments()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing SET clause: %w", err)
+		}
+	}
+	return stmt, nil
+}
+
+// parseLoadDataColumns parses the parenthesized columns and user variables the fields are read into.
+func (p *Parser) parseLoadDataColumns() ([]string, error) {
+	p.pos++ // Skip the opening parenthesis
+	var columns []string
+	for {
+		if variable := p.peek(); variable.Type == tokens.TokenVariable {
+			p.pos++
+			columns = append(columns, variable.Literal)
+		} else {
+			column, err := p.parseIdentifier("column name or user variable")
+			if err != nil {
+				return nil, err
+			}
+			columns = append(columns, column)
+		}
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// parseLoadDataFields parses [TERMINATED BY 'string'] [[OPTIONALLY] ENCLOSED BY 'char'] [ESCAPED BY 'char']
+// after FIELDS or COLUMNS, at least one of which is required.
+func (p *Parser) parseLoadDataFields(stmt *LoadDataStatement) error {
+	for found := false; ; found = true {
+		var target **string
+		switch {
+		case p.consumeKeywords("TERMINATED", "BY"):
+			target = &stmt.FieldsTerminatedBy
+		case p.consumeKeywords("OPTIONALLY", "ENCLOSED", "BY"):
+			stmt.OptionallyEnclosed = true
+			target = &stmt.FieldsEnclosedBy
+		case p.consumeKeywords("ENCLOSED", "BY"):
+			target = &stmt.FieldsEnclosedBy
+		case p.consumeKeywords("ESCAPED", "BY"):
+			target = &stmt.FieldsEscapedBy
+		case found:
+			return nil
+		default:
+			return fmt.Errorf("expected TERMINATED, ENCLOSED or ESCAPED BY, found %q at position %d", p.peek().Literal, p.pos)
+		}
+		if err := p.parseLoadDataString(target); err != nil {
+			return err
+		}
+	}
+}
+
+// parseLoadDataLines parses [STARTING BY 'string'] [TERMINATED BY 'string'] after LINES, at least one of which is required.
+func (p *Parser) parseLoadDataLines(stmt *LoadDataStatement) error {
+	for found := false; ; found = true {
+		var target **string
+		switch {
+		case p.consumeKeywords("STARTING", "BY"):
+			target = &stmt.LinesStartingBy
+		case p.consumeKeywords("TERMINATED", "BY"):
+			target = &stmt.LinesTerminatedBy
+		case found:
+			return nil
+		default:
+			return fmt.Errorf("expected STARTING or TERMINATED BY, found %q at position %d", p.peek().Literal, p.pos)
+		}
+		if err := p.parseLoadDataString(target); err != nil {
+			return err
+		}
+	}
+}
+
+// parseLoadDataString parses the string of a FIELDS or LINES option into target.
+func (p *Parser) parseLoadDataString(target **string) error {
+	value, err := p.expect(tokens.TokenStringLiteral, "string")
+	if err != nil {
+		return err
+	}
+	literal := value.RawValue()
+	*target = &literal
+	return nil
+}
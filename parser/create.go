@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCreate parses a CREATE statement, dispatching on the kind of object created.
+func (p *Parser) parseCreate() (Statement, error) {
+	p.pos++ // Skip the CREATE token
+	start := p.pos
+	orReplace := false
+	if p.peek().Type == tokens.TokenOr && strings.EqualFold(p.peekAhead(1).Literal, "REPLACE") {
+		p.pos += 2 // Skip OR REPLACE
+		orReplace = true
+	}
+	// Skip the options that precede the object kind; parseCreateTable reads them back from start.
+	temporary := false
+	for p.peekWord("GLOBAL") || p.peekWord("LOCAL") || p.peekWord("TEMP") ||
+		p.peekWord("TEMPORARY") || p.peekWord("UNLOGGED") {
+		if p.peekWord("TEMP") || p.peekWord("TEMPORARY") {
+			temporary = true
+		}
+		p.pos++
+	}
+	switch {
+	case p.peek().Type == tokens.TokenTable && !orReplace:
+		return p.parseCreateTable(start)
+	case p.peek().Type == tokens.TokenUnique || p.peekWord("INDEX"):
+		return p.parseCreateIndex()
+	case p.peekWord("VIEW") || p.peekWord("MATERIALIZED"):
+		return p.parseCreateView(orReplace, temporary)
+	case p.peekWord("SCHEMA"):
+		return p.parseCreateSchema()
+	case p.peekWord("SEQUENCE"):
+		return p.parseCreateSequence(temporary)
+	case p.peekWord("FUNCTION") || p.peekWord("PROCEDURE"):
+		return p.parseCreateFunction(orReplace)
+	case p.peekWord("TRIGGER") || p.peek().Type == tokens.TokenConstraint:
+		return p.parseCreateTrigger(orReplace)
+	case p.peekWord("TYPE"):
+		return p.parseCreateType()
+	case p.peekWord("DOMAIN"):
+		return p.parseCreateDomain()
+	case p.peekWord("EXTENSION"):
+		return p.parseCreateExtension()
+	case p.peekWord("ROLE") || p.peekWord("USER"):
+		return p.parseCreateRole()
+	}
+	return nil, fmt.Errorf("unsupported CREATE statement: %s, at position %d", p.peek().Literal, p.pos)
+}
+
+// parseCreateSchema parses CREATE SCHEMA [IF NOT EXISTS] [name] [AUTHORIZATION role].
+func (p *Parser) parseCreateSchema() (*CreateSchemaStatement, error) {
+	p.pos++ // Skip the SCHEMA token
+	stmt := &CreateSchemaStatement{}
+	var err error
+	stmt.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peekWord("AUTHORIZATION") {
+		stmt.Name, err = p.parseIdentifier("schema name")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeWord("AUTHORIZATION") {
+		role, err := p.parseIdentifier("role name")
+		if err != nil {
+			return nil, err
+		}
+		stmt.Authorization = &role
+	}
+	return stmt, nil
+}
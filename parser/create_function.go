@@ -0,0 +1,258 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCreateFunction parses the remainder of CREATE [OR REPLACE] {FUNCTION | PROCEDURE},
+// starting at FUNCTION or PROCEDURE.
+func (p *Parser) parseCreateFunction(orReplace bool) (*CreateFunctionStatement, error) {
+	stmt := &CreateFunctionStatement{OrReplace: orReplace, Procedure: p.peekWord("PROCEDURE")}
+	p.pos++ // Skip the FUNCTION or PROCEDURE token
+	var err error
+	stmt.Name, err = p.parseQualifiedName("function name")
+	if err != nil {
+		return nil, err
+	}
+	stmt.Parameters, err = p.parseFunctionParameters()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing parameters of %s: %w", stmt.Name, err)
+	}
+	if !stmt.Procedure && p.peekWord("RETURNS") && !strings.EqualFold(p.peekAhead(1).Literal, "NULL") {
+		p.pos++ // Skip RETURNS
+		if err := p.parseFunctionReturns(stmt); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.parseFunctionAttributes(stmt); err != nil {
+		return nil, fmt.Errorf("error parsing definition of %s: %w", stmt.Name, err)
+	}
+	if stmt.Body == nil && stmt.Return == nil {
+		return nil, fmt.Errorf("expected AS or RETURN body of %s, found %q at position %d", stmt.Name, p.peek().Literal, p.pos)
+	}
+	return stmt, nil
+}
+
+// parseFunctionParameters parses a parenthesized, possibly empty list of function parameters.
+func (p *Parser) parseFunctionParameters() ([]*FunctionParameter, error) {
+	if _, err := p.expect(tokens.TokenLeftParen, "( after function name"); err != nil {
+		return nil, err
+	}
+	parameters := []*FunctionParameter{}
+	if p.consume(tokens.TokenRightParen) {
+		return parameters, nil
+	}
+	for {
+		parameter, err := p.parseFunctionParameter()
+		if err != nil {
+			return nil, err
+		}
+		parameters = append(parameters, parameter)
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after function parameters"); err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+// multiWordTypeStarts maps the first word of each multi-word type name to the
+// words that may follow it, so that a parameter whose type is, for example,
+// DOUBLE PRECISION is not mistaken for a parameter named double.
+var multiWordTypeStarts = map[string][]string{
+	"DOUBLE":    {"PRECISION"},
+	"CHARACTER": {"VARYING"},
+	"CHAR":      {"VARYING"},
+	"NCHAR":     {"VARYING"},
+	"BIT":       {"VARYING"},
+	"NATIONAL":  {"CHARACTER", "CHAR"},
+}
+
+// parseFunctionParameter parses [mode] [name] type [{DEFAULT | =} expr].
+func (p *Parser) parseFunctionParameter() (*FunctionParameter, error) {
+	pos := p.peek().Pos
+	parameter := &FunctionParameter{}
+	switch {
+	case p.consume(tokens.TokenIn):
+		parameter.Mode = ParameterModeIn
+	case p.consumeWord("OUT"):
+		parameter.Mode = ParameterModeOut
+	case p.consumeWord("INOUT"):
+		parameter.Mode = ParameterModeInOut
+	case p.consumeWord("VARIADIC"):
+		parameter.Mode = ParameterModeVariadic
+	}
+	if p.peek().Type == tokens.TokenIdentifier && p.peekAhead(1).Type == tokens.TokenIdentifier && !p.peekMultiWordType() {
+		name := p.next().RawValue()
+		parameter.Name = &name
+	}
+	var err error
+	parameter.Type, err = p.parseDataType()
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(tokens.TokenDefault) || p.consume(tokens.TokenEqual) {
+		parameter.Default, err = p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing parameter default: %w", err)
+		}
+	}
+	return finishNode(p, pos, parameter), nil
+
+}
+
+// peekMultiWordType reports whether the current and next tokens are the first two words of a multi-word type name.
+func (p *Parser) peekMultiWordType() bool {
+	for _, word := range multiWordTypeStarts[strings.ToUpper(p.peek().Literal)] {
+		if strings.EqualFold(p.peekAhead(1).Literal, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseFunctionReturns parses the type after RETURNS: type, SETOF type or TABLE (columns).
+func (p *Parser) parseFunctionReturns(stmt *CreateFunctionStatement) error {
+	if p.consume(tokens.TokenTable) {
+		if _, err := p.expect(tokens.TokenLeftParen, "( after RETURNS TABLE"); err != nil {
+			return err
+		}
+		for {
+			column, err := p.parseColumnDefinition()
+			if err != nil {
+				return err
+			}
+			if column.Type == nil {
+				return fmt.Errorf("missing data type for result column %s", column.Name)
+			}
+			stmt.ReturnsTable = append(stmt.ReturnsTable, column)
+
+			if !p.consume(tokens.TokenComma) {
+				break
+			}
+		}
+		_, err := p.expect(tokens.TokenRightParen, ") after RETURNS TABLE columns")
+		return err
+	}
+	stmt.ReturnsSetOf = p.consumeWord("SETOF")
+	var err error
+	stmt.Returns, err = p.parseDataType()
+	return err
+}
+
+// parseFunctionAttributes parses the language, volatility, other options and body
+// of a function or procedure, which may appear in any order.
+func (p *Parser) parseFunctionAttributes(stmt *CreateFunctionStatement) error {
+	for {
+		switch {
+		case p.consumeWord("LANGUAGE"):
+			language := p.next()
+			if language.Type != tokens.TokenIdentifier && language.Type != tokens.TokenStringLiteral {
+				return fmt.Errorf("expected language name, found %q at position %d", language.Literal, p.pos-1)
+			}
+			stmt.Language = language.RawValue()
+		case p.peekWord("IMMUTABLE") || p.peekWord("STABLE") || p.peekWord("VOLATILE"):
+			stmt.Volatility = strings.ToUpper(p.next().Literal)
+		case p.consumeWord("STRICT"):
+			stmt.Attributes = append(stmt.Attributes, "STRICT")
+		case p.consumeKeywords("CALLED", "ON", "NULL", "INPUT"):
+			stmt.Attributes = append(stmt.Attributes, "CALLED ON NULL INPUT")
+		case p.consumeKeywords("RETURNS", "NULL", "ON", "NULL", "INPUT"):
+			stmt.Attributes = append(stmt.Attributes, "RETURNS NULL ON NULL INPUT")
+		case p.consumeWord("LEAKPROOF"):
+			stmt.Attributes = append(stmt.Attributes, "LEAKPROOF")
+		case p.consumeKeywords("NOT", "LEAKPROOF"):
+			stmt.Attributes = append(stmt.Attributes, "NOT LEAKPROOF")
+		case p.consumeWord("EXTERNAL") || p.peekWord("SECURITY"):
+			if err := p.expectWord("SECURITY"); err != nil {
+				return err
+			}
+			if !p.peekWord("INVOKER") && !p.peekWord("DEFINER") {
+				return fmt.Errorf("expected INVOKER or DEFINER after SECURITY, found %q at position %d", p.peek().Literal, p.pos)
+			}
+			stmt.Attributes = append(stmt.Attributes, "SECURITY "+strings.ToUpper(p.next().Literal))
+		case p.consumeWord("PARALLEL"):
+			mode, err := p.parseIdentifier("parallel mode")
+			if err != nil {
+				return err
+			}
+			stmt.Attributes = append(stmt.Attributes, "PARALLEL "+strings.ToUpper(mode))
+		case p.peekWord("COST") || p.peekWord("ROWS"):
+			name := strings.ToUpper(p.next().Literal)
+			value, err := p.expect(tokens.TokenNumericLiteral, name+" estimate")
+			if err != nil {
+				return err
+			}
+			stmt.Attributes = append(stmt.Attributes, name+" "+value.Literal)
+		case p.consume(tokens.TokenWindow):
+			stmt.Attributes = append(stmt.Attributes, "WINDOW")
+		case p.consume(tokens.TokenSet):
+			setting, err := p.parseFunctionSetting()
+			if err != nil {
+				return err
+			}
+			stmt.Attributes = append(stmt.Attributes, setting)
+		case p.consume(tokens.TokenAs):
+			body, err := p.expect(tokens.TokenStringLiteral, "function body string")
+			if err != nil {
+				return err
+			}
+			definition := body.RawValue()
+			stmt.Body = &definition
+			if p.consume(tokens.TokenComma) {
+				symbol, err := p.expect(tokens.TokenStringLiteral, "link symbol string")
+				if err != nil {
+					return err
+				}
+				linkSymbol := symbol.RawValue()
+				stmt.LinkSymbol = &linkSymbol
+			}
+		case p.consumeWord("RETURN"):
+			expr, err := p.parseExpression()
+			if err != nil {
+				return fmt.Errorf("error parsing RETURN expression: %w", err)
+			}
+			stmt.Return = expr
+		default:
+			return nil
+		}
+	}
+}
+
+// parseFunctionSetting parses the configuration parameter of a function's
+// SET clause and returns the clause as written, such as "SET search_path = public".
+// The SET token has already been consumed.
+func (p *Parser) parseFunctionSetting() (string, error) {
+	name, err := p.parseQualifiedName("configuration parameter")
+	if err != nil {
+		return "", err
+	}
+	if p.consume(tokens.TokenFrom) {
+		if err := p.expectWord("CURRENT"); err != nil {
+			return "", err
+		}
+		return "SET " + name + " FROM CURRENT", nil
+	}
+	if !p.consume(tokens.TokenTo) && !p.consume(tokens.TokenEqual) {
+		return "", fmt.Errorf("expected TO or = after SET %s, found %q at position %d", name, p.peek().Literal, p.pos)
+	}
+	var values []string
+	for {
+		value := p.next()
+		switch value.Type {
+		case tokens.TokenIdentifier, tokens.TokenStringLiteral, tokens.TokenNumericLiteral, tokens.TokenDefault:
+			values = append(values, value.Literal)
+		default:
+			return "", fmt.Errorf("expected value of %s, found %q at position %d", name, value.Literal, p.pos-1)
+		}
+		if !p.consume(tokens.TokenComma) {
+			return "SET " + name + " = " + strings.Join(values, ", "), nil
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCreateIndex parses CREATE [UNIQUE] INDEX [CONCURRENTLY] [[IF NOT EXISTS] name]
+// ON [ONLY] table [USING method] (element, ...) [INCLUDE (column, ...)] [WHERE predicate].
+func (p *Parser) parseCreateIndex() (*CreateIndexStatement, error) {
+	stmt := &CreateIndexStatement{Unique: p.consume(tokens.TokenUnique)}
+	if err := p.expectWord("INDEX"); err != nil {
+		return nil, err
+	}
+	stmt.Concurrently = p.consumeWord("CONCURRENTLY")
+	var err error
+	stmt.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().Type != tokens.TokenOn {
+		name, err := p.parseQualifiedName("index name")
+		if err != nil {
+			return nil, err
+		}
+		stmt.Name = &name
+	}
+	if _, err := p.expect(tokens.TokenOn, "ON after index name"); err != nil {
+		return nil, err
+	}
+	stmt.Only = p.consumeWord("ONLY")
+	stmt.Table, err = p.parseQualifiedName("table name")
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(tokens.TokenUsing) {
+		method, err := p.parseIdentifier("index method")
+		if err != nil {
+			return nil, err
+		}
+		stmt.Method = &method
+	}
+	if _, err := p.expect(tokens.TokenLeftParen, "( after index table"); err != nil {
+		return nil, err
+	}
+	for {
+		element, err := p.parseIndexElement()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing index element: %w", err)
+		}
+		stmt.Elements = append(stmt.Elements, element)
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after index elements"); err != nil {
+		return nil, err
+	}
+	if p.consumeWord("INCLUDE") {
+		stmt.Include, err = p.parseIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consume(tokens.TokenWhere) {
+		stmt.Where, err = p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing index predicate: %w", err)
+		}
+	}
+	return stmt, nil
+}
+
+// parseIndexElement parses an index column or expression followed by an optional
+// collation, operator class, ASC or DESC, and NULLS FIRST or NULLS LAST.
+func (p *Parser) parseIndexElement() (*IndexElement, error) {
+	pos := p.peek().Pos
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	element := &IndexElement{Expression: expr}
+	// A trailing COLLATE is parsed as part of the expression but belongs to the element.
+	if collate, ok := expr.(*CollateExpression); ok {
+		element.Expression = collate.Expression
+		element.Collation = &collate.Collation
+	}
+	if p.peek().Type == tokens.TokenIdentifier && !p.peekWord("NULLS") {
+		opClass, err := p.parseQualifiedName("operator class")
+		if err != nil {
+			return nil, err
+		}
+		element.OpClass = &opClass
+	}
+	element.Direction, element.Nulls, err = p.parseSortOptions()
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, element), nil
+
+}
@@ -0,0 +1,697 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCreateTable parses a CREATE TABLE statement. start is the position of the
+// first token after CREATE, where the table options such as TEMPORARY begin.
+func (p *Parser) parseCreateTable(start int) (*CreateTableStatement, error) {
+	stmt := &CreateTableStatement{}
+	for _, token := range p.tokens[start:p.pos] {
+		switch strings.ToUpper(token.Literal) {
+		case "TEMP", "TEMPORARY":
+			stmt.Temporary = true
+		case "UNLOGGED":
+			stmt.Unlogged = true
+		}
+	}
+	p.pos++ // Skip the TABLE token
+	var err error
+	stmt.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Name, err = p.parseQualifiedName("table name")
+	if err != nil {
+		return nil, err
+	}
+	// MySQL: CREATE TABLE t LIKE source
+	if p.consume(tokens.TokenLike) {
+		like, err := p.parseTableLike()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Elements = append(stmt.Elements, like)
+		return stmt, nil
+	}
+	if p.peekWord("PARTITION") && strings.EqualFold(p.peekAhead(1).Literal, "OF") {
+		p.pos += 2 // Skip PARTITION OF
+		stmt.PartitionOf, err = p.parseQualifiedName("parent table name")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consume(tokens.TokenLeftParen) {
+		if err := p.parseTableElements(stmt); err != nil {
+			return nil, err
+		}
+	}
+	if stmt.PartitionOf != "" {
+		stmt.Bound, err = p.parsePartitionBound()
+		if err != nil {
+			return nil, err
+		}
+	}
+	stmt.Options, err = p.parseTableOptions()
+	if err != nil {
+		return nil, err
+	}
+	if p.consumeWord("INHERITS") {
+		stmt.Inherits, err = p.parseQualifiedNameList("parent table name")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.peekWord("PARTITION") && p.peekAhead(1).Type == tokens.TokenBy {
+		p.pos += 2 // Skip PARTITION BY
+		stmt.PartitionBy, err = p.parsePartitionSpec()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consume(tokens.TokenAs) {
+		stmt.Query, err = p.parseQuery()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CREATE TABLE AS query: %w", err)
+		}
+		if p.consume(tokens.TokenWith) {
+			stmt.WithNoData = p.consumeWord("NO")
+			if err := p.expectWord("DATA"); err != nil {
+				return nil, err
+			}
+		}
+		return stmt, nil
+	}
+	for _, element := range stmt.Elements {
+		// The columns of a partition take their types from the parent.
+		if column, ok := element.(*ColumnDefinition); ok && column.Type == nil && stmt.PartitionOf == "" {
+			return nil, fmt.Errorf("missing data type for column %s", column.Name)
+		}
+	}
+	return stmt, nil
+}
+
+// parseTableElements parses the column definitions, table constraints, LIKE
+// clauses and index definitions of CREATE TABLE into stmt, in the order they are written. The opening parenthesis has already been consumed.
+func (p *Parser) parseTableElements(stmt *CreateTableStatement) error {
+	for {
+		var element TableElement
+		var err error
+		switch {
+		case p.consume(tokens.TokenLike):
+			element, err = p.parseTableLike()
+		case p.peekIndexDefinition():
+			element, err = p.parseIndexDefinition()
+		case p.peekTableConstraint():
+			element, err = p.parseTableConstraint()
+		default:
+			element, err = p.parseColumnDefinition()
+		}
+		if err != nil {
+			return err
+		}
+		stmt.Elements = append(stmt.Elements, element)
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	_, err := p.expect(tokens.TokenRightParen, ") after table elements")
+	return err
+}
+
+// parseTableLike parses the source table and options of a LIKE clause. The LIKE token has already been consumed.
+func (p *Parser) parseTableLike() (*TableLikeClause, error) {
+	pos := p.posBefore(1)
+	table, err := p.parseQualifiedName("table name after LIKE")
+	if err != nil {
+		return nil, err
+	}
+	like := &TableLikeClause{Table: table}
+	for p.peekWord("INCLUDING") || p.peekWord("EXCLUDING") {
+		keyword := strings.ToUpper(p.next().Literal)
+		var option string
+		if p.consume(tokens.TokenAll) {
+			option = "ALL"
+		} else {
+			option, err = p.parseIdentifier("LIKE option")
+			if err != nil {
+				return nil, err
+			}
+		}
+		like.Options = append(like.Options, keyword+" "+strings.ToUpper(option))
+	}
+	return finishNode(p, pos, like), nil
+}
+
+// peekIndexDefinition reports whether a MySQL index definition starts at the
+// current position rather than a column named index or key. The columns of an
+// index are names, whereas the modifiers of a type such as varchar(10) are numbers.
+func (p *Parser) peekIndexDefinition() bool {
+	if !p.peekWord("INDEX") && !p.peekWord("KEY") {
+		return false
+	}
+	next := 1
+	if p.peekAhead(next).Type == tokens.TokenIdentifier {
+		next++
+	}
+	switch p.peekAhead(next).Type {
+	case tokens.TokenUsing:
+		return true
+	case tokens.TokenLeftParen:
+		return next == 1 || p.peekAhead(next+1).Type == tokens.TokenIdentifier
+	}
+	return false
+}
+
+// parseIndexDefinition parses {INDEX | KEY} [name] [USING method] (columns).
+func (p *Parser) parseIndexDefinition() (*IndexDefinition, error) {
+	pos := p.next().Pos // Skip INDEX or KEY
+	index := &IndexDefinition{}
+	if p.peek().Type == tokens.TokenIdentifier {
+		name := p.next().RawValue()
+		index.Name = &name
+	}
+	if p.consume(tokens.TokenUsing) {
+		method, err := p.parseIdentifier("index method")
+		if err != nil {
+			return nil, err
+		}
+		index.Method = &method
+	}
+	var err error
+	index.Columns, err = p.parseIdentifierList()
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, index), nil
+}
+
+// tableOptionNames are the MySQL table options parseTableOptions accepts, besides
+// [DEFAULT] {CHARACTER SET | CHARSET} and [DEFAULT] COLLATE.
+var tableOptionNames = map[string]bool{
+	"AUTO_INCREMENT": true, "AVG_ROW_LENGTH": true, "CHECKSUM": true,
+	"COMMENT": true, "COMPRESSION": true, "CONNECTION": true, "DELAY_KEY_WRITE": true,
+	"ENCRYPTION": true, "ENGINE": true, "INSERT_METHOD": true, "KEY_BLOCK_SIZE": true,
+	"MAX_ROWS": true, "MIN_ROWS": true, "PACK_KEYS": true, "ROW_FORMAT": true,
+	"STATS_AUTO_RECALC": true, "STATS_PERSISTENT": true, "STATS_SAMPLE_PAGES": true,
+}
+
+// parseTableOptions parses the MySQL table options after the table elements, such as
+// ENGINE = InnoDB DEFAULT CHARSET = utf8mb4. The options may be separated by commas
+// and the equals signs may be omitted.
+func (p *Parser) parseTableOptions() ([]*UtilityOption, error) {
+	var options []*UtilityOption
+	for {
+		pos := p.peek().Pos
+		name := ""
+		if p.consume(tokens.TokenDefault) {
+			name = "DEFAULT "
+		}
+		switch {
+		case p.consumeKeywords("CHARACTER", "SET"):
+			name += "CHARACTER SET"
+		case p.consume(tokens.TokenCollate):
+			name += "COLLATE"
+		case p.consumeWord("CHARSET"):
+			name += "CHARSET"
+		case name == "" && p.peek().Type == tokens.TokenIdentifier && tableOptionNames[strings.ToUpper(p.peek().Literal)]:
+			name = strings.ToUpper(p.next().Literal)
+		case name != "":
+			return nil, fmt.Errorf("expected CHARACTER SET, CHARSET or COLLATE after DEFAULT, found %q at position %d", p.peek().Literal, p.pos)
+		default:
+			return options, nil
+		}
+		p.consume(tokens.TokenEqual)
+		switch value := p.peek(); value.Type {
+		case tokens.TokenIdentifier, tokens.TokenStringLiteral, tokens.TokenNumericLiteral:
+			p.pos++
+			literal := value.RawValue()
+			options = append(options, finishNode(p, pos, &UtilityOption{Name: name, Value: &literal, Quoted: value.Type == tokens.TokenStringLiteral}))
+		default:
+			return nil, fmt.Errorf("expected value of table option %s, found %q at position %d", name, value.Literal, p.pos)
+		}
+		if p.peek().Type == tokens.TokenComma {
+			p.pos++
+		}
+	}
+}
+
+// parsePartitionSpec parses the strategy and keys of PARTITION BY. The PARTITION BY tokens have already been consumed.
+func (p *Parser) parsePartitionSpec() (*PartitionSpec, error) {
+	pos := p.posBefore(2)
+	strategy, err := p.parseIdentifier("partition strategy")
+	if err != nil {
+		return nil, err
+	}
+	spec := &PartitionSpec{Strategy: strings.ToUpper(strategy)}
+	if _, err := p.expect(tokens.TokenLeftParen, "( after partition strategy"); err != nil {
+		return nil, err
+	}
+	spec.Keys, err = p.parseExpressions()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing partition key: %w", err)
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after partition key"); err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, spec), nil
+}
+
+// parseColumnDefinition parses a column name, its data type and its constraints.
+// The data type may be omitted, as in the column list of CREATE TABLE ... AS.
+func (p *Parser) parseColumnDefinition() (*ColumnDefinition, error) {
+	pos := p.peek().Pos
+	name, err := p.parseIdentifier("column name")
+	if err != nil {
+		return nil, err
+	}
+	column := &ColumnDefinition{Name: name}
+	if p.peek().Type == tokens.TokenIdentifier {
+		column.Type, err = p.parseDataType()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing type of column %s: %w", name, err)
+		}
+	}
+	for {
+		if p.consume(tokens.TokenCollate) {
+			collation, err := p.parseIdentifier("collation name")
+			if err != nil {
+				return nil, err
+			}
+			column.Collation = &collation
+			continue
+		}
+		constraint, err := p.parseColumnConstraint()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing constraint of column %s: %w", name, err)
+		}
+		if constraint == nil {
+			return finishNode(p, pos, column), nil
+		}
+		column.Constraints = append(column.Constraints, constraint)
+	}
+}
+
+// parseColumnConstraint parses one column constraint followed by its deferrability,
+// returning nil if none starts at the current position.
+func (p *Parser) parseColumnConstraint() (*ColumnConstraint, error) {
+	pos := p.peek().Pos
+	constraint := &ColumnConstraint{}
+	if p.consume(tokens.TokenConstraint) {
+		name, err := p.parseIdentifier("constraint name")
+		if err != nil {
+			return nil, err
+		}
+		constraint.Name = &name
+	}
+	var err error
+	switch {
+	case p.consume(tokens.TokenNot):
+		if _, err := p.expect(tokens.TokenNull, "NULL after NOT"); err != nil {
+			return nil, err
+		}
+		constraint.Kind = ConstraintNotNull
+	case p.consume(tokens.TokenNull):
+		constraint.Kind = ConstraintNull
+	case p.consume(tokens.TokenDefault):
+		constraint.Kind = ConstraintDefault
+		constraint.Expression, err = p.parseExpression()
+	case p.consume(tokens.TokenCheck):
+		constraint.Kind = ConstraintCheck
+		constraint.Expression, err = p.parseParenthesizedExpression("CHECK")
+	case p.consume(tokens.TokenPrimary):
+		constraint.Kind = ConstraintPrimaryKey
+		err = p.expectWord("KEY")
+	case p.consume(tokens.TokenUnique):
+		constraint.Kind = ConstraintUnique
+		constraint.NullsNotDistinct, err = p.parseNullsDistinct()
+	case p.consume(tokens.TokenReferences):
+		constraint.Kind = ConstraintReferences
+		constraint.References, err = p.parseReferences()
+	case p.consumeWord("GENERATED"):
+		err = p.parseGeneratedColumn(constraint)
+	case p.consumeWord("AUTO_INCREMENT"):
+		constraint.Kind = ConstraintAutoIncrement
+	default:
+		if constraint.Name != nil {
+			return nil, fmt.Errorf("expected constraint after CONSTRAINT %s, found %q at position %d", *constraint.Name, p.peek().Literal, p.pos)
+		}
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	constraint.Deferrable, constraint.InitiallyDeferred, err = p.parseDeferrable()
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, constraint), nil
+}
+
+// parseNullsDistinct parses the optional NULLS [NOT] DISTINCT after UNIQUE and reports whether NOT was written.
+func (p *Parser) parseNullsDistinct() (bool, error) {
+	if !p.consumeWord("NULLS") {
+		return false, nil
+	}
+	notDistinct := p.consume(tokens.TokenNot)
+	if _, err := p.expect(tokens.TokenDistinct, "DISTINCT after NULLS"); err != nil {
+		return false, err
+	}
+	return notDistinct, nil
+}
+
+// parseDeferrable parses the optional [NOT] DEFERRABLE and INITIALLY {DEFERRED | IMMEDIATE}
+// of a constraint, in either order, returning nil for those not written.
+func (p *Parser) parseDeferrable() (deferrable, initiallyDeferred *bool, err error) {
+	for {
+		switch {
+		case deferrable == nil && p.consumeWord("DEFERRABLE"):
+			deferrable = addr(true)
+		case deferrable == nil && p.consumeKeywords("NOT", "DEFERRABLE"):
+			deferrable = addr(false)
+		case initiallyDeferred == nil && p.consumeWord("INITIALLY"):
+			switch {
+			case p.consumeWord("DEFERRED"):
+				initiallyDeferred = addr(true)
+			case p.consumeWord("IMMEDIATE"):
+				initiallyDeferred = addr(false)
+			default:
+				return nil, nil, fmt.Errorf("expected DEFERRED or IMMEDIATE after INITIALLY, found %q at position %d", p.peek().Literal, p.pos)
+			}
+		default:
+			return deferrable, initiallyDeferred, nil
+		}
+	}
+}
+
+// parseGeneratedColumn parses the remainder of GENERATED {ALWAYS | BY DEFAULT} AS
+// IDENTITY [(options)] or GENERATED ALWAYS AS (expr) STORED into constraint.
+func (p *Parser) parseGeneratedColumn(constraint *ColumnConstraint) error {
+	switch {
+	case p.consumeWord("ALWAYS"):
+		constraint.Generated = GeneratedAlways
+	case p.peek().Type == tokens.TokenBy && p.peekAhead(1).Type == tokens.TokenDefault:
+		p.pos += 2 // Skip BY DEFAULT
+		constraint.Generated = GeneratedByDefault
+	default:
+		return fmt.Errorf("expected ALWAYS or BY DEFAULT after GENERATED, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if _, err := p.expect(tokens.TokenAs, "AS after GENERATED"); err != nil {
+		return err
+	}
+	if p.consumeWord("IDENTITY") {
+		constraint.Kind = ConstraintIdentity
+		if p.consume(tokens.TokenLeftParen) {
+			options, err := p.parseSequenceOptions()
+			if err != nil {
+				return err
+			}
+			constraint.IdentityOptions = options
+			if _, err := p.expect(tokens.TokenRightParen, ") after identity options"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if constraint.Generated != GeneratedAlways {
+		return fmt.Errorf("expected IDENTITY after GENERATED BY DEFAULT AS, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	constraint.Kind = ConstraintGenerated
+	expr, err := p.parseParenthesizedExpression("GENERATED ALWAYS AS")
+	if err != nil {
+		return err
+	}
+	constraint.Expression = expr
+	return p.expectWord("STORED")
+}
+
+// parseSequenceOptions parses the options of a sequence or identity column, such as
+// START WITH 1 INCREMENT BY 1 NO CYCLE. It stops at the first token that starts no option.
+func (p *Parser) parseSequenceOptions() ([]*SequenceOption, error) {
+	var options []*SequenceOption
+	for {
+		pos := p.peek().Pos
+		var option *SequenceOption
+		switch {
+		case p.consumeWord("START"):
+			p.consume(tokens.TokenWith)
+			option = &SequenceOption{Name: "START"}
+		case p.consumeWord("INCREMENT"):
+			p.consume(tokens.TokenBy)
+			option = &SequenceOption{Name: "INCREMENT"}
+		case p.consumeWord("MINVALUE"):
+			option = &SequenceOption{Name: "MINVALUE"}
+		case p.consumeWord("MAXVALUE"):
+			option = &SequenceOption{Name: "MAXVALUE"}
+		case p.consumeWord("CACHE"):
+			option = &SequenceOption{Name: "CACHE"}
+		case p.consume(tokens.TokenAs):
+			dataType, err := p.parseDataType()
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, finishNode(p, pos, &SequenceOption{Name: "AS", Type: dataType}))
+			continue
+		case p.consumeWord("OWNED"):
+			if _, err := p.expect(tokens.TokenBy, "BY after OWNED"); err != nil {
+				return nil, err
+			}
+			option = &SequenceOption{Name: "OWNED BY"}
+		case p.consumeWord("RESTART"):
+			// The new value of RESTART [WITH n] is optional.
+			option = &SequenceOption{Name: "RESTART"}
+			if !p.consume(tokens.TokenWith) && p.peek().Type != tokens.TokenNumericLiteral && p.peek().Type != tokens.TokenMinus {
+				options = append(options, finishNode(p, pos, option))
+				continue
+			}
+		case p.consumeWord("CYCLE"):
+			options = append(options, finishNode(p, pos, &SequenceOption{Name: "CYCLE"}))
+			continue
+		case p.peekWord("NO"):
+			p.pos++ // Skip NO
+			switch {
+			case p.consumeWord("MINVALUE"):
+				options = append(options, finishNode(p, pos, &SequenceOption{Name: "NO MINVALUE"}))
+			case p.consumeWord("MAXVALUE"):
+				options = append(options, finishNode(p, pos, &SequenceOption{Name: "NO MAXVALUE"}))
+			case p.consumeWord("CYCLE"):
+				options = append(options, finishNode(p, pos, &SequenceOption{Name: "NO CYCLE"}))
+			default:
+				return nil, fmt.Errorf("expected MINVALUE, MAXVALUE or CYCLE after NO, found %q at position %d", p.peek().Literal, p.pos)
+			}
+			continue
+		default:
+			return options, nil
+		}
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s option: %w", option.Name, err)
+		}
+		option.Value = value
+		options = append(options, finishNode(p, pos, option))
+	}
+}
+
+// parseReferences parses the referenced table and columns, MATCH type and
+// referential actions of a foreign key. The REFERENCES token has already been consumed.
+func (p *Parser) parseReferences() (*ForeignKeyReference, error) {
+	pos := p.posBefore(1)
+	table, err := p.parseQualifiedName("referenced table name")
+	if err != nil {
+		return nil, err
+	}
+	reference := &ForeignKeyReference{Table: table}
+	if p.peek().Type == tokens.TokenLeftParen {
+		reference.Columns, err = p.parseIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeWord("MATCH") {
+		match, err := p.parseIdentifier("match type")
+		if err != nil {
+			return nil, err
+		}
+		reference.Match = strings.ToUpper(match)
+	}
+	for p.peek().Type == tokens.TokenOn {
+		var target *ReferentialAction
+		switch p.peekAhead(1).Type {
+		case tokens.TokenDelete:
+			target = &reference.OnDelete
+		case tokens.TokenUpdate:
+			target = &reference.OnUpdate
+		default:
+			return finishNode(p, pos, reference), nil
+		}
+		p.pos += 2 // Skip ON DELETE or ON UPDATE
+		*target, err = p.parseReferentialAction()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return finishNode(p, pos, reference), nil
+}
+
+// parseReferentialAction parses NO ACTION, RESTRICT, CASCADE, SET NULL or SET DEFAULT.
+func (p *Parser) parseReferentialAction() (ReferentialAction, error) {
+	switch {
+	case p.consumeWord("NO"):
+		if err := p.expectWord("ACTION"); err != nil {
+			return ActionDefault, err
+		}
+		return ActionNoAction, nil
+	case p.consumeWord("RESTRICT"):
+		return ActionRestrict, nil
+	case p.consumeWord("CASCADE"):
+		return ActionCascade, nil
+	case p.consume(tokens.TokenSet):
+		switch {
+		case p.consume(tokens.TokenNull):
+			return ActionSetNull, nil
+		case p.consume(tokens.TokenDefault):
+			return ActionSetDefault, nil
+		}
+	}
+	return ActionDefault, fmt.Errorf("expected referential action, found %q at position %d", p.peek().Literal, p.pos)
+}
+
+// peekTableConstraint reports whether a table constraint starts at the current position.
+func (p *Parser) peekTableConstraint() bool {
+	switch p.peek().Type {
+	case tokens.TokenConstraint, tokens.TokenPrimary, tokens.TokenUnique, tokens.TokenForeign, tokens.TokenCheck:
+		return true
+	}
+	return p.peekWord("EXCLUDE") && (p.peekAhead(1).Type == tokens.TokenUsing || p.peekAhead(1).Type == tokens.TokenLeftParen)
+}
+
+// parseTableConstraint parses a table constraint such as PRIMARY KEY (a, b) or
+// CONSTRAINT fk FOREIGN KEY (a) REFERENCES t (id).
+func (p *Parser) parseTableConstraint() (*TableConstraint, error) {
+	pos := p.peek().Pos
+	constraint := &TableConstraint{}
+	if p.consume(tokens.TokenConstraint) {
+		name, err := p.parseIdentifier("constraint name")
+		if err != nil {
+			return nil, err
+		}
+		constraint.Name = &name
+	}
+	var err error
+	switch {
+	case p.consume(tokens.TokenPrimary):
+		constraint.Kind = TableConstraintPrimaryKey
+		if err := p.expectWord("KEY"); err != nil {
+			return nil, err
+		}
+		constraint.Columns, err = p.parseIdentifierList()
+	case p.consume(tokens.TokenUnique):
+		constraint.Kind = TableConstraintUnique
+		constraint.NullsNotDistinct, err = p.parseNullsDistinct()
+		if err != nil {
+			return nil, err
+		}
+		// MySQL allows UNIQUE [KEY | INDEX] [index_name] (columns).
+		if !p.consumeWord("KEY") {
+			p.consumeWord("INDEX")
+		}
+		if p.peek().Type == tokens.TokenIdentifier && constraint.Name == nil {
+			name := p.next().RawValue()
+			constraint.Name = &name
+		}
+		constraint.Columns, err = p.parseIdentifierList()
+	case p.consume(tokens.TokenForeign):
+		constraint.Kind = TableConstraintForeignKey
+		if err := p.expectWord("KEY"); err != nil {
+			return nil, err
+		}
+		constraint.Columns, err = p.parseIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenReferences, "REFERENCES after FOREIGN KEY columns"); err != nil {
+			return nil, err
+		}
+		constraint.References, err = p.parseReferences()
+	case p.consume(tokens.TokenCheck):
+		constraint.Kind = TableConstraintCheck
+		constraint.Check, err = p.parseParenthesizedExpression("CHECK")
+	case p.consumeWord("EXCLUDE"):
+		constraint.Kind = TableConstraintExclude
+		err = p.parseExcludeConstraint(constraint)
+	default:
+		return nil, fmt.Errorf("expected PRIMARY KEY, UNIQUE, FOREIGN KEY, CHECK or EXCLUDE, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+	constraint.Deferrable, constraint.InitiallyDeferred, err = p.parseDeferrable()
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, constraint), nil
+}
+
+// parseExcludeConstraint parses [USING method] (element WITH operator, ...) [WHERE (predicate)]
+// into constraint. The EXCLUDE token has already been consumed.
+func (p *Parser) parseExcludeConstraint(constraint *TableConstraint) error {
+	if p.consume(tokens.TokenUsing) {
+		method, err := p.parseIdentifier("index method")
+		if err != nil {
+			return err
+		}
+		constraint.IndexMethod = &method
+	}
+	if _, err := p.expect(tokens.TokenLeftParen, "( after EXCLUDE"); err != nil {
+		return err
+	}
+	for {
+		pos := p.peek().Pos
+		expr, err := p.parseExpression()
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect(tokens.TokenWith, "WITH after exclusion element"); err != nil {
+			return err
+		}
+		operator := p.next()
+		if operator.Type == tokens.TokenEOF || operator.Type == tokens.TokenComma || operator.Type == tokens.TokenRightParen {
+			return fmt.Errorf("expected operator after WITH, found %q at position %d", operator.Literal, p.pos-1)
+		}
+		constraint.Exclusions = append(constraint.Exclusions, finishNode(p, pos, &ExclusionElement{Expression: expr, Operator: operator.Literal}))
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after exclusion elements"); err != nil {
+		return err
+	}
+	if p.consume(tokens.TokenWhere) {
+		where, err := p.parseParenthesizedExpression("WHERE")
+		if err != nil {
+			return err
+		}
+		constraint.Where = where
+	}
+	return nil
+}
+
+// parseParenthesizedExpression parses an expression enclosed in parentheses, as required after keyword.
+func (p *Parser) parseParenthesizedExpression(keyword string) (Expression, error) {
+	if _, err := p.expect(tokens.TokenLeftParen, "( after "+keyword); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s expression: %w", keyword, err)
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after "+keyword+" expression"); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCreateTrigger parses the remainder of CREATE [OR REPLACE] [CONSTRAINT] TRIGGER,
+// starting at CONSTRAINT or TRIGGER.
+func (p *Parser) parseCreateTrigger(orReplace bool) (*CreateTriggerStatement, error) {
+	stmt := &CreateTriggerStatement{OrReplace: orReplace, Constraint: p.consume(tokens.TokenConstraint)}
+	if err := p.expectWord("TRIGGER"); err != nil {
+		return nil, err
+	}
+	var err error
+	stmt.Name, err = p.parseIdentifier("trigger name")
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.peekWord("BEFORE") || p.peekWord("AFTER"):
+		stmt.Timing = strings.ToUpper(p.next().Literal)
+	case p.consumeKeywords("INSTEAD", "OF"):
+		stmt.Timing = "INSTEAD OF"
+	default:
+		return nil, fmt.Errorf("expected BEFORE, AFTER or INSTEAD OF, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	for {
+		event, err := p.parseTriggerEvent()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Events = append(stmt.Events, event)
+
+		if !p.consume(tokens.TokenOr) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenOn, "ON after trigger events"); err != nil {
+		return nil, err
+	}
+	stmt.Table, err = p.parseQualifiedName("table name")
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(tokens.TokenFrom) {
+		stmt.From, err = p.parseQualifiedName("referenced table name")
+		if err != nil {
+			return nil, err
+		}
+	}
+	stmt.Deferrable, stmt.InitiallyDeferred, err = p.parseDeferrable()
+	if err != nil {
+		return nil, err
+	}
+	if p.consumeWord("REFERENCING") {
+		if err := p.parseTriggerTransitions(stmt); err != nil {
+			return nil, err
+		}
+	}
+	if p.consume(tokens.TokenFor) {
+		p.consumeWord("EACH")
+		switch {
+		case p.consumeWord("ROW"):
+			stmt.ForEachRow = true
+		case p.consumeWord("STATEMENT"):
+		default:
+			return nil, fmt.Errorf("expected ROW or STATEMENT after FOR EACH, found %q at position %d", p.peek().Literal, p.pos)
+		}
+	}
+	if p.consume(tokens.TokenWhen) {
+		stmt.When, err = p.parseParenthesizedExpression("WHEN")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expectWord("EXECUTE"); err != nil {
+		return nil, err
+	}
+	if !p.consumeWord("FUNCTION") && !p.consumeWord("PROCEDURE") {
+		return nil, fmt.Errorf("expected FUNCTION or PROCEDURE after EXECUTE, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	stmt.Function, err = p.parseQualifiedName("trigger function name")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenLeftParen, "( after trigger function name"); err != nil {
+		return nil, err
+	}
+	if !p.consume(tokens.TokenRightParen) {
+		stmt.Arguments, err = p.parseExpressions()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after trigger function arguments"); err != nil {
+			return nil, err
+		}
+	}
+	return stmt, nil
+}
+
+// parseTriggerTransitions parses the {OLD | NEW} TABLE [AS] name entries of a
+// REFERENCING clause.
+func (p *Parser) parseTriggerTransitions(stmt *CreateTriggerStatement) error {
+	for {
+		var target *string
+		switch {
+		case stmt.OldTable == "" && p.consumeWord("OLD"):
+			target = &stmt.OldTable
+		case stmt.NewTable == "" && p.consumeWord("NEW"):
+			target = &stmt.NewTable
+		case stmt.OldTable == "" && stmt.NewTable == "":
+			return fmt.Errorf("expected OLD or NEW after REFERENCING, found %q at position %d", p.peek().Literal, p.pos)
+		default:
+			return nil
+		}
+		if _, err := p.expect(tokens.TokenTable, "TABLE after OLD or NEW"); err != nil {
+			return err
+		}
+		p.consume(tokens.TokenAs)
+		name, err := p.parseIdentifier("transition relation name")
+		if err != nil {
+			return err
+		}
+		*target = name
+	}
+}
+
+// parseTriggerEvent parses INSERT, UPDATE [OF column, ...], DELETE or TRUNCATE.
+func (p *Parser) parseTriggerEvent() (*TriggerEvent, error) {
+	pos := p.peek().Pos
+	switch p.peek().Type {
+	case tokens.TokenInsert, tokens.TokenDelete, tokens.TokenTruncate:
+		return finishNode(p, pos, &TriggerEvent{Kind: strings.ToUpper(p.next().Literal)}), nil
+	case tokens.TokenUpdate:
+		p.pos++ // Skip UPDATE
+		event := &TriggerEvent{Kind: "UPDATE"}
+		if p.consumeWord("OF") {
+			columns, err := p.parseIdentifiers()
+			if err != nil {
+				return nil, err
+			}
+			event.Columns = columns
+		}
+		return finishNode(p, pos, event), nil
+
+	}
+	return nil, fmt.Errorf("expected INSERT, UPDATE, DELETE or TRUNCATE, found %q at position %d", p.peek().Literal, p.pos)
+}
@@ -0,0 +1,174 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCreateType parses CREATE TYPE name [AS ENUM (label, ...) | AS (attribute type, ...)].
+func (p *Parser) parseCreateType() (*CreateTypeStatement, error) {
+	p.pos++ // Skip the TYPE token
+	name, err := p.parseQualifiedName("type name")
+	if err != nil {
+		return nil, err
+	}
+	stmt := &CreateTypeStatement{Name: name}
+	if !p.consume(tokens.TokenAs) {
+		return stmt, nil
+	}
+	switch {
+	case p.consumeWord("ENUM"):
+		stmt.Kind = TypeKindEnum
+		if _, err := p.expect(tokens.TokenLeftParen, "( after ENUM"); err != nil {
+			return nil, err
+		}
+		stmt.Labels = []string{}
+		for p.peek().Type == tokens.TokenStringLiteral {
+			stmt.Labels = append(stmt.Labels, p.next().RawValue())
+			if !p.consume(tokens.TokenComma) {
+				break
+			}
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after enum labels"); err != nil {
+			return nil, err
+		}
+	case p.consume(tokens.TokenLeftParen):
+		stmt.Kind = TypeKindComposite
+		for {
+			attribute, err := p.parseColumnDefinition()
+			if err != nil {
+				return nil, err
+			}
+			if attribute.Type == nil {
+				return nil, fmt.Errorf("missing data type for attribute %s", attribute.Name)
+			}
+			stmt.Attributes = append(stmt.Attributes, attribute)
+
+			if !p.consume(tokens.TokenComma) {
+				break
+			}
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after type attributes"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected ENUM or ( after CREATE TYPE %s AS, found %q at position %d", name, p.peek().Literal, p.pos)
+	}
+	return stmt, nil
+}
+
+// parseCreateDomain parses CREATE DOMAIN name [AS] type [COLLATE collation] [DEFAULT expr] [constraint ...].
+func (p *Parser) parseCreateDomain() (*CreateDomainStatement, error) {
+	p.pos++ // Skip the DOMAIN token
+	name, err := p.parseQualifiedName("domain name")
+	if err != nil {
+		return nil, err
+	}
+	stmt := &CreateDomainStatement{Name: name}
+	p.consume(tokens.TokenAs)
+	stmt.Type, err = p.parseDataType()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if p.consume(tokens.TokenCollate) {
+			collation, err := p.parseIdentifier("collation name")
+			if err != nil {
+				return nil, err
+			}
+			stmt.Collation = &collation
+			continue
+		}
+		constraint, err := p.parseColumnConstraint()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing constraint of domain %s: %w", name, err)
+		}
+		if constraint == nil {
+			return stmt, nil
+		}
+		stmt.Constraints = append(stmt.Constraints, constraint)
+	}
+}
+
+// parseCreateExtension parses CREATE EXTENSION [IF NOT EXISTS] name [WITH] [SCHEMA schema] [VERSION version] [CASCADE].
+func (p *Parser) parseCreateExtension() (*CreateExtensionStatement, error) {
+	p.pos++ // Skip the EXTENSION token
+	stmt := &CreateExtensionStatement{}
+	var err error
+	stmt.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Name, err = p.parseIdentifier("extension name")
+	if err != nil {
+		return nil, err
+	}
+	p.consume(tokens.TokenWith)
+	for {
+		switch {
+		case p.consumeWord("SCHEMA"):
+			schema, err := p.parseIdentifier("schema name")
+			if err != nil {
+				return nil, err
+			}
+			stmt.Schema = &schema
+		case p.consumeWord("VERSION"):
+			version := p.next()
+			if version.Type != tokens.TokenIdentifier && version.Type != tokens.TokenStringLiteral && version.Type != tokens.TokenNumericLiteral {
+				return nil, fmt.Errorf("expected extension version, found %q at position %d", version.Literal, p.pos-1)
+			}
+			value := version.RawValue()
+			stmt.Version = &value
+		case p.consumeWord("CASCADE"):
+			stmt.Cascade = true
+		default:
+			return stmt, nil
+		}
+	}
+}
+
+// parseAlterType parses ALTER TYPE name ADD VALUE [IF NOT EXISTS] 'label'
+// [BEFORE | AFTER 'label']. The ALTER token has already been consumed.
+func (p *Parser) parseAlterType() (*AlterTypeStatement, error) {
+	p.pos++ // Skip the TYPE token
+	name, err := p.parseQualifiedName("type name")
+	if err != nil {
+		return nil, err
+	}
+	stmt := &AlterTypeStatement{Name: name}
+	if !p.consumeWord("ADD") || !p.consumeWord("VALUE") {
+		return nil, fmt.Errorf("expected ADD VALUE after ALTER TYPE %s, found %q at position %d", name, p.peek().Literal, p.pos)
+	}
+	stmt.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Value, err = p.parseEnumLabel()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.consumeWord("BEFORE"):
+		label, err := p.parseEnumLabel()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Before = &label
+	case p.consumeWord("AFTER"):
+		label, err := p.parseEnumLabel()
+		if err != nil {
+			return nil, err
+		}
+		stmt.After = &label
+	}
+	return stmt, nil
+}
+
+// parseEnumLabel parses the string literal naming an enum label.
+func (p *Parser) parseEnumLabel() (string, error) {
+	if p.peek().Type != tokens.TokenStringLiteral {
+		return "", fmt.Errorf("expected enum label, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	return p.next().RawValue(), nil
+}
@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCreateView parses the remainder of CREATE [OR REPLACE] [TEMPORARY] [MATERIALIZED] VIEW,
+// starting at VIEW or MATERIALIZED.
+func (p *Parser) parseCreateView(orReplace, temporary bool) (*CreateViewStatement, error) {
+	stmt := &CreateViewStatement{OrReplace: orReplace, Temporary: temporary, Materialized: p.consumeWord("MATERIALIZED")}
+	if err := p.expectWord("VIEW"); err != nil {
+		return nil, err
+	}
+	var err error
+	stmt.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Name, err = p.parseQualifiedName("view name")
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().Type == tokens.TokenLeftParen {
+		stmt.Columns, err = p.parseIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.expect(tokens.TokenAs, "AS after view name"); err != nil {
+		return nil, err
+	}
+	stmt.Query, err = p.parseQuery()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing query of view %s: %w", stmt.Name, err)
+	}
+	if p.consume(tokens.TokenWith) {
+		switch {
+		case stmt.Materialized:
+			stmt.WithNoData = p.consumeWord("NO")
+			if err := p.expectWord("DATA"); err != nil {
+				return nil, err
+			}
+		default:
+			stmt.CheckOption = "CASCADED"
+			if p.peekWord("CASCADED") || p.peekWord("LOCAL") {
+				stmt.CheckOption = strings.ToUpper(p.next().Literal)
+			}
+			if _, err := p.expect(tokens.TokenCheck, "CHECK after WITH"); err != nil {
+				return nil, err
+			}
+			if err := p.expectWord("OPTION"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return stmt, nil
+}
+
+// parseRefreshMaterializedView parses REFRESH MATERIALIZED VIEW [CONCURRENTLY] name [WITH [NO] DATA].
+func (p *Parser) parseRefreshMaterializedView() (*RefreshMaterializedViewStatement, error) {
+	p.pos++ // Skip the REFRESH token
+	if err := p.expectWord("MATERIALIZED"); err != nil {
+		return nil, err
+	}
+	if err := p.expectWord("VIEW"); err != nil {
+		return nil, err
+	}
+	stmt := &RefreshMaterializedViewStatement{Concurrently: p.consumeWord("CONCURRENTLY")}
+	var err error
+	stmt.Name, err = p.parseQualifiedName("view name")
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(tokens.TokenWith) {
+		stmt.WithNoData = p.consumeWord("NO")
+		if err := p.expectWord("DATA"); err != nil {
+			return nil, err
+		}
+	}
+	return stmt, nil
+}
@@ -0,0 +1,206 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// intervalFields lists the fields that may restrict an INTERVAL type, from the largest to the smallest.
+var intervalFields = []string{"YEAR", "MONTH", "DAY", "HOUR", "MINUTE", "SECOND"}
+
+// parseDataType parses a data type: a possibly multi-word or schema-qualified
+// name, followed by generic arguments, interval fields, modifiers, a time zone
+// option and array bounds, as applicable.
+func (p *Parser) parseDataType() (*DataType, error) {
+	pos := p.peek().Pos
+	name, err := p.parseDataTypeName()
+	if err != nil {
+		return nil, err
+	}
+	dataType := &DataType{Name: name}
+	switch strings.ToUpper(name) {
+	case "ARRAY", "MAP", "STRUCT":
+		if p.peek().Type == tokens.TokenLessThan {
+			if err := p.parseGenericTypeArguments(dataType); err != nil {
+				return nil, err
+			}
+		}
+	case "INTERVAL":
+		dataType.IntervalFields = p.parseIntervalFields()
+	}
+	if p.consume(tokens.TokenLeftParen) {
+		modifiers, err := p.parseExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing modifiers of type %s: %w", name, err)
+		}
+		dataType.Modifiers = modifiers
+		if _, err := p.expect(tokens.TokenRightParen, ") after type modifiers"); err != nil {
+			return nil, err
+		}
+	}
+	if strings.EqualFold(p.peekAhead(1).Literal, "TIME") {
+		switch {
+		case p.peek().Type == tokens.TokenWith:
+			dataType.TimeZone = WithTimeZone
+		case p.peekWord("WITHOUT"):
+			dataType.TimeZone = WithoutTimeZone
+		}
+		if dataType.TimeZone != TimeZoneDefault {
+			p.pos += 2 // Skip WITH TIME or WITHOUT TIME
+			if err := p.expectWord("ZONE"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := p.parseArrayBounds(dataType); err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, dataType), nil
+}
+
+// parseTypedLiteral parses a type name followed by a string literal, as in
+// DATE '2024-01-01', and the trailing fields of INTERVAL '1' DAY.
+func (p *Parser) parseTypedLiteral() (Expression, error) {
+	pos := p.peek().Pos
+	dataType := finishNode(p, pos, &DataType{Name: p.next().RawValue()})
+	value := p.next().RawValue()
+	if strings.EqualFold(dataType.Name, "INTERVAL") {
+		dataType.IntervalFields = p.parseIntervalFields()
+	}
+	return finishNode(p, pos, &TypedLiteral{Type: dataType, Value: value}), nil
+}
+
+// parseDataTypeName parses the name of a data type, joining the words of
+// multi-word standard types and the parts of schema-qualified names.
+func (p *Parser) parseDataTypeName() (string, error) {
+	first, err := p.expect(tokens.TokenIdentifier, "type name")
+	if err != nil {
+		return "", err
+	}
+	name := first.RawValue()
+	switch strings.ToUpper(name) {
+	case "DOUBLE":
+		if p.peekWord("PRECISION") {
+			name += " " + p.next().Literal
+		}
+		return name, nil
+	case "NATIONAL":
+		if !p.peekWord("CHARACTER") && !p.peekWord("CHAR") {
+			return "", fmt.Errorf("expected CHARACTER or CHAR after NATIONAL, found %q at position %d", p.peek().Literal, p.pos)
+		}
+		name += " " + p.next().Literal
+		fallthrough
+	case "CHARACTER", "CHAR", "NCHAR", "BIT":
+		if p.peekWord("VARYING") {
+			name += " " + p.next().Literal
+		}
+		return name, nil
+	}
+	for p.consume(tokens.TokenDot) {
+		part, err := p.parseIdentifier("type name")
+		if err != nil {
+			return "", err
+		}
+		name += "." + part
+	}
+	return name, nil
+}
+
+// parseGenericTypeArguments parses the <...> arguments of ARRAY, MAP and STRUCT types.
+func (p *Parser) parseGenericTypeArguments(dataType *DataType) error {
+	p.pos++ // Skip <
+	isStruct := strings.EqualFold(dataType.Name, "STRUCT")
+	for {
+		if isStruct {
+			pos := p.peek().Pos
+			field := &StructField{}
+			// A member is named when its name is followed by the start of its type.
+			if p.peek().Type == tokens.TokenIdentifier && p.peekAhead(1).Type == tokens.TokenIdentifier {
+				field.Name = p.next().RawValue()
+			}
+			fieldType, err := p.parseDataType()
+			if err != nil {
+				return err
+			}
+			field.Type = fieldType
+			dataType.Fields = append(dataType.Fields, finishNode(p, pos, field))
+		} else {
+			argument, err := p.parseDataType()
+			if err != nil {
+				return err
+			}
+			dataType.TypeArguments = append(dataType.TypeArguments, argument)
+		}
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenGreaterThan, "> after type arguments"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseIntervalFields parses the optional field restriction of an INTERVAL,
+// such as DAY or YEAR TO MONTH, and returns it upper-cased, or "" if absent.
+func (p *Parser) parseIntervalFields() string {
+	from := p.consumeIntervalField()
+	if from == "" {
+		return ""
+	}
+	if p.peek().Type == tokens.TokenTo && p.peekIntervalField(1) {
+		p.pos++ // Skip TO
+		return from + " TO " + p.consumeIntervalField()
+	}
+	return from
+}
+
+// peekIntervalField reports whether the token n positions ahead is an interval field name.
+func (p *Parser) peekIntervalField(n int) bool {
+	token := p.peekAhead(n)
+	if token.Type != tokens.TokenIdentifier {
+		return false
+	}
+	for _, field := range intervalFields {
+		if strings.EqualFold(token.Literal, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// consumeIntervalField consumes an interval field name and returns it upper-cased, or "" if there is none.
+func (p *Parser) consumeIntervalField() string {
+	if !p.peekIntervalField(0) {
+		return ""
+	}
+	return strings.ToUpper(p.next().Literal)
+}
+
+// parseArrayBounds parses the [] or [n] suffixes, or the standard ARRAY [n] suffix, of an array type.
+func (p *Parser) parseArrayBounds(dataType *DataType) error {
+	if p.peekWord("ARRAY") && p.peekAhead(1).Type != tokens.TokenLessThan {
+		p.pos++ // Skip ARRAY
+		if p.peek().Type != tokens.TokenLeftBracket {
+			dataType.ArrayBounds = append(dataType.ArrayBounds, nil)
+			return nil
+		}
+	}
+	for p.consume(tokens.TokenLeftBracket) {
+		var bound Expression
+		if p.peek().Type != tokens.TokenRightBracket {
+			var err error
+			bound, err = p.parseExpression()
+			if err != nil {
+				return fmt.Errorf("error parsing array bound: %w", err)
+			}
+		}
+		if _, err := p.expect(tokens.TokenRightBracket, "] after array bound"); err != nil {
+			return err
+		}
+		dataType.ArrayBounds = append(dataType.ArrayBounds, bound)
+	}
+	return nil
+}
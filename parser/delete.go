@@ -0,0 +1,144 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseDelete parses a DELETE statement. with is the statement's WITH clause, or nil.
+func (p *Parser) parseDelete(with *WithClause) (*DeleteStatement, error) {
+	p.pos++ // Skip the DELETE token
+	stmt := &DeleteStatement{With: with}
+	var err error
+	if p.consume(tokens.TokenFrom) {
+		stmt.Tables, err = p.parseTableExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing DELETE target: %w", err)
+		}
+	} else {
+		// MySQL: DELETE t1, t2 FROM t1 JOIN t2 ...
+		stmt.Tables, err = p.parseDeleteTargets()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenFrom, "FROM after DELETE targets"); err != nil {
+			return nil, err
+		}
+		stmt.From, err = p.parseTableExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing FROM clause: %w", err)
+		}
+	}
+	if p.consume(tokens.TokenUsing) {
+		stmt.Using, err = p.parseTableExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing USING clause: %w", err)
+		}
+	}
+	if p.consume(tokens.TokenWhere) {
+		if p.peekWord("CURRENT") && strings.EqualFold(p.peekAhead(1).Literal, "OF") {
+			p.pos += 2 // Skip CURRENT OF
+			cursor, err := p.parseIdentifier("cursor name")
+			if err != nil {
+				return nil, err
+			}
+			stmt.CurrentOf = &cursor
+		} else {
+			stmt.Where, err = p.parseExpression()
+			if err != nil {
+				return nil, fmt.Errorf("error parsing WHERE clause: %w", err)
+			}
+		}
+	}
+	if p.consume(tokens.TokenOrder) {
+		stmt.OrderBy, err = p.parseOrderBy()
+		if err != nil {
+			return nil, err
+		}
+	}
+	stmt.Limit, err = p.parseLimit()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Returning, err = p.parseReturning()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseDeleteTargets parses the tables named before FROM in a MySQL multi-table
+// DELETE, each of which may be written as name.* .
+func (p *Parser) parseDeleteTargets() ([]TableExpression, error) {
+	var tables []TableExpression
+	for {
+		pos := p.peek().Pos
+		name, err := p.parseIdentifier("table name")
+		if err != nil {
+			return nil, err
+		}
+		for p.peek().Type == tokens.TokenDot {
+			if p.peekAhead(1).Literal == "*" {
+				p.pos += 2 // Skip .*
+				break
+			}
+			p.pos++ // Skip the dot
+			part, err := p.parseIdentifier("table name")
+			if err != nil {
+				return nil, err
+			}
+			name += "." + part
+		}
+		tables = append(tables, finishNode(p, pos, &TableName{Name: name}))
+
+		if !p.consume(tokens.TokenComma) {
+			return tables, nil
+		}
+	}
+}
+
+// parseTruncate parses TRUNCATE [TABLE] [ONLY] name [*] [, ...] [RESTART | CONTINUE IDENTITY] [CASCADE | RESTRICT].
+func (p *Parser) parseTruncate() (*TruncateStatement, error) {
+	p.pos++ // Skip the TRUNCATE token
+	p.consume(tokens.TokenTable)
+	stmt := &TruncateStatement{}
+	for {
+		pos := p.peek().Pos
+		table, err := p.parseTableName(p.consumeOnly())
+		if err != nil {
+			return nil, err
+		}
+		stmt.Tables = append(stmt.Tables, finishNode(p, pos, table))
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	switch {
+	case p.consumeWord("RESTART"):
+		stmt.Identity = IdentityRestart
+	case p.consumeWord("CONTINUE"):
+		stmt.Identity = IdentityContinue
+	}
+	if stmt.Identity != IdentityDefault {
+		if err := p.expectWord("IDENTITY"); err != nil {
+			return nil, err
+		}
+	}
+	stmt.Behavior = p.parseDropBehavior()
+	return stmt, nil
+}
+
+// parseDropBehavior parses an optional CASCADE or RESTRICT.
+func (p *Parser) parseDropBehavior() DropBehavior {
+	switch {
+	case p.consumeWord("CASCADE"):
+		return DropCascade
+	case p.consumeWord("RESTRICT"):
+		return DropRestrict
+	default:
+		return DropBehaviorDefault
+	}
+}
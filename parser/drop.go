@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// dropObjectKinds lists the kinds of object DROP accepts, with multi-word kinds
+// before the single words they start with.
+var dropObjectKinds = [][]string{
+	{"MATERIALIZED", "VIEW"},
+	{"FOREIGN", "TABLE"},
+	{"EVENT", "TRIGGER"},
+	{"TABLE"},
+	{"INDEX"},
+	{"VIEW"},
+	{"SCHEMA"},
+	{"SEQUENCE"},
+	{"DATABASE"},
+	{"EXTENSION"},
+	{"TYPE"},
+	{"DOMAIN"},
+	{"FUNCTION"},
+	{"PROCEDURE"},
+	{"TRIGGER"},
+	{"ROLE"},
+	{"USER"},
+	{"COLLATION"},
+	{"POLICY"},
+}
+
+// parseDrop parses DROP kind [CONCURRENTLY] [IF EXISTS] name [, ...] [CASCADE | RESTRICT].
+func (p *Parser) parseDrop() (*DropStatement, error) {
+	p.pos++ // Skip the DROP token
+	stmt := &DropStatement{}
+	for _, kind := range dropObjectKinds {
+		if p.consumeKeywords(kind...) {
+			stmt.ObjectKind = strings.Join(kind, " ")
+			break
+		}
+	}
+	if stmt.ObjectKind == "" {
+		return nil, fmt.Errorf("unsupported DROP statement: %s, at position %d", p.peek().Literal, p.pos)
+	}
+	if stmt.ObjectKind == "INDEX" {
+		stmt.Concurrently = p.consumeWord("CONCURRENTLY")
+	}
+	stmt.IfExists = p.parseIfExists()
+	var err error
+	if stmt.ObjectKind == "USER" {
+		stmt.Names, stmt.Hosts, err = p.parseGrantees()
+	} else {
+		stmt.Names, stmt.Signatures, err = p.parseObjectNames(stmt.ObjectKind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if (stmt.ObjectKind == "TRIGGER" || stmt.ObjectKind == "POLICY") && p.consume(tokens.TokenOn) {
+		table, err := p.parseQualifiedName("table name")
+		if err != nil {
+			return nil, err
+		}
+		stmt.On = &table
+	}
+	stmt.Behavior = p.parseDropBehavior()
+	return stmt, nil
+}
+
+// parseObjectNames parses a comma-separated list of names of objects of the given kind.
+// Functions, procedures and routines may be identified by their parameter types;
+// the returned signatures are parallel to the names, or nil if no name had one.
+func (p *Parser) parseObjectNames(kind string) ([]string, [][]*FunctionParameter, error) {
+	var names []string
+	var signatures [][]*FunctionParameter
+	hasSignatures := false
+	for {
+		name, err := p.parseQualifiedName(strings.ToLower(kind) + " name")
+		if err != nil {
+			return nil, nil, err
+		}
+		names = append(names, name)
+		var signature []*FunctionParameter
+		if (kind == "FUNCTION" || kind == "PROCEDURE" || kind == "ROUTINE") && p.peek().Type == tokens.TokenLeftParen {
+			signature, err = p.parseFunctionParameters()
+			if err != nil {
+				return nil, nil, err
+			}
+			hasSignatures = true
+		}
+		signatures = append(signatures, signature)
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if !hasSignatures {
+		signatures = nil
+	}
+	return names, signatures, nil
+}
@@ -0,0 +1,470 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// Operator precedence levels, from the loosest to the tightest binding.
+const (
+	precedenceOr = iota + 1
+	precedenceAnd
+	precedenceNot
+	precedenceIs
+	precedenceComparison
+	precedenceLike // LIKE, ILIKE, IN and BETWEEN
+	precedenceOther
+	precedenceAdditive
+	precedenceMultiplicative
+	precedenceAtTimeZone
+	precedenceCollate
+)
+
+// binaryPrecedence maps binary operator token types to their precedence level.
+var binaryPrecedence = map[tokens.TokenType]int{
+	tokens.TokenOr:                 precedenceOr,
+	tokens.TokenAnd:                precedenceAnd,
+	tokens.TokenEqual:              precedenceComparison,
+	tokens.TokenNotEqual:           precedenceComparison,
+	tokens.TokenLessThan:           precedenceComparison,
+	tokens.TokenLessThanOrEqual:    precedenceComparison,
+	tokens.TokenGreaterThan:        precedenceComparison,
+	tokens.TokenGreaterThanOrEqual: precedenceComparison,
+	tokens.TokenConcat:             precedenceOther,
+	tokens.TokenPlus:               precedenceAdditive,
+	tokens.TokenMinus:              precedenceAdditive,
+	tokens.TokenMultiply:           precedenceMultiplicative,
+	tokens.TokenSlash:              precedenceMultiplicative,
+	tokens.TokenPercent:            precedenceMultiplicative,
+}
+
+// parseExpression parses a complete expression, such as a select list item or a WHERE condition.
+func (p *Parser) parseExpression() (Expression, error) {
+	return p.parseBinaryExpression(precedenceOr)
+}
+
+// parseBinaryExpression parses a chain of infix operators that bind at least as
+// tightly as minPrecedence. Operators of equal precedence associate to the left.
+func (p *Parser) parseBinaryExpression(minPrecedence int) (Expression, error) {
+	// The operand may be parenthesized, so each operation's span starts at the first token rather than at left.
+	pos := p.peek().Pos
+	left, err := p.parseUnaryExpression()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		precedence := p.peekInfixPrecedence()
+		if precedence == 0 || precedence < minPrecedence {
+			return left, nil
+		}
+		left, err = p.parseInfixExpression(left, precedence)
+		if err != nil {
+			return nil, err
+		}
+		left = finishNode(p, pos, left)
+	}
+}
+
+// peekBinaryOperator returns the type of the current token as a binary operator,
+// resolving the ambiguous "*" symbol to TokenMultiply.
+func (p *Parser) peekBinaryOperator() tokens.TokenType {
+	token := p.peek()
+	if token.Type == tokens.TokenSymbol && token.Literal == "*" {
+		return tokens.TokenMultiply
+	}
+	return token.Type
+}
+
+// peekInfixPrecedence returns the precedence of the infix operator at the
+// current position, or 0 if the current token does not continue an expression.
+func (p *Parser) peekInfixPrecedence() int {
+	switch p.peek().Type {
+	case tokens.TokenIs:
+		return precedenceIs
+	case tokens.TokenNot:
+		// Only the negated forms NOT IN, NOT LIKE, NOT ILIKE and NOT BETWEEN are infix.
+		switch p.peekAhead(1).Type {
+		case tokens.TokenLike, tokens.TokenILike, tokens.TokenBetween:
+			return precedenceLike
+		case tokens.TokenIn:
+			if p.peekAhead(2).Type == tokens.TokenLeftParen {
+				return precedenceLike
+			}
+		}
+		return 0
+	case tokens.TokenIn:
+		// POSITION(a IN b) uses IN without parentheses, which ends the expression.
+		if p.peekAhead(1).Type == tokens.TokenLeftParen {
+			return precedenceLike
+		}
+		return 0
+	case tokens.TokenLike, tokens.TokenILike, tokens.TokenBetween:
+		return precedenceLike
+	case tokens.TokenCollate:
+		return precedenceCollate
+	}
+	if p.peekWord("AT") && strings.EqualFold(p.peekAhead(1).Literal, "TIME") {
+		return precedenceAtTimeZone
+	}
+	return binaryPrecedence[p.peekBinaryOperator()]
+}
+
+// parseInfixExpression parses the operator at the current position, which has
+// the given precedence, and its right-hand side, combining them with left.
+func (p *Parser) parseInfixExpression(left Expression, precedence int) (Expression, error) {
+	switch {
+	case p.consume(tokens.TokenIs):
+		return p.parseIsExpression(left)
+	case p.consume(tokens.TokenCollate):
+		collation, err := p.parseIdentifier("collation name")
+		if err != nil {
+			return nil, err
+		}
+		return &CollateExpression{Expression: left, Collation: collation}, nil
+	case p.peekWord("AT"):
+		p.pos += 2 // Skip AT TIME
+		if err := p.expectWord("ZONE"); err != nil {
+			return nil, err
+		}
+		zone, err := p.parseBinaryExpression(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &AtTimeZoneExpression{Expression: left, TimeZone: zone}, nil
+	}
+
+	not := p.consume(tokens.TokenNot)
+	switch p.peek().Type {
+	case tokens.TokenIn:
+		p.pos++ // Skip IN
+		return p.parseInExpression(left, not)
+	case tokens.TokenLike, tokens.TokenILike:
+		like := &LikeExpression{Expression: left, Not: not, CaseInsensitive: p.next().Type == tokens.TokenILike}
+		var err error
+		like.Pattern, err = p.parseBinaryExpression(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		if p.consumeWord("ESCAPE") {
+			like.Escape, err = p.parseBinaryExpression(precedence + 1)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return like, nil
+	case tokens.TokenBetween:
+		p.pos++ // Skip BETWEEN
+		between := &BetweenExpression{Expression: left, Not: not}
+		var err error
+		between.Low, err = p.parseBinaryExpression(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenAnd, "AND in BETWEEN expression"); err != nil {
+			return nil, err
+		}
+		between.High, err = p.parseBinaryExpression(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		return between, nil
+	}
+
+	operator := p.peekBinaryOperator()
+	p.pos++ // Skip the operator
+	right, err := p.parseBinaryExpression(precedence + 1)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryExpression{Left: left, Operator: operator, Right: right}, nil
+}
+
+// parseIsExpression parses the remainder of IS [NOT] {NULL | TRUE | FALSE | UNKNOWN | DISTINCT FROM expression}.
+func (p *Parser) parseIsExpression(left Expression) (Expression, error) {
+	is := &IsExpression{Expression: left, Not: p.consume(tokens.TokenNot)}
+	token := p.peek()
+	switch {
+	case token.Type == tokens.TokenNull || token.Type == tokens.TokenBooleanLiteral:
+		p.pos++ // Skip the literal
+		right, err := p.parseLiteral(token)
+		if err != nil {
+			return nil, err
+		}
+		is.Right = right
+	case p.consumeWord("UNKNOWN"):
+		// IS UNKNOWN is the boolean spelling of IS NULL.
+		is.Right = finishNode(p, token.Pos, &NullValue{})
+	case p.consume(tokens.TokenDistinct):
+		if _, err := p.expect(tokens.TokenFrom, "FROM after IS DISTINCT"); err != nil {
+			return nil, err
+		}
+		right, err := p.parseBinaryExpression(precedenceIs + 1)
+		if err != nil {
+			return nil, err
+		}
+		is.DistinctFrom = true
+		is.Right = right
+	default:
+		return nil, fmt.Errorf("expected NULL, TRUE, FALSE or DISTINCT FROM after IS, found %q at position %d", token.Literal, p.pos)
+	}
+	return is, nil
+}
+
+// parseInExpression parses the parenthesized value list or subquery following IN.
+func (p *Parser) parseInExpression(left Expression, not bool) (Expression, error) {
+	if _, err := p.expect(tokens.TokenLeftParen, "( after IN"); err != nil {
+		return nil, err
+	}
+	in := &InExpression{Expression: left, Not: not}
+	var err error
+	if p.peekQuery() {
+		in.Query, err = p.parseQuery()
+	} else {
+		in.List, err = p.parseExpressions()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IN list: %w", err)
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after IN list"); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// peekQuery reports whether the current token starts a query rather than an expression.
+func (p *Parser) peekQuery() bool {
+	return p.peek().Type == tokens.TokenSelect || p.peek().Type == tokens.TokenWith
+}
+
+// parseUnaryExpression parses prefix operators and then a postfix expression.
+func (p *Parser) parseUnaryExpression() (Expression, error) {
+	pos := p.peek().Pos
+	switch p.peek().Type {
+	case tokens.TokenNot:
+		p.pos++ // Skip NOT
+		// NOT binds more loosely than comparisons: NOT a = b is NOT (a = b).
+		operand, err := p.parseBinaryExpression(precedenceNot)
+		if err != nil {
+			return nil, err
+		}
+		return finishNode(p, pos, &UnaryExpression{Operator: tokens.TokenNot, Operand: operand}), nil
+	case tokens.TokenMinus, tokens.TokenPlus:
+		operator := p.next().Type
+		operand, err := p.parseUnaryExpression()
+		if err != nil {
+			return nil, err
+		}
+		return finishNode(p, pos, &UnaryExpression{Operator: operator, Operand: operand}), nil
+	}
+	return p.parsePostfixExpression()
+}
+
+// parsePostfixExpression parses a primary expression followed by any number of
+// PostgreSQL-style ::type casts, which bind tighter than every other operator.
+func (p *Parser) parsePostfixExpression() (Expression, error) {
+	pos := p.peek().Pos
+	expr, err := p.parsePrimaryExpression()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume(tokens.TokenDoubleColon) {
+		dataType, err := p.parseDataType()
+		if err != nil {
+			return nil, err
+		}
+		expr = finishNode(p, pos, &CastExpression{Expression: expr, Type: dataType, DoubleColon: true})
+
+	}
+	return expr, nil
+}
+
+// parsePrimaryExpression parses literals, column references, function calls,
+// special forms such as CASE and CAST, subqueries and parenthesized expressions.
+func (p *Parser) parsePrimaryExpression() (Expression, error) {
+	token := p.peek()
+	switch {
+	case token.Type == tokens.TokenLeftParen:
+		p.pos++ // Skip the opening parenthesis
+		if p.peekQuery() {
+			query, err := p.parseQuery()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokens.TokenRightParen, ") after subquery"); err != nil {
+				return nil, err
+			}
+			return finishNode(p, token.Pos, &SubqueryExpression{Query: query}), nil
+		}
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if p.consume(tokens.TokenComma) {
+			items, err := p.parseExpressions()
+			if err != nil {
+				return nil, err
+			}
+			expr = &RowExpression{Items: append([]Expression{expr}, items...)}
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		if row, ok := expr.(*RowExpression); ok {
+			finishNode(p, token.Pos, row)
+		}
+		return expr, nil
+	case token.Type == tokens.TokenValues && p.peekAhead(1).Type == tokens.TokenLeftParen:
+		// MySQL's VALUES(column) refers to the value proposed by ON DUPLICATE KEY UPDATE.
+		return p.parseFunctionCall(token.Pos, p.next().Literal)
+	case token.Type == tokens.TokenDefault:
+		p.pos++ // Skip DEFAULT
+		return finishNode(p, token.Pos, &DefaultExpression{}), nil
+	case token.Type == tokens.TokenCase:
+		return p.parseCaseExpression()
+	case token.Type == tokens.TokenCast:
+		return p.parseCastExpression()
+	case token.Type == tokens.TokenIdentifier && p.peekAhead(1).Type == tokens.TokenStringLiteral:
+		return p.parseTypedLiteral()
+	case token.Type == tokens.TokenIdentifier && p.peekAhead(1).Type == tokens.TokenLeftParen:
+		if expr, ok, err := p.parseSpecialFunction(); ok || err != nil {
+			return expr, err
+		}
+		return p.parseColumnOrFunction()
+	case token.Type == tokens.TokenIdentifier:
+		return p.parseColumnOrFunction()
+	case token.Type == tokens.TokenSymbol && token.Literal == "*":
+		p.pos++ // Skip the wildcard
+		return finishNode(p, token.Pos, &ColumnExpression{Name: "*"}), nil
+	case token.Type == tokens.TokenParameter && token.Literal == "?":
+		p.pos++ // Skip the placeholder
+		return finishNode(p, token.Pos, &PositionalParameter{Index: p.placeholderIndex(), Question: true}), nil
+	case token.Type == tokens.TokenParameter:
+		p.pos++ // Skip the parameter
+		index, err := strconv.Atoi(token.Literal[1:])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing parameter %s: %w", token.Literal, err)
+		}
+		return finishNode(p, token.Pos, &PositionalParameter{Index: index}), nil
+	case token.Type == tokens.TokenVariable:
+		p.pos++ // Skip the variable
+		return finishNode(p, token.Pos, &UserVariable{Name: token.Literal[1:]}), nil
+	case isLiteral(token.Type):
+		p.pos++ // Skip the literal
+		return p.parseLiteral(token)
+	default:
+		return nil, fmt.Errorf("unexpected token in expression: %v", token.Literal)
+	}
+}
+
+// parseColumnOrFunction parses a column reference, or a function call when the name is followed by a parenthesis.
+func (p *Parser) parseColumnOrFunction() (Expression, error) {
+	column, err := p.parseColumnExpression()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().Type == tokens.TokenLeftParen && column.Name != "*" {
+		name := column.Name
+		if column.Table != nil {
+			name = *column.Table + "." + column.Name
+		}
+		return p.parseFunctionCall(column.Pos(), name)
+	}
+	return column, nil
+}
+
+// parseFunctionCall parses the argument list and the optional FILTER and OVER
+// clauses of a call to the function name, which has already been consumed from
+// the byte offset pos.
+func (p *Parser) parseFunctionCall(pos int, name string) (*FunctionCall, error) {
+	p.pos++ // Skip the opening parenthesis
+	call := &FunctionCall{Name: name}
+	if !p.consume(tokens.TokenRightParen) {
+		switch {
+		case p.consume(tokens.TokenDistinct):
+			call.Distinct = true
+		case p.consume(tokens.TokenAll):
+		}
+		arguments, err := p.parseExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing arguments of %s: %w", name, err)
+		}
+		call.Arguments = arguments
+		if p.consume(tokens.TokenOrder) {
+			call.OrderBy, err = p.parseOrderBy()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after function arguments"); err != nil {
+			return nil, err
+		}
+	}
+	if p.peekWord("FILTER") && p.peekAhead(1).Type == tokens.TokenLeftParen {
+		p.pos += 2 // Skip FILTER (
+		if _, err := p.expect(tokens.TokenWhere, "WHERE in FILTER clause"); err != nil {
+			return nil, err
+		}
+		filter, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing FILTER clause: %w", err)
+		}
+		call.Filter = filter
+		if _, err := p.expect(tokens.TokenRightParen, ") after FILTER clause"); err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeWord("OVER") {
+		over, err := p.parseOver()
+		if err != nil {
+			return nil, err
+		}
+		call.Over = over
+	}
+	return finishNode(p, pos, call), nil
+}
+
+// parseColumnExpression parses a possibly qualified column reference such as
+// column, table.column, schema.table.column or table.*.
+func (p *Parser) parseColumnExpression() (*ColumnExpression, error) {
+	pos := p.peek().Pos
+	parts := []string{p.next().RawValue()}
+	for p.consume(tokens.TokenDot) {
+		token := p.next()
+		switch {
+		case token.Type == tokens.TokenIdentifier:
+			parts = append(parts, token.RawValue())
+		case token.Type == tokens.TokenSymbol && token.Literal == "*":
+			parts = append(parts, "*")
+			return finishNode(p, pos, newColumnExpression(parts)), nil
+		default:
+			return nil, fmt.Errorf("expected column name after %q, found %q", strings.Join(parts, "."), token.Literal)
+		}
+	}
+	return finishNode(p, pos, newColumnExpression(parts)), nil
+}
+
+// newColumnExpression builds a ColumnExpression from the dot-separated parts of a column reference.
+func newColumnExpression(parts []string) *ColumnExpression {
+	column := &ColumnExpression{Name: parts[len(parts)-1]}
+	if len(parts) > 1 {
+		table := strings.Join(parts[:len(parts)-1], ".")
+		column.Table = &table
+	}
+	return column
+}
+
+// placeholderIndex returns the 1-based index of the ? placeholder just
+// consumed among those of the current statement. It counts the tokens
+// rather than keeping a counter, since the parser may back up and reparse.
+func (p *Parser) placeholderIndex() int {
+	index := 0
+	for _, token := range p.tokens[p.start:p.pos] {
+		if token.Type == tokens.TokenParameter && token.Literal == "?" {
+			index++
+		}
+	}
+	return index
+}
@@ -0,0 +1,193 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/lexer"
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// Format parses src as a script and writes it back with the configuration,
+// each statement followed by a semicolon and a newline. Comments, both -- and
+// /* */ ones, are kept: those between statements stay on lines of their own,
+// and a comment on the last line of a statement stays at the end of it. With
+// an indent, a comment inside a statement that ends the line of a list item,
+// condition or other part of the statement, or stands on lines of its own
+// before one, stays there. Comments inside a statement placed elsewhere, or
+// written on one line without an indent, are moved to the lines before the
+// statement. A blank line between statements or comments in src is kept as a
+// single blank line.
+func (c *PrintConfig) Format(src string) (string, error) {
+	lexed := lexer.NewLexer(src).Lex()
+	stmts, err := NewParser(lexed).Parse()
+	if err != nil {
+		return "", err
+	}
+	var comments, copyData []tokens.Token
+	for _, token := range lexed {
+		switch token.Type {
+		case tokens.TokenComment:
+			comments = append(comments, token)
+		case tokens.TokenCopyData:
+			copyData = append(copyData, token)
+		}
+	}
+
+	f := &formatter{src: src}
+	for _, stmt := range stmts {
+		for len(comments) > 0 && comments[0].Pos < stmt.Pos() {
+			f.comment(comments[0], true)
+			comments = comments[1:]
+		}
+		inside := &sourceComments{src: src}
+		for len(comments) > 0 && comments[0].Pos < stmt.End() {
+			inside.pending = append(inside.pending, comments[0])
+			comments = comments[1:]
+		}
+		var d doc
+		if c.Indent > 0 {
+			d, err = c.build(stmt, inside)
+		} else {
+			d, err = c.build(stmt, nil)
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, comment := range inside.pending {
+			f.comment(comment, false)
+		}
+		f.separate(stmt.Pos())
+		f.b.WriteString(c.render(d))
+		f.last = stmt.End()
+		if hasCopyData(stmt) {
+			for len(copyData) > 0 && copyData[0].Pos < stmt.End() {
+				copyData = copyData[1:]
+			}
+			if len(copyData) > 0 {
+				f.last = copyData[0].Pos + len(copyData[0].Literal)
+				copyData = copyData[1:]
+			}
+			continue
+		}
+		f.b.WriteString(";")
+		// A comment on the line the statement ends on stays at the end of it.
+		if len(comments) > 0 && !strings.Contains(src[f.last:comments[0].Pos], "\n") {
+			f.b.WriteString(" " + commentText(comments[0]))
+			f.last = comments[0].Pos + len(commentText(comments[0]))
+			comments = comments[1:]
+		}
+		f.b.WriteString("\n")
+	}
+	for _, comment := range comments {
+		f.comment(comment, true)
+	}
+	return f.b.String(), nil
+}
+
+// sourceComments holds the comments inside a statement that are still to be
+// placed as the printer converts its nodes.
+type sourceComments struct {
+	src     string
+	pending []tokens.Token
+}
+
+// commented returns d, the doc of node, with the pending comments that stand
+// on lines of their own right before node or follow it on its last line. The
+// innermost node a comment is next to takes it, as its doc is built first.
+func (p *printer) commented(d doc, node Node) doc {
+	if p.comments == nil || d == nil || isNilNode(node) || node.End() <= node.Pos() {
+		return d
+	}
+	leading, trailing := p.comments.take(node.Pos(), node.End())
+	if leading == nil && trailing == nil {
+		return d
+	}
+	return docCommented{leading: leading, body: d, trailing: trailing}
+}
+
+// take removes from the pending comments the ones that stand on lines of
+// their own right before the source from pos to end and the ones that follow
+// it on its last line, with nothing but commas between, and returns their text.
+func (s *sourceComments) take(pos, end int) (leading, trailing []string) {
+	first := 0
+	for first < len(s.pending) && s.pending[first].Pos < pos {
+		first++
+	}
+	start, next := first, pos
+	for start > 0 {
+		comment := s.pending[start-1]
+		commentEnd := comment.Pos + len(commentText(comment))
+		lineStart := strings.LastIndexByte(s.src[:comment.Pos], '\n') + 1
+		if commentEnd > next || strings.Trim(s.src[commentEnd:next], " \t\r\n,") != "" || strings.TrimSpace(s.src[lineStart:comment.Pos]) != "" {
+			break
+		}
+		start--
+		next = comment.Pos
+	}
+	after := first
+	for after < len(s.pending) && s.pending[after].Pos < end {
+		after++
+	}
+	stop, last := after, end
+	for stop < len(s.pending) {
+		comment := s.pending[stop]
+		if gap := s.src[last:comment.Pos]; strings.Contains(gap, "\n") || strings.Trim(gap, " \t,") != "" {
+			break
+		}
+		stop++
+		last = comment.Pos + len(commentText(comment))
+	}
+	for _, comment := range s.pending[start:first] {
+		leading = append(leading, commentText(comment))
+	}
+	for _, comment := range s.pending[after:stop] {
+		trailing = append(trailing, commentText(comment))
+	}
+	s.pending = slices.Concat(s.pending[:start], s.pending[first:after], s.pending[stop:])
+	return leading, trailing
+}
+
+// formatter collects the output of Format.
+type formatter struct {
+	src  string
+	b    strings.Builder
+	last int // byte offset in src of the end of what has been written
+}
+
+// comment writes comment on a line of its own. Blank lines before it are kept
+// only if it is outside a statement.
+func (f *formatter) comment(comment tokens.Token, outside bool) {
+	if outside {
+		f.separate(comment.Pos)
+		f.last = comment.Pos + len(commentText(comment))
+	}
+	f.b.WriteString(commentText(comment) + "\n")
+}
+
+// separate writes a blank line if the source has one between what has been
+// written and the byte offset pos.
+func (f *formatter) separate(pos int) {
+	if f.b.Len() > 0 && pos > f.last && hasBlankLine(f.src[f.last:pos]) {
+		f.b.WriteString("\n")
+	}
+}
+
+// hasBlankLine reports whether s, text between two tokens, holds a line with nothing but spaces.
+func hasBlankLine(s string) bool {
+	lines := strings.Split(s, "\n")
+	if len(lines) < 3 {
+		return false
+	}
+	for _, line := range lines[1 : len(lines)-1] {
+		if strings.TrimSpace(line) == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// commentText returns the text of a comment token without its line break.
+func commentText(comment tokens.Token) string {
+	return strings.TrimRight(comment.Literal, " \t\r\n")
+}
@@ -0,0 +1,136 @@
+package parser
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		config PrintConfig
+		input  string
+		want   string
+	}{
+		{
+			name:   "without an indent each statement stays on one line",
+			config: PrintConfig{},
+			input:  "select a from t where b = 1; delete from t",
+			want:   "SELECT a FROM t WHERE b = 1;\nDELETE FROM t;\n",
+		},
+		{
+			name:   "keyword and identifier case",
+			config: PrintConfig{KeywordCase: CaseLower, IdentifierCase: CaseUpper},
+			input:  `SELECT Name, "order", "Mixed Case" FROM users WHERE id = 1 AND active`,
+			want:   "select NAME, \"order\", \"Mixed Case\" from USERS where ID = 1 and ACTIVE;\n",
+		},
+		{
+			name:   "identifier case leaves quoted names alone",
+			config: PrintConfig{IdentifierCase: CaseLower},
+			input:  `SELECT "MyCol", OtherCol FROM t WHERE "Col" = 1`,
+			want:   "SELECT \"MyCol\", othercol FROM t WHERE \"Col\" = 1;\n",
+		},
+		{
+			name:   "clauses start lines",
+			config: PrintConfig{Indent: 4},
+			input:  "select a, b from t where a = 1 and b = 2 order by a",
+			want:   "SELECT a, b\nFROM t\nWHERE a = 1 AND b = 2\nORDER BY a;\n",
+		},
+		{
+			name:   "lists and conditions wrap at the line width",
+			config: PrintConfig{Indent: 4, LineWidth: 30},
+			input:  "select first_name, last_name, email from users where active and created_at > '2024-01-01' and email is not null",
+			want: "SELECT\n    first_name,\n    last_name,\n    email\nFROM users\n" +
+				"WHERE active\n    AND created_at > '2024-01-01'\n    AND email IS NOT NULL;\n",
+		},
+		{
+			name:   "comma first",
+			config: PrintConfig{Indent: 4, LineWidth: 20, CommaFirst: true},
+			input:  "select first_name, last_name, email from users",
+			want:   "SELECT\n    first_name\n  , last_name\n  , email\nFROM users;\n",
+		},
+		{
+			name:   "river",
+			config: PrintConfig{Indent: 4, LineWidth: 30, River: true},
+			input:  "select first_name, last_name from users where active and email is not null group by first_name, last_name",
+			want: "SELECT first_name, last_name\n  FROM users\n WHERE active\n   AND email IS NOT NULL\n" +
+				" GROUP BY first_name,\n          last_name;\n",
+		},
+		{
+			name:   "subqueries and column definitions break inside parentheses",
+			config: PrintConfig{Indent: 2, LineWidth: 30},
+			input:  "create table t (id int primary key, name text not null); select * from t where exists (select 1 from u where u.id = t.id)",
+			want: "CREATE TABLE t (\n  id int PRIMARY KEY,\n  name text NOT NULL\n);\n" +
+				"SELECT *\nFROM t\nWHERE EXISTS (\n  SELECT 1\n  FROM u\n  WHERE u.id = t.id\n);\n",
+		},
+		{
+			name:   "joins start lines",
+			config: PrintConfig{Indent: 4, LineWidth: 40},
+			input:  "select * from a join b on a.id = b.a_id left join c on c.b_id = b.id and c.active where a.x = 1",
+			want: "SELECT *\nFROM a\n    JOIN b ON a.id = b.a_id\n    LEFT JOIN c ON c.b_id = b.id\n        AND c.active\n" +
+				"WHERE a.x = 1;\n",
+		},
+		{
+			name:   "joins line up under the table in a river",
+			config: PrintConfig{Indent: 4, LineWidth: 30, River: true},
+			input:  "select a.x from a join b on a.id = b.a_id join c using (id)",
+			want:   "SELECT a.x\n  FROM a\n       JOIN b ON a.id = b.a_id\n       JOIN c USING (id);\n",
+		},
+		{
+			name:   "comments between statements and blank lines are kept",
+			config: PrintConfig{},
+			input:  "-- setup\n\n\nbegin;\n-- the work\nselect 1; -- one\n\ncommit;\n-- done\n",
+			want:   "-- setup\n\nBEGIN;\n-- the work\nSELECT 1; -- one\n\nCOMMIT;\n-- done\n",
+		},
+		{
+			name:   "comments inside a statement stay on their lines",
+			config: PrintConfig{Indent: 4},
+			input:  "select a, -- the key\n  b -- the value\nfrom t where a = 1 -- first\n and b = 2;",
+			want:   "SELECT\n    a, -- the key\n    b -- the value\nFROM t\nWHERE a = 1 -- first\n    AND b = 2;\n",
+		},
+		{
+			name:   "comments on the columns of a table stay on their lines",
+			config: PrintConfig{Indent: 2, CommaFirst: true},
+			input:  "create table t (\n  id int, -- the key\n  -- the login name\n  name text /* unique */\n);",
+			want:   "CREATE TABLE t (\n  id int -- the key\n  -- the login name\n, name text /* unique */\n);\n",
+		},
+		{
+			name:   "comments inside a statement move above it without an indent",
+			config: PrintConfig{},
+			input:  "select a, -- the key\n  b -- the value\nfrom t;",
+			want:   "-- the key\n-- the value\nSELECT a, b FROM t;\n",
+		},
+		{
+			name:   "block comments are kept like line comments",
+			config: PrintConfig{},
+			input:  "/* setup\n   script */\nselect 1; /* one */\nselect /* inside */ 2;\n/* a /* nested */ comment */",
+			want:   "/* setup\n   script */\nSELECT 1; /* one */\n/* inside */\nSELECT 2;\n/* a /* nested */ comment */\n",
+		},
+		{
+			name:   "copy data is kept",
+			config: PrintConfig{},
+			input:  "copy t from stdin;\n1\t2\n\\.\n\n-- after\nselect 1",
+			want:   "COPY t FROM STDIN;\n1\t2\n\\.\n\n-- after\nSELECT 1;\n",
+		},
+		{
+			name:   "a script of comments only",
+			config: PrintConfig{},
+			input:  "-- nothing to see\n",
+			want:   "-- nothing to see\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.Format(tt.input)
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	if _, err := (&PrintConfig{}).Format("select from where"); err == nil {
+		t.Error("Format() error = nil, want a syntax error")
+	}
+}
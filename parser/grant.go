@@ -0,0 +1,378 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// privilegeNames lists the privileges GRANT and REVOKE accept besides ALL [PRIVILEGES].
+var privilegeNames = [][]string{
+	{"SELECT"},
+	{"INSERT"},
+	{"UPDATE"},
+	{"DELETE"},
+	{"TRUNCATE"},
+	{"REFERENCES"},
+	{"TRIGGER"},
+	{"CREATE"},
+	{"CONNECT"},
+	{"TEMPORARY"},
+	{"TEMP"},
+	{"EXECUTE"},
+	{"USAGE"},
+	{"MAINTAIN"},
+}
+
+// grantObjectKinds lists the kinds of object privileges can be granted on, with
+// multi-word kinds before the single words they start with.
+var grantObjectKinds = [][]string{
+	{"ALL", "TABLES", "IN", "SCHEMA"},
+	{"ALL", "SEQUENCES", "IN", "SCHEMA"},
+	{"ALL", "FUNCTIONS", "IN", "SCHEMA"},
+	{"ALL", "PROCEDURES", "IN", "SCHEMA"},
+	{"ALL", "ROUTINES", "IN", "SCHEMA"},
+	{"FOREIGN", "DATA", "WRAPPER"},
+	{"FOREIGN", "SERVER"},
+	{"TABLE"},
+	{"SEQUENCE"},
+	{"DATABASE"},
+	{"DOMAIN"},
+	{"FUNCTION"},
+	{"PROCEDURE"},
+	{"ROUTINE"},
+	{"LANGUAGE"},
+	{"SCHEMA"},
+	{"TABLESPACE"},
+	{"TYPE"},
+}
+
+// defaultPrivilegeObjectKinds lists the kinds of object ALTER DEFAULT PRIVILEGES applies to.
+var defaultPrivilegeObjectKinds = [][]string{
+	{"TABLES"},
+	{"SEQUENCES"},
+	{"FUNCTIONS"},
+	{"ROUTINES"},
+	{"TYPES"},
+	{"SCHEMAS"},
+	{"LARGE", "OBJECTS"},
+}
+
+// parseGrant parses GRANT privileges ON [kind] objects TO grantees [WITH GRANT OPTION] [GRANTED BY role]
+// and GRANT roles TO grantees [WITH ADMIN OPTION] [GRANTED BY role]. With defaults set it parses the
+// GRANT of ALTER DEFAULT PRIVILEGES, which names only a kind of object after ON.
+func (p *Parser) parseGrant(defaults bool) (*GrantStatement, error) {
+	p.pos++ // Skip GRANT
+	stmt := &GrantStatement{}
+	var err error
+	stmt.Privileges, stmt.Roles, err = p.parsePrivilegesOrRoles(defaults)
+	if err != nil {
+		return nil, err
+	}
+	if stmt.Privileges != nil {
+		stmt.ObjectKind, stmt.Objects, stmt.Signatures, err = p.parsePrivilegeObjects(defaults)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.expect(tokens.TokenTo, "TO before grantees"); err != nil {
+		return nil, err
+	}
+	stmt.Grantees, stmt.Hosts, err = p.parseGrantees()
+	if err != nil {
+		return nil, err
+	}
+	if stmt.Privileges != nil {
+		stmt.WithGrantOption = p.consumeKeywords("WITH", "GRANT", "OPTION")
+	} else {
+		stmt.WithGrantOption = p.consumeKeywords("WITH", "ADMIN", "OPTION")
+	}
+	stmt.GrantedBy, err = p.parseGrantedBy()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseRevoke parses REVOKE [GRANT OPTION FOR] privileges ON [kind] objects FROM grantees
+// and REVOKE [ADMIN OPTION FOR] roles FROM grantees, each followed by
+// [GRANTED BY role] [CASCADE | RESTRICT]. defaults is as in parseGrant.
+func (p *Parser) parseRevoke(defaults bool) (*RevokeStatement, error) {
+	p.pos++ // Skip REVOKE
+	stmt := &RevokeStatement{}
+	stmt.GrantOptionFor = p.consumeKeywords("GRANT", "OPTION", "FOR") || (!defaults && p.consumeKeywords("ADMIN", "OPTION", "FOR"))
+	var err error
+	stmt.Privileges, stmt.Roles, err = p.parsePrivilegesOrRoles(defaults)
+	if err != nil {
+		return nil, err
+	}
+	if stmt.Privileges != nil {
+		stmt.ObjectKind, stmt.Objects, stmt.Signatures, err = p.parsePrivilegeObjects(defaults)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.expect(tokens.TokenFrom, "FROM before grantees"); err != nil {
+		return nil, err
+	}
+	stmt.Grantees, stmt.Hosts, err = p.parseGrantees()
+	if err != nil {
+		return nil, err
+	}
+	stmt.GrantedBy, err = p.parseGrantedBy()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Behavior = p.parseDropBehavior()
+	return stmt, nil
+}
+
+// parsePrivilegesOrRoles parses the comma-separated list after GRANT or REVOKE,
+// which is either privileges, each with optional columns, or role names unless
+// privilegesOnly is set. Exactly one of the returned slices is non-nil.
+func (p *Parser) parsePrivilegesOrRoles(privilegesOnly bool) ([]*Privilege, []string, error) {
+	var privileges []*Privilege
+	var roles []string
+	for {
+		privilege, err := p.parsePrivilege()
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case privilege != nil && roles == nil:
+			privileges = append(privileges, privilege)
+		case privilege == nil && privileges == nil && !privilegesOnly && p.peek().Type == tokens.TokenIdentifier:
+			roles = append(roles, p.next().RawValue())
+		default:
+			return nil, nil, fmt.Errorf("expected privilege or role name, found %q at position %d", p.peek().Literal, p.pos)
+		}
+
+		if !p.consume(tokens.TokenComma) {
+			return privileges, roles, nil
+		}
+	}
+}
+
+// parsePrivilege parses a privilege with its optional column list, returning nil if none is found.
+func (p *Parser) parsePrivilege() (*Privilege, error) {
+	pos := p.peek().Pos
+	privilege := &Privilege{}
+	if p.consume(tokens.TokenAll) {
+		p.consumeWord("PRIVILEGES")
+		privilege.Name = "ALL"
+	} else {
+		for _, name := range privilegeNames {
+			if p.consumeKeywords(name...) {
+				privilege.Name = strings.Join(name, " ")
+				break
+			}
+		}
+		if privilege.Name == "" {
+			return nil, nil
+		}
+	}
+	if p.peek().Type == tokens.TokenLeftParen {
+		columns, err := p.parseIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+		privilege.Columns = columns
+	}
+	return finishNode(p, pos, privilege), nil
+
+}
+
+// parsePrivilegeObjects parses ON [kind] name [, ...], returning the kind as written or
+// an empty kind when it is omitted. With defaults set it parses ON kind of
+// ALTER DEFAULT PRIVILEGES, which names no objects.
+func (p *Parser) parsePrivilegeObjects(defaults bool) (string, []string, [][]*FunctionParameter, error) {
+	if _, err := p.expect(tokens.TokenOn, "ON after privileges"); err != nil {
+		return "", nil, nil, err
+	}
+	kinds := grantObjectKinds
+	if defaults {
+		kinds = defaultPrivilegeObjectKinds
+	}
+	kind := ""
+	for _, words := range kinds {
+		if p.consumeKeywords(words...) {
+			kind = strings.Join(words, " ")
+			break
+		}
+	}
+	switch {
+	case defaults && kind == "":
+		return "", nil, nil, fmt.Errorf("expected TABLES, SEQUENCES, FUNCTIONS, ROUTINES, TYPES, SCHEMAS or LARGE OBJECTS, found %q at position %d", p.peek().Literal, p.pos)
+	case defaults:
+		return kind, nil, nil, nil
+	case kind == "" || kind == "TABLE":
+		names, err := p.parsePrivilegeLevels()
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return kind, names, nil, nil
+	}
+	names, signatures, err := p.parseObjectNames(kind)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return kind, names, signatures, nil
+}
+
+// parsePrivilegeLevels parses the comma-separated tables privileges are granted
+// on, where each part of a name may also be * as in MySQL's db.* and *.*.
+func (p *Parser) parsePrivilegeLevels() ([]string, error) {
+	var names []string
+	for {
+		var parts []string
+		for {
+			if token := p.peek(); token.Type == tokens.TokenSymbol && token.Literal == "*" {
+				parts = append(parts, p.next().Literal)
+			} else {
+				part, err := p.parseIdentifier("table name")
+				if err != nil {
+					return nil, err
+				}
+				parts = append(parts, part)
+			}
+			if !p.consume(tokens.TokenDot) {
+				break
+			}
+		}
+		names = append(names, strings.Join(parts, "."))
+
+		if !p.consume(tokens.TokenComma) {
+			return names, nil
+		}
+	}
+}
+
+// parseGrantees parses a comma-separated list of [GROUP] role, where role may also be
+// PUBLIC, CURRENT_USER, CURRENT_ROLE or SESSION_USER, or a MySQL account
+// 'user'@'host'. The hosts are parallel to the grantees and nil unless an
+// account names one.
+func (p *Parser) parseGrantees() ([]string, []*string, error) {
+	var grantees []string
+	var hosts []*string
+	hasHosts := false
+	for {
+		p.consume(tokens.TokenGroup)
+		grantee, host, err := p.parseAccount()
+		if err != nil {
+			return nil, nil, err
+		}
+		grantees = append(grantees, grantee)
+		hosts = append(hosts, host)
+		hasHosts = hasHosts || host != nil
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if !hasHosts {
+		hosts = nil
+	}
+	return grantees, hosts, nil
+}
+
+// parseAccount parses a role name or a MySQL account 'user'@'host', whose user
+// may be written as a string. The host is nil if none is written.
+func (p *Parser) parseAccount() (string, *string, error) {
+	var name string
+	if p.peek().Type == tokens.TokenStringLiteral {
+		name = p.next().RawValue()
+	} else {
+		var err error
+		name, err = p.parseIdentifier("role name")
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	host, err := p.parseAccountHost()
+	if err != nil {
+		return "", nil, err
+	}
+	return name, host, nil
+}
+
+// parseAccountHost parses the @host part of a MySQL account, returning nil if
+// there is none. An unquoted host lexes as a user variable.
+func (p *Parser) parseAccountHost() (*string, error) {
+	if token := p.peek(); token.Type == tokens.TokenVariable {
+		host := p.next().Literal[1:]
+		return &host, nil
+	}
+	if token := p.peek(); token.Type != tokens.TokenSymbol || token.Literal != "@" {
+		return nil, nil
+	}
+	p.pos++ // Skip @
+	if p.peek().Type == tokens.TokenStringLiteral {
+		host := p.next().RawValue()
+		return &host, nil
+	}
+	host, err := p.parseIdentifier("host name")
+	if err != nil {
+		return nil, err
+	}
+	return &host, nil
+}
+
+// parseGrantedBy parses the optional GRANTED BY role clause.
+func (p *Parser) parseGrantedBy() (*string, error) {
+	if !p.consumeWord("GRANTED") {
+		return nil, nil
+	}
+	if _, err := p.expect(tokens.TokenBy, "BY after GRANTED"); err != nil {
+		return nil, err
+	}
+	role, err := p.parseIdentifier("role name")
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// parseAlterDefaultPrivileges parses ALTER DEFAULT PRIVILEGES [FOR {ROLE | USER} role, ...]
+// [IN SCHEMA schema, ...] followed by a GRANT or REVOKE. The ALTER token has already been consumed.
+func (p *Parser) parseAlterDefaultPrivileges() (*AlterDefaultPrivilegesStatement, error) {
+	p.pos++ // Skip DEFAULT
+	if err := p.expectWord("PRIVILEGES"); err != nil {
+		return nil, err
+	}
+	stmt := &AlterDefaultPrivilegesStatement{}
+	var err error
+	if p.consume(tokens.TokenFor) {
+		if !p.consumeWord("ROLE") && !p.consumeWord("USER") {
+			return nil, fmt.Errorf("expected ROLE or USER after FOR, found %q at position %d", p.peek().Literal, p.pos)
+		}
+		stmt.Roles, err = p.parseIdentifiers()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeKeywords("IN", "SCHEMA") {
+		stmt.Schemas, err = p.parseIdentifiers()
+		if err != nil {
+			return nil, err
+		}
+	}
+	pos := p.peek().Pos
+	switch {
+	case p.peekWord("GRANT"):
+		grant, err := p.parseGrant(true)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Action = finishNode(p, pos, grant)
+	case p.peekWord("REVOKE"):
+		revoke, err := p.parseRevoke(true)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Action = finishNode(p, pos, revoke)
+	default:
+		return nil, fmt.Errorf("expected GRANT or REVOKE, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	return stmt, nil
+}
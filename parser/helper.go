@@ -1,6 +1,11 @@
 package parser
 
-import "github.com/sanemat/go-sql-parser/tokens"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
 
 // get pointer of value
 // https://github.com/golang/go/issues/45624#issuecomment-1843832599
@@ -19,6 +24,34 @@ func operatorToString(op tokens.TokenType) string {
 		return "<"
 	case tokens.TokenLessThanOrEqual:
 		return "<="
+	case tokens.TokenEqual:
+		return "="
+	case tokens.TokenNotEqual:
+		return "<>"
+	case tokens.TokenPlus:
+		return "+"
+	case tokens.TokenMinus:
+		return "-"
+	case tokens.TokenMultiply:
+		return "*"
+	case tokens.TokenSlash:
+		return "/"
+	case tokens.TokenPercent:
+		return "%"
+	case tokens.TokenConcat:
+		return "||"
+	case tokens.TokenAnd:
+		return "AND"
+	case tokens.TokenOr:
+		return "OR"
+	case tokens.TokenNot:
+		return "NOT"
+	case tokens.TokenUnion:
+		return "UNION"
+	case tokens.TokenIntersect:
+		return "INTERSECT"
+	case tokens.TokenExcept:
+		return "EXCEPT"
 	// Add more cases as necessary
 	default:
 		return "unknown_operator"
@@ -30,3 +63,193 @@ func isLiteral(tokenType tokens.TokenType) bool {
 	return tokenType == tokens.TokenNumericLiteral || tokenType == tokens.TokenStringLiteral ||
 		tokenType == tokens.TokenBooleanLiteral || tokenType == tokens.TokenNull
 }
+
+// stringPrefix returns the upper-cased prefix letter of a string literal token such as E'a\tb', or "" if it has none.
+func stringPrefix(token tokens.Token) string {
+	if len(token.Literal) > 1 && token.Literal[1] == '\'' {
+		return strings.ToUpper(token.Literal[:1])
+	}
+	return ""
+}
+
+// Helper function to join the String() forms of expressions with commas
+func joinExpressions(expressions []Expression) string {
+	strs := make([]string, len(expressions))
+	for i, expr := range expressions {
+		strs[i] = expr.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to format the ORDER BY and LIMIT clauses shared by queries
+func orderByAndLimitString(orderBy []*OrderByItem, limit *Limit) string {
+	var b strings.Builder
+	if len(orderBy) > 0 {
+		fmt.Fprintf(&b, ", OrderBy: [%s]", joinOrderByItems(orderBy))
+	}
+	if limit != nil {
+		fmt.Fprintf(&b, ", Limit: %s", limit.String())
+	}
+	return b.String()
+}
+
+// Helper function to format an optional WITH clause as a prefix of a statement's fields
+func withString(with *WithClause) string {
+	if with == nil {
+		return ""
+	}
+	return fmt.Sprintf("With: %s, ", with.String())
+}
+
+// Helper function to join the String() forms of ORDER BY items with commas
+func joinOrderByItems(items []*OrderByItem) string {
+	strs := make([]string, len(items))
+	for i, item := range items {
+		strs[i] = item.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join the String() forms of assignments with commas
+func joinAssignments(assignments []*Assignment) string {
+	strs := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		strs[i] = assignment.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join the String() forms of table expressions with commas
+func joinTableExpressions(tables []TableExpression) string {
+	strs := make([]string, len(tables))
+	for i, table := range tables {
+		strs[i] = table.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join the String() forms of table elements with commas
+func joinTableElements(elements []TableElement) string {
+	strs := make([]string, len(elements))
+	for i, element := range elements {
+		strs[i] = element.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join the String() forms of sequence options with commas
+func joinSequenceOptions(options []*SequenceOption) string {
+	strs := make([]string, len(options))
+	for i, option := range options {
+		strs[i] = option.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join the String() forms of function parameters with commas
+func joinFunctionParameters(parameters []*FunctionParameter) string {
+	strs := make([]string, len(parameters))
+	for i, parameter := range parameters {
+		strs[i] = parameter.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join object names, each followed by its parenthesized signature if it has one
+func joinObjectNames(names []string, signatures [][]*FunctionParameter) string {
+	strs := make([]string, len(names))
+	for i, name := range names {
+		strs[i] = name
+		if signatures != nil && signatures[i] != nil {
+			strs[i] += fmt.Sprintf("(%s)", joinFunctionParameters(signatures[i]))
+		}
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join the String() forms of role options with commas
+func joinRoleOptions(options []*RoleOption) string {
+	strs := make([]string, len(options))
+	for i, option := range options {
+		strs[i] = option.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to format what GRANT and REVOKE apply to: privileges on objects, or roles
+func privilegeTargetString(privileges []*Privilege, roles []string, kind string, objects []string, signatures [][]*FunctionParameter) string {
+	if len(roles) > 0 {
+		return fmt.Sprintf("Roles: [%s]", strings.Join(roles, ", "))
+	}
+	strs := make([]string, len(privileges))
+	for i, privilege := range privileges {
+		strs[i] = privilege.String()
+	}
+	on := joinObjectNames(objects, signatures)
+	if kind != "" {
+		on = strings.TrimSuffix(kind+" "+on, " ")
+	}
+	return fmt.Sprintf("Privileges: [%s], On: %s", strings.Join(strs, ", "), on)
+}
+
+// joinGrantees joins the grantees of GRANT or REVOKE with commas, writing MySQL accounts as user@host.
+func joinGrantees(grantees []string, hosts []*string) string {
+	strs := make([]string, len(grantees))
+	for i, grantee := range grantees {
+		strs[i] = grantee
+		if hosts != nil {
+			strs[i] = accountName(grantee, hosts[i])
+		}
+	}
+	return strings.Join(strs, ", ")
+}
+
+// accountName returns name, followed by @host if host is not nil.
+func accountName(name string, host *string) string {
+	if host == nil {
+		return name
+	}
+	return name + "@" + *host
+}
+
+// Helper function to join the String() forms of utility options with commas
+func joinUtilityOptions(options []*UtilityOption) string {
+	strs := make([]string, len(options))
+	for i, option := range options {
+		strs[i] = option.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to join the String() forms of vacuum relations with commas
+func joinVacuumRelations(relations []*VacuumRelation) string {
+	strs := make([]string, len(relations))
+	for i, relation := range relations {
+		strs[i] = relation.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Helper function to format an optional RETURNING list as a trailing field
+func returningString(returning []Expression) string {
+	if len(returning) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", Returning: [%s]", joinExpressions(returning))
+}
+
+// deferrableString returns the [NOT] DEFERRABLE and INITIALLY clauses of a constraint, each preceded by a space.
+func deferrableString(deferrable, initiallyDeferred *bool) string {
+	var b strings.Builder
+	if deferrable != nil && *deferrable {
+		b.WriteString(" DEFERRABLE")
+	} else if deferrable != nil {
+		b.WriteString(" NOT DEFERRABLE")
+	}
+	if initiallyDeferred != nil && *initiallyDeferred {
+		b.WriteString(" INITIALLY DEFERRED")
+	} else if initiallyDeferred != nil {
+		b.WriteString(" INITIALLY IMMEDIATE")
+	}
+	return b.String()
+}
@@ -0,0 +1,222 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseInsert parses an INSERT statement. with is the statement's WITH clause, or nil.
+func (p *Parser) parseInsert(with *WithClause) (*InsertStatement, error) {
+	p.pos++ // Skip the INSERT token
+	if _, err := p.expect(tokens.TokenInto, "INTO after INSERT"); err != nil {
+		return nil, err
+	}
+	pos := p.peek().Pos
+	name, err := p.parseQualifiedName("table name")
+	if err != nil {
+		return nil, err
+	}
+	table := &TableName{Name: name}
+	if p.consume(tokens.TokenAs) {
+		alias, err := p.parseIdentifier("table alias")
+		if err != nil {
+			return nil, err
+		}
+		table.Alias = &alias
+	}
+	stmt := &InsertStatement{With: with, Table: finishNode(p, pos, table)}
+	// A parenthesis after the table starts either the column list or a parenthesized query.
+	if p.peek().Type == tokens.TokenLeftParen && p.peekAhead(1).Type == tokens.TokenIdentifier {
+		stmt.Columns, err = p.parseIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeWord("OVERRIDING") {
+		switch {
+		case p.consumeWord("SYSTEM"):
+			stmt.Overriding = OverridingSystemValue
+		case p.consumeWord("USER"):
+			stmt.Overriding = OverridingUserValue
+		default:
+			return nil, fmt.Errorf("expected SYSTEM or USER after OVERRIDING, found %q at position %d", p.peek().Literal, p.pos)
+		}
+		if err := p.expectWord("VALUE"); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case p.peek().Type == tokens.TokenDefault:
+		p.pos++ // Skip DEFAULT
+		if _, err := p.expect(tokens.TokenValues, "VALUES after DEFAULT"); err != nil {
+			return nil, err
+		}
+		stmt.DefaultValues = true
+	case p.consume(tokens.TokenValues):
+		stmt.Values, err = p.parseValuesRows()
+		if err != nil {
+			return nil, err
+		}
+	case p.peekQuery() || p.peek().Type == tokens.TokenLeftParen:
+		stmt.Query, err = p.parseQuery()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing INSERT query: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("expected VALUES, DEFAULT VALUES or a query, found %q at position %d", p.peek().Literal, p.pos)
+	}
+
+	if p.peek().Type == tokens.TokenOn {
+		switch {
+		case strings.EqualFold(p.peekAhead(1).Literal, "CONFLICT"):
+			p.pos += 2 // Skip ON CONFLICT
+			stmt.OnConflict, err = p.parseOnConflict()
+			if err != nil {
+				return nil, fmt.Errorf("error parsing ON CONFLICT clause: %w", err)
+			}
+		case strings.EqualFold(p.peekAhead(1).Literal, "DUPLICATE"):
+			p.pos += 2 // Skip ON DUPLICATE
+			if err := p.expectWord("KEY"); err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokens.TokenUpdate, "UPDATE after ON DUPLICATE KEY"); err != nil {
+				return nil, err
+			}
+			stmt.OnDuplicateKeyUpdate, err = p.parseAssignments()
+			if err != nil {
+				return nil, fmt.Errorf("error parsing ON DUPLICATE KEY UPDATE clause: %w", err)
+			}
+		}
+	}
+	stmt.Returning, err = p.parseReturning()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseOnConflict parses the conflict target and action of an ON CONFLICT clause.
+// The ON CONFLICT tokens have already been consumed.
+func (p *Parser) parseOnConflict() (*OnConflict, error) {
+	pos := p.posBefore(2)
+	onConflict := &OnConflict{}
+	var err error
+	switch {
+	case p.consume(tokens.TokenLeftParen):
+		onConflict.Target, err = p.parseExpressions()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after conflict target"); err != nil {
+			return nil, err
+		}
+		if p.consume(tokens.TokenWhere) {
+			onConflict.TargetWhere, err = p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+		}
+	case p.consume(tokens.TokenOn):
+		if _, err := p.expect(tokens.TokenConstraint, "CONSTRAINT after ON"); err != nil {
+			return nil, err
+		}
+		constraint, err := p.parseIdentifier("constraint name")
+		if err != nil {
+			return nil, err
+		}
+		onConflict.Constraint = &constraint
+	}
+	if err := p.expectWord("DO"); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.consumeWord("NOTHING"):
+		onConflict.Action = ConflictDoNothing
+	case p.consume(tokens.TokenUpdate):
+		onConflict.Action = ConflictDoUpdate
+		if _, err := p.expect(tokens.TokenSet, "SET after DO UPDATE"); err != nil {
+			return nil, err
+		}
+		onConflict.Assignments, err = p.parseAssignments()
+		if err != nil {
+			return nil, err
+		}
+		if p.consume(tokens.TokenWhere) {
+			onConflict.Where, err = p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("expected NOTHING or UPDATE after DO, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	return finishNode(p, pos, onConflict), nil
+}
+
+// parseAssignments parses a comma-separated list of column = value assignments,
+// including tuple assignments of the form (a, b) = (1, 2).
+func (p *Parser) parseAssignments() ([]*Assignment, error) {
+	var assignments []*Assignment
+	for {
+		pos := p.peek().Pos
+		assignment := &Assignment{}
+		var err error
+		if p.peek().Type == tokens.TokenLeftParen {
+			assignment.Columns, err = p.parseIdentifierList()
+		} else {
+			assignment.Column, err = p.parseQualifiedName("column name")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenEqual, "= in assignment"); err != nil {
+			return nil, err
+		}
+		assignment.Value, err = p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing assigned value: %w", err)
+		}
+		assignments = append(assignments, finishNode(p, pos, assignment))
+
+		if !p.consume(tokens.TokenComma) {
+			return assignments, nil
+		}
+	}
+}
+
+// parseReturning parses an optional RETURNING list, returning nil if there is none.
+func (p *Parser) parseReturning() ([]Expression, error) {
+	if !p.consume(tokens.TokenReturning) {
+		return nil, nil
+	}
+	returning, err := p.parseSelectItems()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing RETURNING clause: %w", err)
+	}
+	return returning, nil
+}
+
+// parseValuesRows parses the parenthesized rows of a VALUES list. The VALUES token has already been consumed.
+func (p *Parser) parseValuesRows() ([][]Expression, error) {
+	var rows [][]Expression
+	for {
+		if _, err := p.expect(tokens.TokenLeftParen, "( before VALUES row"); err != nil {
+			return nil, err
+		}
+		row, err := p.parseExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing VALUES row %d: %w", len(rows)+1, err)
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after VALUES row"); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+
+		if !p.consume(tokens.TokenComma) {
+			return rows, nil
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package parser
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// layout writes docs broken into lines: each clause of a statement starts a
+// line, and lists, conditions and parenthesized forms are wrapped when they do
+// not fit in the line width.
+type layout struct {
+	config   *PrintConfig
+	b        strings.Builder
+	column   int      // column of the next character written, counted in runes
+	indent   int      // column the current line starts at
+	comments []string // comments to end the current line with
+}
+
+// layout writes d broken into lines.
+func (c *PrintConfig) layout(d doc) string {
+	l := &layout{config: c}
+	l.doc(d, 0)
+	if len(l.comments) > 0 {
+		l.newline(0)
+	}
+	return l.b.String()
+}
+
+// lineWidth returns the configured line width, or the default if none is set.
+func (c *PrintConfig) lineWidth() int {
+	if c.LineWidth > 0 {
+		return c.LineWidth
+	}
+	return defaultLineWidth
+}
+
+// write writes s. Comments ending the current line are written first, and
+// followed by a line break unless s starts one; a comma stays before them.
+func (l *layout) write(s string) {
+	if len(l.comments) > 0 && s != "," {
+		comments := " " + strings.Join(l.comments, " ")
+		l.comments = nil
+		if !strings.HasPrefix(s, "\n") {
+			comments += "\n" + strings.Repeat(" ", l.indent)
+		}
+		l.write(comments)
+	}
+	l.b.WriteString(s)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		l.column = utf8.RuneCountInString(s[i+1:])
+	} else {
+		l.column += utf8.RuneCountInString(s)
+	}
+}
+
+// newline starts a new line indented to column indent.
+func (l *layout) newline(indent int) {
+	l.write("\n" + strings.Repeat(" ", max(indent, 0)))
+	l.indent = max(indent, 0)
+}
+
+func (l *layout) flat(d doc) string {
+	var b strings.Builder
+	l.config.renderFlat(&b, d)
+	return b.String()
+}
+
+// fits reports whether prefix followed by d written on a single line ends
+// within the line width when written at the current column.
+func (l *layout) fits(prefix string, d doc) bool {
+	s := prefix + l.flat(d)
+	return !strings.Contains(s, "\n") && l.column+utf8.RuneCountInString(s) <= l.config.lineWidth()
+}
+
+// doc writes d, breaking it into lines where it does not fit. Lines continuing
+// d are indented relative to column indent.
+func (l *layout) doc(d doc, indent int) {
+	switch d := d.(type) {
+	case docClauses:
+		l.clauses(d, indent)
+	case docClause:
+		l.clause(d, indent, riverWidth(d.keyword))
+	case docConcat:
+		for _, part := range d {
+			l.doc(part, indent)
+		}
+	case docList:
+		if l.fits("", d) {
+			l.write(l.flat(d))
+			return
+		}
+		l.list(d, l.column)
+	case docParens:
+		l.parens(d, indent)
+	case docChain:
+		if l.fits("", d) {
+			l.write(l.flat(d))
+			return
+		}
+		l.chain(d, indent, false)
+	case docJoins:
+		if l.fits("", d) {
+			l.write(l.flat(d))
+			return
+		}
+		l.joins(d, indent+l.config.Indent)
+	case docCommented:
+		column := l.column
+		for _, comment := range d.leading {
+			l.write(comment)
+			l.newline(column)
+		}
+		l.doc(d.body, indent)
+		l.comments = append(l.comments, d.trailing...)
+	default:
+		l.write(l.flat(d))
+	}
+}
+
+// riverWidth returns the width of the first word of keyword, which is the part
+// of a clause keyword aligned to the river.
+func riverWidth(keyword string) int {
+	if i := strings.IndexByte(keyword, ' '); i >= 0 {
+		return i
+	}
+	return len(keyword)
+}
+
+// clauses writes each clause on a line of its own starting at column indent.
+// A list among the clauses, such as the actions of ALTER TABLE, is indented
+// below them with one item per line.
+func (l *layout) clauses(d docClauses, indent int) {
+	width := 0
+	if l.config.River {
+		for _, item := range d {
+			if c, ok := item.(docClause); ok {
+				width = max(width, riverWidth(c.keyword))
+			}
+		}
+	}
+	for i, item := range d {
+		if i > 0 {
+			l.newline(indent)
+		}
+		switch item := item.(type) {
+		case docClause:
+			if l.config.River {
+				l.write(strings.Repeat(" ", width-riverWidth(item.keyword)))
+				l.clause(item, indent, width)
+			} else {
+				l.clause(item, indent, 0)
+			}
+		case docList:
+			offset := l.config.Indent
+			if l.config.River && width > 0 {
+				offset = width + 1
+			}
+			l.write(strings.Repeat(" ", offset))
+			l.list(item, indent+offset)
+		default:
+			l.doc(item, indent)
+		}
+	}
+}
+
+// clause writes a clause starting at the current column, within clauses
+// starting at column indent whose river, if any, is width columns from indent.
+func (l *layout) clause(c docClause, indent, width int) {
+	l.write(l.config.keyword(c.keyword))
+	if c.body == nil {
+		return
+	}
+	if l.fits(" ", c.body) {
+		l.write(" " + l.flat(c.body))
+		return
+	}
+	river := l.config.River
+	nested := indent + l.config.Indent
+	body := c.body
+	if list, ok := body.(docList); ok && len(list) == 1 {
+		// A join alone in a FROM list breaks as if it were not in a list.
+		if joins, ok := list[0].(docJoins); ok {
+			body = joins
+		}
+	}
+	switch body := body.(type) {
+	case docList:
+		if len(body) < 2 {
+			break
+		}
+		if river {
+			l.write(" ")
+			l.list(body, l.column)
+		} else {
+			l.newline(nested)
+			l.list(body, nested)
+		}
+		return
+	case docChain:
+		l.write(" ")
+		if river {
+			l.chain(body, indent+width, true)
+		} else {
+			l.chain(body, nested, false)
+		}
+		return
+	case docJoins:
+		l.write(" ")
+		if river {
+			l.joins(body, l.column)
+		} else {
+			l.joins(body, nested)
+		}
+		return
+	case docClauses:
+		if river {
+			l.write(" ")
+			l.clauses(body, l.column)
+		} else {
+			l.newline(nested)
+			l.clauses(body, nested)
+		}
+		return
+	}
+	l.write(" ")
+	l.doc(c.body, indent)
+}
+
+// list writes one item of d per line, with the items starting at column.
+func (l *layout) list(d docList, column int) {
+	for i, item := range d {
+		if i > 0 {
+			if l.config.CommaFirst {
+				// Comments on lines of their own before an item go before its comma.
+				if c, ok := item.(docCommented); ok {
+					for _, comment := range c.leading {
+						l.newline(column)
+						l.write(comment)
+					}
+					c.leading = nil
+					item = c
+				}
+				l.newline(column - 2)
+				l.write(", ")
+			} else {
+				l.write(",")
+				l.newline(column)
+			}
+		}
+		l.doc(item, column)
+	}
+}
+
+// chain writes the operands of d, starting each but the first on a new line
+// with the operator. The operators start at column indent, or end there if
+// alignRight is set.
+func (l *layout) chain(d docChain, indent int, alignRight bool) {
+	operator := l.config.keyword(d.operator)
+	column := indent
+	if alignRight {
+		column = indent - len(operator)
+	}
+	for i, operand := range d.operands {
+		if i > 0 {
+			l.newline(column)
+			l.write(operator + " ")
+		}
+		l.doc(operand, l.column)
+	}
+}
+
+// joins writes the table of d and then each join on a new line starting at
+// column, with the lines continuing a join indented from there.
+func (l *layout) joins(d docJoins, column int) {
+	l.doc(d.table, column)
+	for _, join := range d.joins {
+		l.newline(column)
+		l.doc(join, column+l.config.Indent)
+	}
+}
+
+// parens writes d on a single line if it fits. Otherwise a query or list in the
+// parentheses is written on lines of its own indented from column indent, and
+// anything else continues on lines aligned after the opening parenthesis.
+func (l *layout) parens(d docParens, indent int) {
+	if l.fits("", d) {
+		l.write(l.flat(d))
+		return
+	}
+	nested := indent + l.config.Indent
+	switch body := d.body.(type) {
+	case docClauses:
+		l.write("(")
+		l.newline(nested)
+		l.clauses(body, nested)
+	case docList:
+		l.write("(")
+		l.newline(nested)
+		l.list(body, nested)
+	default:
+		l.write("(")
+		l.doc(d.body, l.column)
+		l.write(")")
+		return
+	}
+	l.newline(indent)
+	l.write(")")
+}
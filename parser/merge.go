@@ -0,0 +1,145 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseMerge parses a MERGE statement. with is the statement's WITH clause, or nil.
+func (p *Parser) parseMerge(with *WithClause) (*MergeStatement, error) {
+	p.pos++ // Skip MERGE
+	p.consume(tokens.TokenInto)
+	stmt := &MergeStatement{With: with}
+	pos := p.peek().Pos
+	name, err := p.parseQualifiedName("MERGE target table")
+	if err != nil {
+		return nil, err
+	}
+	alias, err := p.parseTableAlias()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Target = finishNode(p, pos, &TableName{Name: name, Alias: alias})
+	if _, err := p.expect(tokens.TokenUsing, "USING after MERGE target"); err != nil {
+		return nil, err
+	}
+	stmt.Source, err = p.parseTablePrimary()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing MERGE source: %w", err)
+	}
+	if _, err := p.expect(tokens.TokenOn, "ON after MERGE source"); err != nil {
+		return nil, err
+	}
+	stmt.On, err = p.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing MERGE condition: %w", err)
+	}
+	for p.peek().Type == tokens.TokenWhen {
+		clause, err := p.parseMergeWhenClause()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Clauses = append(stmt.Clauses, clause)
+	}
+	if len(stmt.Clauses) == 0 {
+		return nil, fmt.Errorf("expected WHEN clause, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	stmt.Returning, err = p.parseReturning()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseMergeWhenClause parses WHEN [NOT] MATCHED [BY SOURCE | BY TARGET] [AND condition] THEN action.
+func (p *Parser) parseMergeWhenClause() (*MergeWhenClause, error) {
+	pos := p.next().Pos // Skip WHEN
+	clause := &MergeWhenClause{}
+	notMatched := p.consume(tokens.TokenNot)
+	if err := p.expectWord("MATCHED"); err != nil {
+		return nil, err
+	}
+	if notMatched {
+		clause.Match = MergeNotMatched
+		if p.consume(tokens.TokenBy) {
+			switch {
+			case p.consumeWord("SOURCE"):
+				clause.Match = MergeNotMatchedBySource
+			case p.consumeWord("TARGET"):
+			default:
+				return nil, fmt.Errorf("expected SOURCE or TARGET after NOT MATCHED BY, found %q at position %d", p.peek().Literal, p.pos)
+			}
+		}
+	}
+	var err error
+	if p.consume(tokens.TokenAnd) {
+		clause.Condition, err = p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing WHEN condition: %w", err)
+		}
+	}
+	if _, err := p.expect(tokens.TokenThen, "THEN after WHEN condition"); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.consumeKeywords("DO", "NOTHING"):
+		clause.Action = MergeDoNothing
+	case p.consume(tokens.TokenUpdate):
+		clause.Action = MergeUpdate
+		if _, err := p.expect(tokens.TokenSet, "SET after UPDATE"); err != nil {
+			return nil, err
+		}
+		clause.Assignments, err = p.parseAssignments()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing SET clause: %w", err)
+		}
+	case p.consume(tokens.TokenDelete):
+		clause.Action = MergeDelete
+	case p.consume(tokens.TokenInsert):
+		clause.Action = MergeInsert
+		if err := p.parseMergeInsert(clause); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected UPDATE, DELETE, INSERT or DO NOTHING after THEN, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if clause.Match == MergeNotMatched && (clause.Action == MergeUpdate || clause.Action == MergeDelete) {
+		return nil, fmt.Errorf("%s is not allowed in WHEN NOT MATCHED, at position %d", clause.Action, p.pos)
+	}
+	if clause.Match != MergeNotMatched && clause.Action == MergeInsert {
+		return nil, fmt.Errorf("INSERT is only allowed in WHEN NOT MATCHED, at position %d", p.pos)
+	}
+	return finishNode(p, pos, clause), nil
+
+}
+
+// parseMergeInsert parses the [(columns)] {VALUES (expressions) | DEFAULT VALUES} of a MERGE INSERT action.
+func (p *Parser) parseMergeInsert(clause *MergeWhenClause) error {
+	var err error
+	if p.peek().Type == tokens.TokenLeftParen {
+		clause.Columns, err = p.parseIdentifierList()
+		if err != nil {
+			return err
+		}
+	}
+	if p.consume(tokens.TokenDefault) {
+		if _, err := p.expect(tokens.TokenValues, "VALUES after DEFAULT"); err != nil {
+			return err
+		}
+		clause.DefaultValues = true
+		return nil
+	}
+	if _, err := p.expect(tokens.TokenValues, "VALUES in MERGE INSERT"); err != nil {
+		return err
+	}
+	rows, err := p.parseValuesRows()
+	if err != nil {
+		return err
+	}
+	if len(rows) != 1 {
+		return fmt.Errorf("expected a single VALUES row in MERGE INSERT, found %d", len(rows))
+	}
+	clause.Values = rows[0]
+	return nil
+}
@@ -0,0 +1,126 @@
+package parser
+
+// span records the byte offsets of the input a node was parsed from, and the
+// names written as quoted identifiers within them. It is embedded in every node
+// type to implement the Pos and End methods of Node.
+type span struct {
+	pos, end int
+	quoted   []string
+}
+
+func (s *span) Pos() int { return s.pos }
+
+func (s *span) End() int { return s.end }
+
+func (s *span) setSpan(pos, end int) {
+	s.pos, s.end = pos, end
+}
+
+// quotedNames returns the names written as quoted identifiers in the node,
+// which the printer quotes again whatever their spelling.
+func (s *span) quotedNames() []string { return s.quoted }
+
+func (s *span) setQuotedNames(names []string) {
+	s.quoted = names
+}
+
+// spanned is implemented by the node types through their embedded span.
+type spanned interface {
+	setSpan(pos, end int)
+	quotedNames() []string
+	setQuotedNames(names []string)
+}
+
+// appendNodes appends the non-nil nodes to list. Children implementations pass
+// optional fields straight through, so nil pointers and nil interfaces are
+// skipped here as well, by comparing each node with the zero value of its type.
+func appendNodes[T interface {
+	Node
+	comparable
+}](list []Node, nodes ...T) []Node {
+	var zero T
+	for _, node := range nodes {
+		if node == zero {
+			continue
+		}
+		list = append(list, node)
+	}
+	return list
+}
+
+// statementNode() ensures that only statement nodes can be assigned to a Statement.
+func (*SelectStatement) statementNode()                  {}
+func (*SetOperation) statementNode()                     {}
+func (*InsertStatement) statementNode()                  {}
+func (*UpdateStatement) statementNode()                  {}
+func (*DeleteStatement) statementNode()                  {}
+func (*MergeStatement) statementNode()                   {}
+func (*TruncateStatement) statementNode()                {}
+func (*CreateTableStatement) statementNode()             {}
+func (*AlterTableStatement) statementNode()              {}
+func (*CreateIndexStatement) statementNode()             {}
+func (*CreateViewStatement) statementNode()              {}
+func (*RefreshMaterializedViewStatement) statementNode() {}
+func (*CreateSchemaStatement) statementNode()            {}
+func (*CreateSequenceStatement) statementNode()          {}
+func (*AlterSequenceStatement) statementNode()           {}
+func (*DropStatement) statementNode()                    {}
+func (*CreateFunctionStatement) statementNode()          {}
+func (*CreateTriggerStatement) statementNode()           {}
+func (*CreateTypeStatement) statementNode()              {}
+func (*AlterTypeStatement) statementNode()               {}
+func (*CreateDomainStatement) statementNode()            {}
+func (*CreateExtensionStatement) statementNode()         {}
+func (*BeginStatement) statementNode()                   {}
+func (*CommitStatement) statementNode()                  {}
+func (*RollbackStatement) statementNode()                {}
+func (*SavepointStatement) statementNode()               {}
+func (*ReleaseSavepointStatement) statementNode()        {}
+func (*SetStatement) statementNode()                     {}
+func (*SetTransactionStatement) statementNode()          {}
+func (*ResetStatement) statementNode()                   {}
+func (*ShowStatement) statementNode()                    {}
+func (*UseStatement) statementNode()                     {}
+func (*GrantStatement) statementNode()                   {}
+func (*RevokeStatement) statementNode()                  {}
+func (*AlterDefaultPrivilegesStatement) statementNode()  {}
+func (*CreateRoleStatement) statementNode()              {}
+func (*AlterRoleStatement) statementNode()               {}
+func (*ExplainStatement) statementNode()                 {}
+func (*PrepareStatement) statementNode()                 {}
+func (*ExecuteStatement) statementNode()                 {}
+func (*DeallocateStatement) statementNode()              {}
+func (*AnalyzeStatement) statementNode()                 {}
+func (*VacuumStatement) statementNode()                  {}
+func (*CopyStatement) statementNode()                    {}
+func (*LoadDataStatement) statementNode()                {}
+
+// expressionNode() ensures that only expression nodes can be assigned to an Expression.
+func (*AliasedExpression) expressionNode()    {}
+func (*ColumnExpression) expressionNode()     {}
+func (*BinaryExpression) expressionNode()     {}
+func (*FunctionCall) expressionNode()         {}
+func (*TypedLiteral) expressionNode()         {}
+func (*CaseExpression) expressionNode()       {}
+func (*CastExpression) expressionNode()       {}
+func (*ExtractExpression) expressionNode()    {}
+func (*PositionExpression) expressionNode()   {}
+func (*SubstringExpression) expressionNode()  {}
+func (*TrimExpression) expressionNode()       {}
+func (*CollateExpression) expressionNode()    {}
+func (*AtTimeZoneExpression) expressionNode() {}
+func (*IsExpression) expressionNode()         {}
+func (*InExpression) expressionNode()         {}
+func (*BetweenExpression) expressionNode()    {}
+func (*LikeExpression) expressionNode()       {}
+func (*SubqueryExpression) expressionNode()   {}
+func (*RowExpression) expressionNode()        {}
+func (*ExistsExpression) expressionNode()     {}
+func (*UnaryExpression) expressionNode()      {}
+func (*NumericLiteral) expressionNode()       {}
+func (*StringLiteral) expressionNode()        {}
+func (*DefaultExpression) expressionNode()    {}
+func (*NullValue) expressionNode()            {}
+func (*BooleanLiteral) expressionNode()       {}
+func (*PositionalParameter) expressionNode()  {}
+func (*UserVariable) expressionNode()         {}
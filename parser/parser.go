@@ -2,6 +2,8 @@ package parser
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/sanemat/go-sql-parser/tokens"
 )
@@ -10,33 +12,114 @@ import (
 type Parser struct {
 	tokens []tokens.Token
 	pos    int // current position in the token slice
+	start  int // position of the first token of the statement being parsed
 }
 
-// NewParser creates a new Parser instance.
-func NewParser(tokens []tokens.Token) *Parser {
+// NewParser creates a new Parser instance. Comment tokens are dropped, as they
+// may appear anywhere between the tokens of a statement.
+func NewParser(lexed []tokens.Token) *Parser {
+	significant := make([]tokens.Token, 0, len(lexed))
+	for _, token := range lexed {
+		if token.Type != tokens.TokenComment {
+			significant = append(significant, token)
+		}
+	}
 	return &Parser{
-		tokens: tokens,
+		tokens: significant,
 		pos:    0,
 	}
 }
 
 // Parse starts the parsing process and returns the ASTs
-func (p *Parser) Parse() ([]Node, error) {
-	var nodes []Node
+func (p *Parser) Parse() ([]Statement, error) {
+	var nodes []Statement
 	for p.peek().Type != tokens.TokenEOF {
+		p.start = p.pos
 		node, err := p.parseStatement()
 		if err != nil {
-			return nil, fmt.Errorf("parseStatement, err: %w", err)
+			return nil, p.syntaxError(fmt.Errorf("parseStatement, err: %w", err))
 		}
 		nodes = append(nodes, node)
 
-		if p.peek().Type == tokens.TokenSemicolon {
+		switch p.peek().Type {
+		case tokens.TokenSemicolon:
 			p.pos++ // Advance past the semicolon only if it's present
+			// The lexer emits the inline rows of COPY ... FROM STDIN right after its semicolon.
+			if copyStmt, ok := node.(*CopyStatement); ok && p.peek().Type == tokens.TokenCopyData {
+				data := p.next().Literal
+				copyStmt.Data = &data
+			}
+		case tokens.TokenEOF:
+		default:
+			return nil, p.syntaxError(fmt.Errorf("unexpected token %q at position %d, expected end of statement", p.peek().Literal, p.pos))
 		}
 	}
 	return nodes, nil
 }
 
+// SyntaxError is the error Parse returns for input it cannot parse.
+type SyntaxError struct {
+	Pos int // byte offset of the token at which parsing failed
+	Err error
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// syntaxError returns err located at the current token, or at the end of the
+// input if all tokens have been consumed.
+func (p *Parser) syntaxError(err error) *SyntaxError {
+	pos := 0
+	if p.pos < len(p.tokens) {
+		pos = p.tokens[p.pos].Pos
+	} else if len(p.tokens) > 0 {
+		last := p.tokens[len(p.tokens)-1]
+		pos = last.Pos + len(last.Literal)
+	}
+	return &SyntaxError{Pos: pos, Err: err}
+}
+
+// finishNode sets the span of node to run from the byte offset pos to the end
+// of the last consumed token, records the names quoted within it, and returns the node.
+func finishNode[T Node](p *Parser, pos int, node T) T {
+	end := pos
+	if last := min(p.pos, len(p.tokens)) - 1; last >= 0 {
+		end = max(end, p.tokens[last].Pos+len(p.tokens[last].Literal))
+	}
+	if s, ok := any(node).(spanned); ok {
+		s.setSpan(pos, end)
+		s.setQuotedNames(p.quotedNames(pos))
+	}
+	return node
+}
+
+// quotedNames returns the names of the quoted identifiers consumed since the
+// byte offset pos, in source order, or nil if there are none. PostgreSQL folds
+// unquoted names to lower case, so a quoted name such as "MyCol" must stay
+// quoted when printed.
+func (p *Parser) quotedNames(pos int) []string {
+	var names []string
+	for i := min(p.pos, len(p.tokens)) - 1; i >= 0 && p.tokens[i].Pos >= pos; i-- {
+		token := p.tokens[i]
+		if token.Type == tokens.TokenIdentifier && token.Literal != token.RawValue() && !slices.Contains(names, token.RawValue()) {
+			names = append(names, token.RawValue())
+		}
+	}
+	slices.Reverse(names)
+	return names
+}
+
+// posBefore returns the byte offset of the token n positions before the current
+// one, for nodes whose leading keywords have already been consumed by the caller.
+func (p *Parser) posBefore(n int) int {
+	return p.tokens[p.pos-n].Pos
+}
+
 func (p *Parser) peek() tokens.Token {
 	if p.pos >= len(p.tokens) {
 		// Return an EOF token if we're at or beyond the end of the tokens slice
@@ -55,3 +138,167 @@ func (p *Parser) next() tokens.Token {
 	// Return the "peeked" current token, now that pos has been advanced
 	return currentToken
 }
+
+// peekAhead returns the token n positions after the current one without advancing.
+func (p *Parser) peekAhead(n int) tokens.Token {
+	if p.pos+n >= len(p.tokens) {
+		return tokens.Token{Type: tokens.TokenEOF, Literal: ""}
+	}
+	return p.tokens[p.pos+n]
+}
+
+// consume advances past the current token if it has the given type and reports whether it did.
+func (p *Parser) consume(t tokens.TokenType) bool {
+	if p.peek().Type != t {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// expect consumes the current token if it has the given type, or returns an error describing what was found instead.
+func (p *Parser) expect(t tokens.TokenType, want string) (tokens.Token, error) {
+	if p.peek().Type != t {
+		return tokens.Token{}, fmt.Errorf("expected %s, found %q at position %d", want, p.peek().Literal, p.pos)
+	}
+	return p.next(), nil
+}
+
+// peekWord reports whether the current token is the unreserved word, ignoring case.
+// Words such as NULLS or ROWS only have meaning in certain clauses, so they are
+// lexed as identifiers and recognized here by their spelling.
+func (p *Parser) peekWord(word string) bool {
+	token := p.peek()
+	return token.Type == tokens.TokenIdentifier && strings.EqualFold(token.Literal, word)
+}
+
+// consumeWord advances past the current token if it is the unreserved word and reports whether it did.
+func (p *Parser) consumeWord(word string) bool {
+	if !p.peekWord(word) {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// expectWord consumes the unreserved word, or returns an error describing what was found instead.
+func (p *Parser) expectWord(word string) error {
+	if !p.consumeWord(word) {
+		return fmt.Errorf("expected %s, found %q at position %d", word, p.peek().Literal, p.pos)
+	}
+	return nil
+}
+
+// consumeKeywords consumes the given sequence of words if the next tokens match it,
+// whether they were lexed as keywords or as identifiers, and reports whether they did.
+func (p *Parser) consumeKeywords(words ...string) bool {
+	for i, word := range words {
+		token := p.peekAhead(i)
+		if token.Type == tokens.TokenStringLiteral || !strings.EqualFold(token.Literal, word) {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+// parseIdentifier consumes an identifier and returns its unquoted name, or returns an error naming what was expected.
+func (p *Parser) parseIdentifier(what string) (string, error) {
+	token, err := p.expect(tokens.TokenIdentifier, what)
+	if err != nil {
+		return "", err
+	}
+	return token.RawValue(), nil
+}
+
+// parseIdentifiers parses a comma-separated list of identifiers.
+func (p *Parser) parseIdentifiers() ([]string, error) {
+	var names []string
+	for {
+		name, err := p.parseIdentifier("column name")
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+
+		if !p.consume(tokens.TokenComma) {
+			return names, nil
+		}
+	}
+}
+
+// parseIdentifierList parses a parenthesized, comma-separated list of identifiers such as a column list.
+func (p *Parser) parseIdentifierList() ([]string, error) {
+	if _, err := p.expect(tokens.TokenLeftParen, "("); err != nil {
+		return nil, err
+	}
+	names, err := p.parseIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// parseQualifiedName parses a possibly schema-qualified name such as a table name
+// and returns its unquoted parts joined by dots.
+func (p *Parser) parseQualifiedName(what string) (string, error) {
+	name, err := p.parseIdentifier(what)
+	if err != nil {
+		return "", err
+	}
+	for p.consume(tokens.TokenDot) {
+		part, err := p.parseIdentifier(what)
+		if err != nil {
+			return "", err
+		}
+		name += "." + part
+	}
+	return name, nil
+}
+
+// parseQualifiedNameList parses a parenthesized, comma-separated list of possibly schema-qualified names.
+func (p *Parser) parseQualifiedNameList(what string) ([]string, error) {
+	if _, err := p.expect(tokens.TokenLeftParen, "("); err != nil {
+		return nil, err
+	}
+	var names []string
+	for {
+		name, err := p.parseQualifiedName(what)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// parseIfNotExists consumes an optional IF NOT EXISTS and reports whether it was present.
+func (p *Parser) parseIfNotExists() (bool, error) {
+	if !p.peekWord("IF") || p.peekAhead(1).Type != tokens.TokenNot {
+		return false, nil
+	}
+	p.pos += 2 // Skip IF NOT
+	if err := p.expectWord("EXISTS"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// parseIfExists consumes an optional IF EXISTS and reports whether it was present.
+func (p *Parser) parseIfExists() bool {
+	if p.peekWord("IF") && strings.EqualFold(p.peekAhead(1).Literal, "EXISTS") {
+		p.pos += 2 // Skip IF EXISTS
+		return true
+	}
+	return false
+}
@@ -2,9 +2,12 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/sanemat/go-sql-parser/lexer"
 	"github.com/sanemat/go-sql-parser/tokens"
 )
 
@@ -12,7 +15,7 @@ func TestParser(t *testing.T) {
 	tests := []struct {
 		name    string
 		input   []tokens.Token
-		want    []Node
+		want    []Statement
 		wantErr error
 	}{
 		{
@@ -25,12 +28,12 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
 						&ColumnExpression{Name: "column1"},
 					},
-					Table: addr("tablea"),
+					From:  []TableExpression{&TableName{Name: "tablea"}},
 					Where: nil,
 				},
 			},
@@ -47,13 +50,13 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
 						&ColumnExpression{Name: "id"},
 						&ColumnExpression{Name: "title"},
 					},
-					Table: addr("table1"),
+					From:  []TableExpression{&TableName{Name: "table1"}},
 					Where: nil,
 				},
 			},
@@ -66,12 +69,12 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
-						&NumericLiteral{Value: 1},
+						&NumericLiteral{Value: 1, Text: "1"},
 					},
-					Table: nil,
+					From:  nil,
 					Where: nil,
 				},
 			},
@@ -84,12 +87,12 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
 						&NullValue{},
 					},
-					Table: nil,
+					From:  nil,
 					Where: nil,
 				},
 			},
@@ -102,12 +105,12 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
 						&BooleanLiteral{Value: false},
 					},
-					Table: nil,
+					From:  nil,
 					Where: nil,
 				},
 			},
@@ -120,12 +123,12 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
 						&BooleanLiteral{Value: true},
 					},
-					Table: nil,
+					From:  nil,
 					Where: nil,
 				},
 			},
@@ -141,15 +144,15 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
-						&NumericLiteral{Value: 1},
+						&NumericLiteral{Value: 1, Text: "1"},
 					},
 				},
 				&SelectStatement{
 					Expressions: []Expression{
-						&NumericLiteral{Value: 2},
+						&NumericLiteral{Value: 2, Text: "2"},
 					},
 				},
 			},
@@ -162,12 +165,12 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
 						&StringLiteral{Value: "text"},
 					},
-					Table: nil,
+					From:  nil,
 					Where: nil,
 				},
 			},
@@ -180,12 +183,12 @@ func TestParser(t *testing.T) {
 				{Type: tokens.TokenSemicolon, Literal: ";"},
 				{Type: tokens.TokenEOF, Literal: ""},
 			},
-			want: []Node{
+			want: []Statement{
 				&SelectStatement{
 					Expressions: []Expression{
 						&StringLiteral{Value: "O'Reilly"},
 					},
-					Table: nil,
+					From:  nil,
 					Where: nil,
 				},
 			},
@@ -201,6 +204,8 @@ func TestParser(t *testing.T) {
 					t.Errorf("Praser.Parse() error = %v, at position %d, want %v", err, p.pos, tt.want)
 					return
 				}
+				// The tokens carry no positions, so only the shape of the tree is compared.
+				clearSpans(got)
 				if !reflect.DeepEqual(got, tt.want) {
 					t.Errorf("Parser.Parse() = %v, want %v", got, tt.want)
 				}
@@ -216,3 +221,3047 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSyntaxErrorPos(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantPos int
+	}{
+		{name: "unexpected token in an expression", input: "select a,, b", wantPos: 9},
+		{name: "missing end of statement", input: "select 1;\nselect 2 3", wantPos: 19},
+		{name: "end of input", input: "select a from", wantPos: 13},
+		{name: "unterminated string", input: "select 'a", wantPos: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewParser(lexer.NewLexer(tt.input).Lex()).Parse()
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parser.Parse() error = %v, want a *SyntaxError", err)
+			}
+			if syntaxErr.Pos != tt.wantPos {
+				t.Errorf("SyntaxError.Pos = %d, want %d", syntaxErr.Pos, tt.wantPos)
+			}
+		})
+	}
+}
+
+// sqlTest is a parser test case written as SQL text rather than tokens.
+type sqlTest struct {
+	name    string
+	input   string
+	want    []Statement
+	wantErr bool
+}
+
+// clearSpans zeroes the spans and the quoted names of the statements and every node
+// nested in them, so that parsed trees can be compared with literals written without positions.
+// A node missing from the Children of its parent keeps its span and fails the comparison.
+func clearSpans(stmts []Statement) {
+	for _, stmt := range stmts {
+		clearSpan(stmt)
+	}
+}
+
+func clearSpan(node Node) {
+	node.(spanned).setSpan(0, 0)
+	node.(spanned).setQuotedNames(nil)
+	for _, child := range node.Children() {
+		clearSpan(child)
+	}
+}
+
+// runSQLTests lexes and parses the input of each test case, compares the resulting ASTs
+// and checks that printing them back as SQL in each dialect and layout parses to the same ASTs.
+func runSQLTests(t *testing.T, tests []sqlTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser(lexer.NewLexer(tt.input).Lex())
+			got, err := p.Parse()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Parser.Parse() = %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Parser.Parse() error = %v, at position %d", err, p.pos)
+				return
+			}
+			clearSpans(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parser.Parse() = %v, want %v", got, tt.want)
+			}
+			checkRoundTrip(t, tt.input)
+			checkSourceOrder(t, tt.input)
+		})
+	}
+}
+
+func TestParseSelectClauses(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "where with precedence",
+			input: "select * from users where a = 1 or b > 2 and not c < 3;",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "*"}},
+					From:        []TableExpression{&TableName{Name: "users"}},
+					Where: &BinaryExpression{
+						Left: &BinaryExpression{
+							Left:     &ColumnExpression{Name: "a"},
+							Operator: tokens.TokenEqual,
+							Right:    &NumericLiteral{Value: 1, Text: "1"},
+						},
+						Operator: tokens.TokenOr,
+						Right: &BinaryExpression{
+							Left: &BinaryExpression{
+								Left:     &ColumnExpression{Name: "b"},
+								Operator: tokens.TokenGreaterThan,
+								Right:    &NumericLiteral{Value: 2, Text: "2"},
+							},
+							Operator: tokens.TokenAnd,
+							Right: &UnaryExpression{
+								Operator: tokens.TokenNot,
+								Operand: &BinaryExpression{
+									Left:     &ColumnExpression{Name: "c"},
+									Operator: tokens.TokenLessThan,
+									Right:    &NumericLiteral{Value: 3, Text: "3"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "arithmetic and qualified columns",
+			input: "select (u.a + 1) * -2 from users",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&BinaryExpression{
+							Left: &BinaryExpression{
+								Left:     &ColumnExpression{Table: addr("u"), Name: "a"},
+								Operator: tokens.TokenPlus,
+								Right:    &NumericLiteral{Value: 1, Text: "1"},
+							},
+							Operator: tokens.TokenMultiply,
+							Right:    &UnaryExpression{Operator: tokens.TokenMinus, Operand: &NumericLiteral{Value: 2, Text: "2"}},
+						},
+					},
+					From: []TableExpression{&TableName{Name: "users"}},
+				},
+			},
+		},
+		{
+			name:  "from tables, joins and derived tables",
+			input: "select * from s.t; select * from t as x, u y; select * from a join b on a.id = b.id; select s.n from (select 1 as n) s",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "*"}},
+					From:        []TableExpression{&TableName{Name: "s.t"}},
+				},
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "*"}},
+					From:        []TableExpression{&TableName{Name: "t", Alias: addr("x")}, &TableName{Name: "u", Alias: addr("y")}},
+				},
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "*"}},
+					From: []TableExpression{&Join{
+						Left:  &TableName{Name: "a"},
+						Type:  JoinInner,
+						Right: &TableName{Name: "b"},
+						On: &BinaryExpression{
+							Left:     &ColumnExpression{Table: addr("a"), Name: "id"},
+							Operator: tokens.TokenEqual,
+							Right:    &ColumnExpression{Table: addr("b"), Name: "id"},
+						},
+					}},
+				},
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Table: addr("s"), Name: "n"}},
+					From: []TableExpression{&SubqueryTable{
+						Query: &SelectStatement{Expressions: []Expression{&AliasedExpression{Expression: &NumericLiteral{Value: 1, Text: "1"}, Alias: "n"}}},
+						Alias: addr("s"),
+					}},
+				},
+			},
+		},
+		{
+			name:  "group by and having",
+			input: "select a from t group by a, b having a > 1",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					GroupBy:     []Expression{&ColumnExpression{Name: "a"}, &ColumnExpression{Name: "b"}},
+					Having: &BinaryExpression{
+						Left:     &ColumnExpression{Name: "a"},
+						Operator: tokens.TokenGreaterThan,
+						Right:    &NumericLiteral{Value: 1, Text: "1"},
+					},
+				},
+			},
+		},
+		{
+			name:  "order by items",
+			input: `select a from t order by a, b desc nulls last, c collate "C" asc nulls first`,
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					OrderBy: []*OrderByItem{
+						{Expression: &ColumnExpression{Name: "a"}},
+						{Expression: &ColumnExpression{Name: "b"}, Direction: SortDesc, Nulls: NullsLast},
+						{Expression: &ColumnExpression{Name: "c"}, Collation: addr("C"), Direction: SortAsc, Nulls: NullsFirst},
+					},
+				},
+			},
+		},
+		{
+			name:  "limit offset",
+			input: "select a from t order by a limit 10 offset 20",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Name: "a"}}},
+					Limit:       &Limit{Style: LimitStyleLimit, Count: &NumericLiteral{Value: 10, Text: "10"}, Offset: &NumericLiteral{Value: 20, Text: "20"}},
+				},
+			},
+		},
+		{
+			name:  "offset before limit",
+			input: "select a from t offset 20 limit 10",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					Limit:       &Limit{Style: LimitStyleLimit, Count: &NumericLiteral{Value: 10, Text: "10"}, Offset: &NumericLiteral{Value: 20, Text: "20"}},
+				},
+			},
+		},
+		{
+			name:  "limit all",
+			input: "select a from t limit all",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					Limit:       &Limit{Style: LimitStyleLimit},
+				},
+			},
+		},
+		{
+			name:  "mysql limit offset, count",
+			input: "select a from t limit 20, 10",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					Limit:       &Limit{Style: LimitStyleComma, Count: &NumericLiteral{Value: 10, Text: "10"}, Offset: &NumericLiteral{Value: 20, Text: "20"}},
+				},
+			},
+		},
+		{
+			name:  "offset fetch only",
+			input: "select a from t order by a offset 20 rows fetch next 10 rows only",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Name: "a"}}},
+					Limit:       &Limit{Style: LimitStyleFetch, Count: &NumericLiteral{Value: 10, Text: "10"}, Offset: &NumericLiteral{Value: 20, Text: "20"}},
+				},
+			},
+		},
+		{
+			name:  "fetch first with ties",
+			input: "select a from t order by a fetch first 5 percent rows with ties",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Name: "a"}}},
+					Limit:       &Limit{Style: LimitStyleFetch, Count: &NumericLiteral{Value: 5, Text: "5"}, Percent: true, WithTies: true},
+				},
+			},
+		},
+		{
+			name:  "fetch first row without count",
+			input: "select a from t fetch first row only",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					Limit:       &Limit{Style: LimitStyleFetch},
+				},
+			},
+		},
+		{
+			name:  "top percent",
+			input: "select top (10) percent a from t order by a",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Name: "a"}}},
+					Limit:       &Limit{Style: LimitStyleTop, Count: &NumericLiteral{Value: 10, Text: "10"}, Percent: true},
+				},
+			},
+		},
+		{
+			name:  "top as column name",
+			input: "select top from t",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "top"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+				},
+			},
+		},
+		{
+			name:    "top combined with limit",
+			input:   "select top 5 a from t limit 10",
+			wantErr: true,
+		},
+		{
+			name:    "nulls without first or last",
+			input:   "select a from t order by a nulls",
+			wantErr: true,
+		},
+		{
+			name:    "fetch without only",
+			input:   "select a from t fetch first 10 rows",
+			wantErr: true,
+		},
+		{
+			name:  "numeric and prefixed string literals",
+			input: `select 1.5e3, 0x1F, x'ff', b'101', E'a\tb''s', n'x'`,
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&NumericLiteral{Value: 1500, Text: "1.5e3"},
+						&NumericLiteral{Value: 31, Text: "0x1F"},
+						&StringLiteral{Prefix: "X", Value: "ff"},
+						&StringLiteral{Prefix: "B", Value: "101"},
+						&StringLiteral{Prefix: "E", Value: "a\tb's"},
+						&StringLiteral{Prefix: "N", Value: "x"},
+					},
+				},
+			},
+		},
+		{
+			name:    "number followed by a letter",
+			input:   "select 1.5e3x",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseSetOperations(t *testing.T) {
+	selectFrom := func(column, table string) *SelectStatement {
+		return &SelectStatement{
+			Expressions: []Expression{&ColumnExpression{Name: column}},
+			From:        []TableExpression{&TableName{Name: table}},
+		}
+	}
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "union all with trailing order by and limit",
+			input: "select a from t union all select b from u order by 1 limit 5;",
+			want: []Statement{
+				&SetOperation{
+					Left:     selectFrom("a", "t"),
+					Operator: tokens.TokenUnion,
+					All:      true,
+					Right:    selectFrom("b", "u"),
+					OrderBy:  []*OrderByItem{{Expression: &NumericLiteral{Value: 1, Text: "1"}}},
+					Limit:    &Limit{Style: LimitStyleLimit, Count: &NumericLiteral{Value: 5, Text: "5"}},
+				},
+			},
+		},
+		{
+			name:  "intersect binds tighter than union",
+			input: "select a from t union select b from u intersect distinct select c from v",
+			want: []Statement{
+				&SetOperation{
+					Left:     selectFrom("a", "t"),
+					Operator: tokens.TokenUnion,
+					Right: &SetOperation{
+						Left:     selectFrom("b", "u"),
+						Operator: tokens.TokenIntersect,
+						Distinct: true,
+						Right:    selectFrom("c", "v"),
+					},
+				},
+			},
+		},
+		{
+			name:  "union and except associate to the left",
+			input: "select a from t except select b from u union select c from v",
+			want: []Statement{
+				&SetOperation{
+					Left: &SetOperation{
+						Left:     selectFrom("a", "t"),
+						Operator: tokens.TokenExcept,
+						Right:    selectFrom("b", "u"),
+					},
+					Operator: tokens.TokenUnion,
+					Right:    selectFrom("c", "v"),
+				},
+			},
+		},
+		{
+			name:  "parenthesized operands",
+			input: "(select a from t order by a limit 1) union (select b from u union select c from v)",
+			want: []Statement{
+				&SetOperation{
+					Left: &SelectStatement{
+						Expressions: []Expression{&ColumnExpression{Name: "a"}},
+						From:        []TableExpression{&TableName{Name: "t"}},
+						OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Name: "a"}}},
+						Limit:       &Limit{Style: LimitStyleLimit, Count: &NumericLiteral{Value: 1, Text: "1"}},
+					},
+					Operator: tokens.TokenUnion,
+					Right: &SetOperation{
+						Left:     selectFrom("b", "u"),
+						Operator: tokens.TokenUnion,
+						Right:    selectFrom("c", "v"),
+					},
+				},
+			},
+		},
+		{
+			name:  "select distinct",
+			input: "select distinct a from t",
+			want: []Statement{
+				&SelectStatement{
+					Distinct:    true,
+					Expressions: []Expression{&ColumnExpression{Name: "a"}},
+					From:        []TableExpression{&TableName{Name: "t"}},
+				},
+			},
+		},
+		{
+			name:    "missing right operand",
+			input:   "select a from t union",
+			wantErr: true,
+		},
+		{
+			name:    "order by before union",
+			input:   "select a from t order by a union select b from u",
+			wantErr: true,
+		},
+		{
+			name:    "unparsed trailing tokens",
+			input:   "select a from t x garbage",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseWith(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "simple cte",
+			input: "with recent as (select id from orders where id > 10) select id from recent",
+			want: []Statement{
+				&SelectStatement{
+					With: &WithClause{
+						CTEs: []*CommonTableExpression{
+							{
+								Name: "recent",
+								Query: &SelectStatement{
+									Expressions: []Expression{&ColumnExpression{Name: "id"}},
+									From:        []TableExpression{&TableName{Name: "orders"}},
+									Where: &BinaryExpression{
+										Left:     &ColumnExpression{Name: "id"},
+										Operator: tokens.TokenGreaterThan,
+										Right:    &NumericLiteral{Value: 10, Text: "10"},
+									},
+								},
+							},
+						},
+					},
+					Expressions: []Expression{&ColumnExpression{Name: "id"}},
+					From:        []TableExpression{&TableName{Name: "recent"}},
+				},
+			},
+		},
+		{
+			name:  "multiple ctes with column lists and materialization",
+			input: "with a (x) as materialized (select 1), b (y, z) as not materialized (select 2, 3) select x from a",
+			want: []Statement{
+				&SelectStatement{
+					With: &WithClause{
+						CTEs: []*CommonTableExpression{
+							{
+								Name:         "a",
+								Columns:      []string{"x"},
+								Materialized: Materialized,
+								Query:        &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+							},
+							{
+								Name:         "b",
+								Columns:      []string{"y", "z"},
+								Materialized: NotMaterialized,
+								Query:        &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 2, Text: "2"}, &NumericLiteral{Value: 3, Text: "3"}}},
+							},
+						},
+					},
+					Expressions: []Expression{&ColumnExpression{Name: "x"}},
+					From:        []TableExpression{&TableName{Name: "a"}},
+				},
+			},
+		},
+		{
+			name: "recursive cte with search and cycle",
+			input: "with recursive tree (id, parent) as (select id, parent from nodes union all select id, parent from tree) " +
+				"search depth first by id set ord cycle id set is_cycle to true default false using path " +
+				"select id from tree union select 0 order by id",
+			want: []Statement{
+				&SetOperation{
+					With: &WithClause{
+						Recursive: true,
+						CTEs: []*CommonTableExpression{
+							{
+								Name:    "tree",
+								Columns: []string{"id", "parent"},
+								Query: &SetOperation{
+									Left: &SelectStatement{
+										Expressions: []Expression{&ColumnExpression{Name: "id"}, &ColumnExpression{Name: "parent"}},
+										From:        []TableExpression{&TableName{Name: "nodes"}},
+									},
+									Operator: tokens.TokenUnion,
+									All:      true,
+									Right: &SelectStatement{
+										Expressions: []Expression{&ColumnExpression{Name: "id"}, &ColumnExpression{Name: "parent"}},
+										From:        []TableExpression{&TableName{Name: "tree"}},
+									},
+								},
+								Search: &SearchClause{DepthFirst: true, Columns: []string{"id"}, SetColumn: "ord"},
+								Cycle: &CycleClause{
+									Columns:      []string{"id"},
+									SetColumn:    "is_cycle",
+									MarkValue:    &BooleanLiteral{Value: true},
+									DefaultValue: &BooleanLiteral{Value: false},
+									PathColumn:   "path",
+								},
+							},
+						},
+					},
+					Left: &SelectStatement{
+						Expressions: []Expression{&ColumnExpression{Name: "id"}},
+						From:        []TableExpression{&TableName{Name: "tree"}},
+					},
+					Operator: tokens.TokenUnion,
+					Right:    &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 0, Text: "0"}}},
+					OrderBy:  []*OrderByItem{{Expression: &ColumnExpression{Name: "id"}}},
+				},
+			},
+		},
+		{
+			name:    "missing AS",
+			input:   "with a (select 1) select 1",
+			wantErr: true,
+		},
+		{
+			name:    "cycle without using",
+			input:   "with recursive a as (select 1) cycle x set y select 1",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseWindowFunctions(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "aggregate calls",
+			input: "select count(*), count(distinct a), string_agg(b, ',' order by c) filter (where c > 0), now() from t",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&FunctionCall{Name: "count", Arguments: []Expression{&ColumnExpression{Name: "*"}}},
+						&FunctionCall{Name: "count", Distinct: true, Arguments: []Expression{&ColumnExpression{Name: "a"}}},
+						&FunctionCall{
+							Name:      "string_agg",
+							Arguments: []Expression{&ColumnExpression{Name: "b"}, &StringLiteral{Value: ","}},
+							OrderBy:   []*OrderByItem{{Expression: &ColumnExpression{Name: "c"}}},
+							Filter: &BinaryExpression{
+								Left:     &ColumnExpression{Name: "c"},
+								Operator: tokens.TokenGreaterThan,
+								Right:    &NumericLiteral{Value: 0, Text: "0"},
+							},
+						},
+						&FunctionCall{Name: "now"},
+					},
+					From: []TableExpression{&TableName{Name: "t"}},
+				},
+			},
+		},
+		{
+			name: "window specification with frame",
+			input: "select sum(amount) over (partition by account order by day desc " +
+				"rows between unbounded preceding and current row exclude ties) from ledger",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&FunctionCall{
+							Name:      "sum",
+							Arguments: []Expression{&ColumnExpression{Name: "amount"}},
+							Over: &WindowSpec{
+								PartitionBy: []Expression{&ColumnExpression{Name: "account"}},
+								OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Name: "day"}, Direction: SortDesc}},
+								Frame: &WindowFrame{
+									Units:      FrameRows,
+									StartBound: &FrameBound{Type: UnboundedPreceding},
+									EndBound:   &FrameBound{Type: CurrentRow},
+									Exclude:    ExcludeTies,
+								},
+							},
+						},
+					},
+					From: []TableExpression{&TableName{Name: "ledger"}},
+				},
+			},
+		},
+		{
+			name:  "frame offsets without between",
+			input: "select avg(x) over (order by d range 3 preceding), avg(x) over (groups between 1 preceding and 2 following exclude no others) from t",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&FunctionCall{
+							Name:      "avg",
+							Arguments: []Expression{&ColumnExpression{Name: "x"}},
+							Over: &WindowSpec{
+								OrderBy: []*OrderByItem{{Expression: &ColumnExpression{Name: "d"}}},
+								Frame:   &WindowFrame{Units: FrameRange, StartBound: &FrameBound{Type: Preceding, Offset: &NumericLiteral{Value: 3, Text: "3"}}},
+							},
+						},
+						&FunctionCall{
+							Name:      "avg",
+							Arguments: []Expression{&ColumnExpression{Name: "x"}},
+							Over: &WindowSpec{
+								Frame: &WindowFrame{
+									Units:      FrameGroups,
+									StartBound: &FrameBound{Type: Preceding, Offset: &NumericLiteral{Value: 1, Text: "1"}},
+									EndBound:   &FrameBound{Type: Following, Offset: &NumericLiteral{Value: 2, Text: "2"}},
+									Exclude:    ExcludeNoOthers,
+								},
+							},
+						},
+					},
+					From: []TableExpression{&TableName{Name: "t"}},
+				},
+			},
+		},
+		{
+			name:  "named windows",
+			input: "select rank() over w, row_number() over (w order by b) from t window w as (partition by a), w2 as (w) order by a",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&FunctionCall{Name: "rank", Over: &WindowSpec{Name: addr("w")}},
+						&FunctionCall{
+							Name: "row_number",
+							Over: &WindowSpec{
+								Name:    addr("w"),
+								OrderBy: []*OrderByItem{{Expression: &ColumnExpression{Name: "b"}}},
+							},
+						},
+					},
+					From: []TableExpression{&TableName{Name: "t"}},
+					Windows: []*NamedWindow{
+						{Name: "w", Spec: &WindowSpec{PartitionBy: []Expression{&ColumnExpression{Name: "a"}}}},
+						{Name: "w2", Spec: &WindowSpec{Name: addr("w")}},
+					},
+					OrderBy: []*OrderByItem{{Expression: &ColumnExpression{Name: "a"}}},
+				},
+			},
+		},
+		{
+			name:  "qualified function name",
+			input: "select pg_catalog.now()",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&FunctionCall{Name: "pg_catalog.now"}},
+				},
+			},
+		},
+		{
+			name:    "frame bound without direction",
+			input:   "select sum(x) over (rows 3) from t",
+			wantErr: true,
+		},
+		{
+			name:    "unclosed window specification",
+			input:   "select sum(x) over (partition by a from t",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseSpecialExpressions(t *testing.T) {
+	column := func(name string) *ColumnExpression { return &ColumnExpression{Name: name} }
+	number := func(value float64) *NumericLiteral { return &NumericLiteral{Value: value, Text: formatNumber(value)} }
+	selectOne := func(expr Expression) []Statement {
+		return []Statement{&SelectStatement{Expressions: []Expression{expr}}}
+	}
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "searched case",
+			input: "select case when a > 1 then 'big' when a = 1 then 'one' else 'small' end",
+			want: selectOne(&CaseExpression{
+				Whens: []*WhenClause{
+					{
+						Condition: &BinaryExpression{Left: column("a"), Operator: tokens.TokenGreaterThan, Right: number(1)},
+						Result:    &StringLiteral{Value: "big"},
+					},
+					{
+						Condition: &BinaryExpression{Left: column("a"), Operator: tokens.TokenEqual, Right: number(1)},
+						Result:    &StringLiteral{Value: "one"},
+					},
+				},
+				Else: &StringLiteral{Value: "small"},
+			}),
+		},
+		{
+			name:  "simple case",
+			input: "select case status when 1 then true end",
+			want: selectOne(&CaseExpression{
+				Operand: column("status"),
+				Whens:   []*WhenClause{{Condition: number(1), Result: &BooleanLiteral{Value: true}}},
+			}),
+		},
+		{
+			name:  "cast and double colon",
+			input: "select cast(a as numeric(10, 2)) + b::int",
+			want: selectOne(&BinaryExpression{
+				Left: &CastExpression{
+					Expression: column("a"),
+					Type:       &DataType{Name: "numeric", Modifiers: []Expression{number(10), number(2)}},
+				},
+				Operator: tokens.TokenPlus,
+				Right:    &CastExpression{Expression: column("b"), Type: &DataType{Name: "int"}, DoubleColon: true},
+			}),
+		},
+		{
+			name:  "double colon binds tighter than unary minus",
+			input: "select -'1'::int",
+			want: selectOne(&UnaryExpression{
+				Operator: tokens.TokenMinus,
+				Operand:  &CastExpression{Expression: &StringLiteral{Value: "1"}, Type: &DataType{Name: "int"}, DoubleColon: true},
+			}),
+		},
+		{
+			name:  "extract and position",
+			input: "select extract(year from created_at), position('@' in email)",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&ExtractExpression{Field: "year", Source: column("created_at")},
+						&PositionExpression{Substring: &StringLiteral{Value: "@"}, Source: column("email")},
+					},
+				},
+			},
+		},
+		{
+			name:  "substring forms",
+			input: "select substring(name from 2 for 3), substring(name for 3), substring(name, 2, 3)",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&SubstringExpression{Source: column("name"), From: number(2), For: number(3)},
+						&SubstringExpression{Source: column("name"), For: number(3)},
+						&FunctionCall{Name: "substring", Arguments: []Expression{column("name"), number(2), number(3)}},
+					},
+				},
+			},
+		},
+		{
+			name:  "trim forms",
+			input: "select trim(leading 'x' from name), trim(both from name), trim('x' from name), trim(name)",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&TrimExpression{Side: TrimLeading, Characters: &StringLiteral{Value: "x"}, Source: column("name")},
+						&TrimExpression{Side: TrimBoth, Source: column("name")},
+						&TrimExpression{Characters: &StringLiteral{Value: "x"}, Source: column("name")},
+						&FunctionCall{Name: "trim", Arguments: []Expression{column("name")}},
+					},
+				},
+			},
+		},
+		{
+			name:  "collate and at time zone",
+			input: `select name collate "de_DE" || 'x', created_at at time zone 'UTC'`,
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&BinaryExpression{
+							Left:     &CollateExpression{Expression: column("name"), Collation: "de_DE"},
+							Operator: tokens.TokenConcat,
+							Right:    &StringLiteral{Value: "x"},
+						},
+						&AtTimeZoneExpression{Expression: column("created_at"), TimeZone: &StringLiteral{Value: "UTC"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "is, in, between and like",
+			input: "select * from t where a is not null and b in (1, 2) and c not between 1 and 5 and d not ilike 'x%' escape '!' or e is distinct from f",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{column("*")},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					Where: &BinaryExpression{
+						Left: &BinaryExpression{
+							Left: &BinaryExpression{
+								Left: &BinaryExpression{
+									Left:     &IsExpression{Expression: column("a"), Not: true, Right: &NullValue{}},
+									Operator: tokens.TokenAnd,
+									Right:    &InExpression{Expression: column("b"), List: []Expression{number(1), number(2)}},
+								},
+								Operator: tokens.TokenAnd,
+								Right:    &BetweenExpression{Expression: column("c"), Not: true, Low: number(1), High: number(5)},
+							},
+							Operator: tokens.TokenAnd,
+							Right: &LikeExpression{
+								Expression:      column("d"),
+								Not:             true,
+								CaseInsensitive: true,
+								Pattern:         &StringLiteral{Value: "x%"},
+								Escape:          &StringLiteral{Value: "!"},
+							},
+						},
+						Operator: tokens.TokenOr,
+						Right:    &IsExpression{Expression: column("e"), DistinctFrom: true, Right: column("f")},
+					},
+				},
+			},
+		},
+		{
+			name:  "subqueries",
+			input: "select (select max(id) from t) from u where id not in (select id from v) and exists (select 1)",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&SubqueryExpression{Query: &SelectStatement{
+							Expressions: []Expression{&FunctionCall{Name: "max", Arguments: []Expression{column("id")}}},
+							From:        []TableExpression{&TableName{Name: "t"}},
+						}},
+					},
+					From: []TableExpression{&TableName{Name: "u"}},
+					Where: &BinaryExpression{
+						Left: &InExpression{
+							Expression: column("id"),
+							Not:        true,
+							Query:      &SelectStatement{Expressions: []Expression{column("id")}, From: []TableExpression{&TableName{Name: "v"}}},
+						},
+						Operator: tokens.TokenAnd,
+						Right:    &ExistsExpression{Query: &SelectStatement{Expressions: []Expression{number(1)}}},
+					},
+				},
+			},
+		},
+		{
+			name:  "order by collation",
+			input: `select a from t order by a collate "C" desc`,
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{column("a")},
+					From:        []TableExpression{&TableName{Name: "t"}},
+					OrderBy:     []*OrderByItem{{Expression: column("a"), Collation: addr("C"), Direction: SortDesc}},
+				},
+			},
+		},
+		{
+			name:    "case without end",
+			input:   "select case when a then b",
+			wantErr: true,
+		},
+		{
+			name:    "cast without type",
+			input:   "select cast(a as)",
+			wantErr: true,
+		},
+		{
+			name:    "is followed by garbage",
+			input:   "select a is 1",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseDataTypes(t *testing.T) {
+	castTo := func(dataType *DataType) []Statement {
+		return []Statement{&SelectStatement{Expressions: []Expression{
+			&CastExpression{Expression: &ColumnExpression{Name: "x"}, Type: dataType},
+		}}}
+	}
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "parameterized",
+			input: "select cast(x as varchar(255))",
+			want:  castTo(&DataType{Name: "varchar", Modifiers: []Expression{&NumericLiteral{Value: 255, Text: "255"}}}),
+		},
+		{
+			name:  "multi-word",
+			input: "select cast(x as double precision)",
+			want:  castTo(&DataType{Name: "double precision"}),
+		},
+		{
+			name:  "character varying",
+			input: "select cast(x as national character varying(10))",
+			want:  castTo(&DataType{Name: "national character varying", Modifiers: []Expression{&NumericLiteral{Value: 10, Text: "10"}}}),
+		},
+		{
+			name:  "timestamp with time zone",
+			input: "select cast(x as timestamp(3) with time zone)",
+			want:  castTo(&DataType{Name: "timestamp", Modifiers: []Expression{&NumericLiteral{Value: 3, Text: "3"}}, TimeZone: WithTimeZone}),
+		},
+		{
+			name:  "time without time zone",
+			input: "select cast(x as time without time zone)",
+			want:  castTo(&DataType{Name: "time", TimeZone: WithoutTimeZone}),
+		},
+		{
+			name:  "interval with fields",
+			input: "select cast(x as interval day to second(3))",
+			want:  castTo(&DataType{Name: "interval", IntervalFields: "DAY TO SECOND", Modifiers: []Expression{&NumericLiteral{Value: 3, Text: "3"}}}),
+		},
+		{
+			name:  "arrays",
+			input: "select cast(x as int[]), cast(x as text[3][]), cast(x as int array)",
+			want: []Statement{&SelectStatement{Expressions: []Expression{
+				&CastExpression{Expression: &ColumnExpression{Name: "x"}, Type: &DataType{Name: "int", ArrayBounds: []Expression{nil}}},
+				&CastExpression{Expression: &ColumnExpression{Name: "x"}, Type: &DataType{Name: "text", ArrayBounds: []Expression{&NumericLiteral{Value: 3, Text: "3"}, nil}}},
+				&CastExpression{Expression: &ColumnExpression{Name: "x"}, Type: &DataType{Name: "int", ArrayBounds: []Expression{nil}}},
+			}}},
+		},
+		{
+			name:  "generic array and map",
+			input: "select cast(x as array<map<string, int64>>)",
+			want: castTo(&DataType{
+				Name: "array",
+				TypeArguments: []*DataType{
+					{Name: "map", TypeArguments: []*DataType{{Name: "string"}, {Name: "int64"}}},
+				},
+			}),
+		},
+		{
+			name:  "struct",
+			input: "select cast(x as struct<id int64, tags array<string>>)",
+			want: castTo(&DataType{
+				Name: "struct",
+				Fields: []*StructField{
+					{Name: "id", Type: &DataType{Name: "int64"}},
+					{Name: "tags", Type: &DataType{Name: "array", TypeArguments: []*DataType{{Name: "string"}}}},
+				},
+			}),
+		},
+		{
+			name:  "qualified user-defined type",
+			input: `select x::public."Mood"`,
+			want: []Statement{&SelectStatement{Expressions: []Expression{
+				&CastExpression{Expression: &ColumnExpression{Name: "x"}, Type: &DataType{Name: "public.Mood"}, DoubleColon: true},
+			}}},
+		},
+		{
+			name:  "typed literals",
+			input: "select date '2024-01-01', interval '1' day",
+			want: []Statement{&SelectStatement{Expressions: []Expression{
+				&TypedLiteral{Type: &DataType{Name: "date"}, Value: "2024-01-01"},
+				&TypedLiteral{Type: &DataType{Name: "interval", IntervalFields: "DAY"}, Value: "1"},
+			}}},
+		},
+		{
+			name:    "unclosed generic",
+			input:   "select cast(x as array<int)",
+			wantErr: true,
+		},
+		{
+			name:    "national without character",
+			input:   "select cast(x as national varchar)",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseInsert(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "multi-row values",
+			input: "insert into public.users (id, name) values (1, 'a'), (2, default);",
+			want: []Statement{
+				&InsertStatement{
+					Table:   &TableName{Name: "public.users"},
+					Columns: []string{"id", "name"},
+					Values: [][]Expression{
+						{&NumericLiteral{Value: 1, Text: "1"}, &StringLiteral{Value: "a"}},
+						{&NumericLiteral{Value: 2, Text: "2"}, &DefaultExpression{}},
+					},
+				},
+			},
+		},
+		{
+			name:  "insert select with overriding",
+			input: "insert into users as u (id) overriding system value select id from staging where id > 0",
+			want: []Statement{
+				&InsertStatement{
+					Table:      &TableName{Name: "users", Alias: addr("u")},
+					Columns:    []string{"id"},
+					Overriding: OverridingSystemValue,
+					Query: &SelectStatement{
+						Expressions: []Expression{&ColumnExpression{Name: "id"}},
+						From:        []TableExpression{&TableName{Name: "staging"}},
+						Where: &BinaryExpression{
+							Left:     &ColumnExpression{Name: "id"},
+							Operator: tokens.TokenGreaterThan,
+							Right:    &NumericLiteral{Value: 0, Text: "0"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "parenthesized query without columns",
+			input: "insert into users (select 1 union select 2)",
+			want: []Statement{
+				&InsertStatement{
+					Table: &TableName{Name: "users"},
+					Query: &SetOperation{
+						Left:     &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+						Operator: tokens.TokenUnion,
+						Right:    &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 2, Text: "2"}}},
+					},
+				},
+			},
+		},
+		{
+			name:  "default values",
+			input: "insert into audit default values",
+			want:  []Statement{&InsertStatement{Table: &TableName{Name: "audit"}, DefaultValues: true}},
+		},
+		{
+			name:  "with clause",
+			input: "with src as (select 1) insert into t select * from src",
+			want: []Statement{
+				&InsertStatement{
+					With: &WithClause{CTEs: []*CommonTableExpression{
+						{Name: "src", Query: &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}}},
+					}},
+					Table: &TableName{Name: "t"},
+					Query: &SelectStatement{Expressions: []Expression{&ColumnExpression{Name: "*"}}, From: []TableExpression{&TableName{Name: "src"}}},
+				},
+			},
+		},
+		{
+			name:    "missing into",
+			input:   "insert users values (1)",
+			wantErr: true,
+		},
+		{
+			name:    "missing rows",
+			input:   "insert into users (id)",
+			wantErr: true,
+		},
+		{
+			name:    "unclosed row",
+			input:   "insert into users values (1, 2",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseUpsert(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "on conflict do nothing",
+			input: "insert into t (id) values (1) on conflict do nothing",
+			want: []Statement{
+				&InsertStatement{
+					Table:      &TableName{Name: "t"},
+					Columns:    []string{"id"},
+					Values:     [][]Expression{{&NumericLiteral{Value: 1, Text: "1"}}},
+					OnConflict: &OnConflict{Action: ConflictDoNothing},
+				},
+			},
+		},
+		{
+			name:  "on conflict do update",
+			input: "insert into t (id, n) values (1, 2) on conflict (id) where id > 0 do update set n = excluded.n where t.n < excluded.n",
+			want: []Statement{
+				&InsertStatement{
+					Table:   &TableName{Name: "t"},
+					Columns: []string{"id", "n"},
+					Values:  [][]Expression{{&NumericLiteral{Value: 1, Text: "1"}, &NumericLiteral{Value: 2, Text: "2"}}},
+					OnConflict: &OnConflict{
+						Target: []Expression{&ColumnExpression{Name: "id"}},
+						TargetWhere: &BinaryExpression{
+							Left:     &ColumnExpression{Name: "id"},
+							Operator: tokens.TokenGreaterThan,
+							Right:    &NumericLiteral{Value: 0, Text: "0"},
+						},
+						Action: ConflictDoUpdate,
+						Assignments: []*Assignment{
+							{Column: "n", Value: &ColumnExpression{Table: addr("excluded"), Name: "n"}},
+						},
+						Where: &BinaryExpression{
+							Left:     &ColumnExpression{Table: addr("t"), Name: "n"},
+							Operator: tokens.TokenLessThan,
+							Right:    &ColumnExpression{Table: addr("excluded"), Name: "n"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "on conflict on constraint after select",
+			input: "insert into t select * from s on conflict on constraint t_pkey do nothing",
+			want: []Statement{
+				&InsertStatement{
+					Table:      &TableName{Name: "t"},
+					Query:      &SelectStatement{Expressions: []Expression{&ColumnExpression{Name: "*"}}, From: []TableExpression{&TableName{Name: "s"}}},
+					OnConflict: &OnConflict{Constraint: addr("t_pkey"), Action: ConflictDoNothing},
+				},
+			},
+		},
+		{
+			name:  "on duplicate key update",
+			input: "insert into t (id, n) values (1, 2) on duplicate key update n = values(n), m = 0",
+			want: []Statement{
+				&InsertStatement{
+					Table:   &TableName{Name: "t"},
+					Columns: []string{"id", "n"},
+					Values:  [][]Expression{{&NumericLiteral{Value: 1, Text: "1"}, &NumericLiteral{Value: 2, Text: "2"}}},
+					OnDuplicateKeyUpdate: []*Assignment{
+						{Column: "n", Value: &FunctionCall{Name: "values", Arguments: []Expression{&ColumnExpression{Name: "n"}}}},
+						{Column: "m", Value: &NumericLiteral{Value: 0, Text: "0"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "returning with alias",
+			input: "insert into t default values returning id, n as total",
+			want: []Statement{
+				&InsertStatement{
+					Table:         &TableName{Name: "t"},
+					DefaultValues: true,
+					Returning: []Expression{
+						&ColumnExpression{Name: "id"},
+						&AliasedExpression{Expression: &ColumnExpression{Name: "n"}, Alias: "total"},
+					},
+				},
+			},
+		},
+		{
+			name:  "select item aliases",
+			input: "select a as x, b y from t",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{
+						&AliasedExpression{Expression: &ColumnExpression{Name: "a"}, Alias: "x"},
+						&AliasedExpression{Expression: &ColumnExpression{Name: "b"}, Alias: "y"},
+					},
+					From: []TableExpression{&TableName{Name: "t"}},
+				},
+			},
+		},
+		{
+			name:    "missing conflict action",
+			input:   "insert into t values (1) on conflict (id) do",
+			wantErr: true,
+		},
+		{
+			name:    "do update without set",
+			input:   "insert into t values (1) on conflict (id) do update n = 1",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate without key",
+			input:   "insert into t values (1) on duplicate update n = 1",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseUpdate(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "simple update",
+			input: "update users set name = 'a', score = default where id = 1",
+			want: []Statement{
+				&UpdateStatement{
+					Tables: []TableExpression{&TableName{Name: "users"}},
+					Assignments: []*Assignment{
+						{Column: "name", Value: &StringLiteral{Value: "a"}},
+						{Column: "score", Value: &DefaultExpression{}},
+					},
+					Where: &BinaryExpression{
+						Left:     &ColumnExpression{Name: "id"},
+						Operator: tokens.TokenEqual,
+						Right:    &NumericLiteral{Value: 1, Text: "1"},
+					},
+				},
+			},
+		},
+		{
+			name:  "tuple assignment and returning",
+			input: "update public.users as u set (a, b) = (1, u.a) returning *",
+			want: []Statement{
+				&UpdateStatement{
+					Tables: []TableExpression{&TableName{Name: "public.users", Alias: addr("u")}},
+					Assignments: []*Assignment{
+						{
+							Columns: []string{"a", "b"},
+							Value: &RowExpression{Items: []Expression{
+								&NumericLiteral{Value: 1, Text: "1"},
+								&ColumnExpression{Table: addr("u"), Name: "a"},
+							}},
+						},
+					},
+					Returning: []Expression{&ColumnExpression{Name: "*"}},
+				},
+			},
+		},
+		{
+			name:  "postgres from list",
+			input: "update t set n = s.n from (select id, n from src) s, other o where t.id = s.id",
+			want: []Statement{
+				&UpdateStatement{
+					Tables:      []TableExpression{&TableName{Name: "t"}},
+					Assignments: []*Assignment{{Column: "n", Value: &ColumnExpression{Table: addr("s"), Name: "n"}}},
+					From: []TableExpression{
+						&SubqueryTable{
+							Query: &SelectStatement{
+								Expressions: []Expression{&ColumnExpression{Name: "id"}, &ColumnExpression{Name: "n"}},
+								From:        []TableExpression{&TableName{Name: "src"}},
+							},
+							Alias: addr("s"),
+						},
+						&TableName{Name: "other", Alias: addr("o")},
+					},
+					Where: &BinaryExpression{
+						Left:     &ColumnExpression{Table: addr("t"), Name: "id"},
+						Operator: tokens.TokenEqual,
+						Right:    &ColumnExpression{Table: addr("s"), Name: "id"},
+					},
+				},
+			},
+		},
+		{
+			name:  "mysql multi-table with join, order by and limit",
+			input: "update t1 left outer join t2 using (id) inner join t3 on t3.id = t1.id set t1.n = t2.n order by t1.id limit 10",
+			want: []Statement{
+				&UpdateStatement{
+					Tables: []TableExpression{
+						&Join{
+							Left: &Join{
+								Left:  &TableName{Name: "t1"},
+								Type:  JoinLeft,
+								Right: &TableName{Name: "t2"},
+								Using: []string{"id"},
+							},
+							Type:  JoinInner,
+							Right: &TableName{Name: "t3"},
+							On: &BinaryExpression{
+								Left:     &ColumnExpression{Table: addr("t3"), Name: "id"},
+								Operator: tokens.TokenEqual,
+								Right:    &ColumnExpression{Table: addr("t1"), Name: "id"},
+							},
+						},
+					},
+					Assignments: []*Assignment{{Column: "t1.n", Value: &ColumnExpression{Table: addr("t2"), Name: "n"}}},
+					OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Table: addr("t1"), Name: "id"}}},
+					Limit:       &Limit{Count: &NumericLiteral{Value: 10, Text: "10"}},
+				},
+			},
+		},
+		{
+			name:  "mysql comma-separated tables",
+			input: "update a, b natural join c set a.x = b.x",
+			want: []Statement{
+				&UpdateStatement{
+					Tables: []TableExpression{
+						&TableName{Name: "a"},
+						&Join{Left: &TableName{Name: "b"}, Natural: true, Right: &TableName{Name: "c"}},
+					},
+					Assignments: []*Assignment{{Column: "a.x", Value: &ColumnExpression{Table: addr("b"), Name: "x"}}},
+				},
+			},
+		},
+		{
+			name:  "with clause",
+			input: "with s as (select 1) update t set (a) = (select * from s)",
+			want: []Statement{
+				&UpdateStatement{
+					With: &WithClause{CTEs: []*CommonTableExpression{
+						{Name: "s", Query: &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}}},
+					}},
+					Tables: []TableExpression{&TableName{Name: "t"}},
+					Assignments: []*Assignment{
+						{
+							Columns: []string{"a"},
+							Value: &SubqueryExpression{Query: &SelectStatement{
+								Expressions: []Expression{&ColumnExpression{Name: "*"}},
+								From:        []TableExpression{&TableName{Name: "s"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "only and descendant tables",
+			input: "update only t set a = 1; update t * x set a = 1; update only s.t as x set a = 1",
+			want: []Statement{
+				&UpdateStatement{
+					Tables:      []TableExpression{&TableName{Only: true, Name: "t"}},
+					Assignments: []*Assignment{{Column: "a", Value: &NumericLiteral{Value: 1, Text: "1"}}},
+				},
+				&UpdateStatement{
+					Tables:      []TableExpression{&TableName{Name: "t", Descendants: true, Alias: addr("x")}},
+					Assignments: []*Assignment{{Column: "a", Value: &NumericLiteral{Value: 1, Text: "1"}}},
+				},
+				&UpdateStatement{
+					Tables:      []TableExpression{&TableName{Only: true, Name: "s.t", Alias: addr("x")}},
+					Assignments: []*Assignment{{Column: "a", Value: &NumericLiteral{Value: 1, Text: "1"}}},
+				},
+			},
+		},
+		{
+			name:    "reserved word as an alias without as",
+			input:   "update t only set a = 1",
+			wantErr: true,
+		},
+		{
+			name:    "missing set",
+			input:   "update t a = 1",
+			wantErr: true,
+		},
+		{
+			name:    "join without condition",
+			input:   "update a join b set a.x = 1",
+			wantErr: true,
+		},
+		{
+			name:    "natural without join",
+			input:   "update a natural set x = 1",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseDelete(t *testing.T) {
+	idEquals := func(table string) Expression {
+		return &BinaryExpression{
+			Left:     &ColumnExpression{Table: addr(table), Name: "id"},
+			Operator: tokens.TokenEqual,
+			Right:    &ColumnExpression{Table: addr("o"), Name: "id"},
+		}
+	}
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "delete with alias, using and returning",
+			input: "with x as (select 1) delete from public.orders as d using old o where d.id = o.id returning d.id",
+			want: []Statement{
+				&DeleteStatement{
+					With: &WithClause{CTEs: []*CommonTableExpression{
+						{Name: "x", Query: &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}}},
+					}},
+					Tables:    []TableExpression{&TableName{Name: "public.orders", Alias: addr("d")}},
+					Using:     []TableExpression{&TableName{Name: "old", Alias: addr("o")}},
+					Where:     idEquals("d"),
+					Returning: []Expression{&ColumnExpression{Table: addr("d"), Name: "id"}},
+				},
+			},
+		},
+		{
+			name:  "where current of",
+			input: "delete from t where current of c1",
+			want: []Statement{
+				&DeleteStatement{Tables: []TableExpression{&TableName{Name: "t"}}, CurrentOf: addr("c1")},
+			},
+		},
+		{
+			name:  "mysql order by and limit",
+			input: "delete from t order by id desc limit 5",
+			want: []Statement{
+				&DeleteStatement{
+					Tables:  []TableExpression{&TableName{Name: "t"}},
+					OrderBy: []*OrderByItem{{Expression: &ColumnExpression{Name: "id"}, Direction: SortDesc}},
+					Limit:   &Limit{Count: &NumericLiteral{Value: 5, Text: "5"}},
+				},
+			},
+		},
+		{
+			name:  "mysql multi-table targets before from",
+			input: "delete t1, t2.* from t1 join o on t1.id = o.id join t2 using (id)",
+			want: []Statement{
+				&DeleteStatement{
+					Tables: []TableExpression{&TableName{Name: "t1"}, &TableName{Name: "t2"}},
+					From: []TableExpression{
+						&Join{
+							Left:  &Join{Left: &TableName{Name: "t1"}, Right: &TableName{Name: "o"}, On: idEquals("t1")},
+							Right: &TableName{Name: "t2"},
+							Using: []string{"id"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "truncate",
+			input: "truncate table a, s.b restart identity cascade",
+			want: []Statement{
+				&TruncateStatement{Tables: []TableExpression{&TableName{Name: "a"}, &TableName{Name: "s.b"}}, Identity: IdentityRestart, Behavior: DropCascade},
+			},
+		},
+		{
+			name:  "truncate without table keyword",
+			input: "truncate logs",
+			want:  []Statement{&TruncateStatement{Tables: []TableExpression{&TableName{Name: "logs"}}}},
+		},
+		{
+			name:  "only and descendant tables",
+			input: "delete from only t where id = 1; truncate a, only b, c * continue identity",
+			want: []Statement{
+				&DeleteStatement{
+					Tables: []TableExpression{&TableName{Only: true, Name: "t"}},
+					Where:  &BinaryExpression{Left: &ColumnExpression{Name: "id"}, Operator: tokens.TokenEqual, Right: &NumericLiteral{Value: 1, Text: "1"}},
+				},
+				&TruncateStatement{
+					Tables:   []TableExpression{&TableName{Name: "a"}, &TableName{Only: true, Name: "b"}, &TableName{Name: "c", Descendants: true}},
+					Identity: IdentityContinue,
+				},
+			},
+		},
+		{
+			name:    "multi-table targets without from",
+			input:   "delete t1 where id = 1",
+			wantErr: true,
+		},
+		{
+			name:    "current without of",
+			input:   "delete from t where current c1",
+			wantErr: true,
+		},
+		{
+			name:    "restart without identity",
+			input:   "truncate t restart",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseCreateTable(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name: "columns with constraints",
+			input: `create table if not exists public.users (
+				id bigint generated by default as identity (start with 100 increment by 1 no cycle) primary key,
+				email varchar(255) collate "C" not null unique,
+				score numeric(10, 2) default 0 check (score >= 0),
+				org_id int constraint users_org_fk references orgs (id) on delete cascade on update set null,
+				total int generated always as (score * 2) stored
+			)`,
+			want: []Statement{
+				&CreateTableStatement{
+					Name:        "public.users",
+					IfNotExists: true,
+					Elements: []TableElement{
+						&ColumnDefinition{
+							Name: "id",
+							Type: &DataType{Name: "bigint"},
+							Constraints: []*ColumnConstraint{
+								{
+									Kind:      ConstraintIdentity,
+									Generated: GeneratedByDefault,
+									IdentityOptions: []*SequenceOption{
+										{Name: "START", Value: &NumericLiteral{Value: 100, Text: "100"}},
+										{Name: "INCREMENT", Value: &NumericLiteral{Value: 1, Text: "1"}},
+										{Name: "NO CYCLE"},
+									},
+								},
+								{Kind: ConstraintPrimaryKey},
+							},
+						},
+						&ColumnDefinition{
+							Name:        "email",
+							Type:        &DataType{Name: "varchar", Modifiers: []Expression{&NumericLiteral{Value: 255, Text: "255"}}},
+							Collation:   addr("C"),
+							Constraints: []*ColumnConstraint{{Kind: ConstraintNotNull}, {Kind: ConstraintUnique}},
+						},
+						&ColumnDefinition{
+							Name: "score",
+							Type: &DataType{Name: "numeric", Modifiers: []Expression{&NumericLiteral{Value: 10, Text: "10"}, &NumericLiteral{Value: 2, Text: "2"}}},
+							Constraints: []*ColumnConstraint{
+								{Kind: ConstraintDefault, Expression: &NumericLiteral{Value: 0, Text: "0"}},
+								{Kind: ConstraintCheck, Expression: &BinaryExpression{
+									Left:     &ColumnExpression{Name: "score"},
+									Operator: tokens.TokenGreaterThanOrEqual,
+									Right:    &NumericLiteral{Value: 0, Text: "0"},
+								}},
+							},
+						},
+						&ColumnDefinition{
+							Name: "org_id",
+							Type: &DataType{Name: "int"},
+							Constraints: []*ColumnConstraint{
+								{
+									Name: addr("users_org_fk"),
+									Kind: ConstraintReferences,
+									References: &ForeignKeyReference{
+										Table:    "orgs",
+										Columns:  []string{"id"},
+										OnDelete: ActionCascade,
+										OnUpdate: ActionSetNull,
+									},
+								},
+							},
+						},
+						&ColumnDefinition{
+							Name: "total",
+							Type: &DataType{Name: "int"},
+							Constraints: []*ColumnConstraint{
+								{Kind: ConstraintGenerated, Expression: &BinaryExpression{
+									Left:     &ColumnExpression{Name: "score"},
+									Operator: tokens.TokenMultiply,
+									Right:    &NumericLiteral{Value: 2, Text: "2"},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "table constraints",
+			input: `create temporary table booking (
+				id int,
+				room int,
+				during tsrange,
+				primary key (id),
+				constraint room_fk foreign key (room) references rooms (id) match full on delete restrict,
+				unique (room, during),
+				check (id > 0),
+				exclude using gist (room with =, during with &&) where (id > 0)
+			)`,
+			want: []Statement{
+				&CreateTableStatement{
+					Name:      "booking",
+					Temporary: true,
+					Elements: []TableElement{
+						&ColumnDefinition{Name: "id", Type: &DataType{Name: "int"}},
+						&ColumnDefinition{Name: "room", Type: &DataType{Name: "int"}},
+						&ColumnDefinition{Name: "during", Type: &DataType{Name: "tsrange"}},
+						&TableConstraint{Kind: TableConstraintPrimaryKey, Columns: []string{"id"}},
+						&TableConstraint{
+							Name:       addr("room_fk"),
+							Kind:       TableConstraintForeignKey,
+							Columns:    []string{"room"},
+							References: &ForeignKeyReference{Table: "rooms", Columns: []string{"id"}, Match: "FULL", OnDelete: ActionRestrict},
+						},
+						&TableConstraint{Kind: TableConstraintUnique, Columns: []string{"room", "during"}},
+						&TableConstraint{Kind: TableConstraintCheck, Check: &BinaryExpression{
+							Left:     &ColumnExpression{Name: "id"},
+							Operator: tokens.TokenGreaterThan,
+							Right:    &NumericLiteral{Value: 0, Text: "0"},
+						}},
+						&TableConstraint{
+							Kind:        TableConstraintExclude,
+							IndexMethod: addr("gist"),
+							Exclusions: []*ExclusionElement{
+								{Expression: &ColumnExpression{Name: "room"}, Operator: "="},
+								{Expression: &ColumnExpression{Name: "during"}, Operator: "&&"},
+							},
+							Where: &BinaryExpression{
+								Left:     &ColumnExpression{Name: "id"},
+								Operator: tokens.TokenGreaterThan,
+								Right:    &NumericLiteral{Value: 0, Text: "0"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "like, inherits and partition by",
+			input: "create unlogged table m (like base including defaults excluding all, extra text) inherits (parent, s.other) partition by range (created_at)",
+			want: []Statement{
+				&CreateTableStatement{
+					Name:     "m",
+					Unlogged: true,
+					Elements: []TableElement{
+						&TableLikeClause{Table: "base", Options: []string{"INCLUDING DEFAULTS", "EXCLUDING ALL"}},
+						&ColumnDefinition{Name: "extra", Type: &DataType{Name: "text"}},
+					},
+					Inherits: []string{"parent", "s.other"},
+					PartitionBy: &PartitionSpec{
+						Strategy: "RANGE",
+						Keys:     []Expression{&ColumnExpression{Name: "created_at"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "table elements in written order",
+			input: "create table t (a int, like u including all, b int, primary key (a), c int)",
+			want: []Statement{
+				&CreateTableStatement{
+					Name: "t",
+					Elements: []TableElement{
+						&ColumnDefinition{Name: "a", Type: &DataType{Name: "int"}},
+						&TableLikeClause{Table: "u", Options: []string{"INCLUDING ALL"}},
+						&ColumnDefinition{Name: "b", Type: &DataType{Name: "int"}},
+						&TableConstraint{Kind: TableConstraintPrimaryKey, Columns: []string{"a"}},
+						&ColumnDefinition{Name: "c", Type: &DataType{Name: "int"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "mysql like and auto_increment",
+			input: "create table a like b; create table c (id int auto_increment, unique key c_id (id))",
+			want: []Statement{
+				&CreateTableStatement{Name: "a", Elements: []TableElement{&TableLikeClause{Table: "b"}}},
+				&CreateTableStatement{
+					Name: "c",
+					Elements: []TableElement{
+						&ColumnDefinition{Name: "id", Type: &DataType{Name: "int"}, Constraints: []*ColumnConstraint{{Kind: ConstraintAutoIncrement}}},
+						&TableConstraint{Name: addr("c_id"), Kind: TableConstraintUnique, Columns: []string{"id"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "mysql index definitions",
+			input: "create table t (id int, key idx_a (a), index (b), key k using btree (a, b))",
+			want: []Statement{
+				&CreateTableStatement{
+					Name: "t",
+					Elements: []TableElement{
+						&ColumnDefinition{Name: "id", Type: &DataType{Name: "int"}},
+						&IndexDefinition{Name: addr("idx_a"), Columns: []string{"a"}},
+						&IndexDefinition{Columns: []string{"b"}},
+						&IndexDefinition{Name: addr("k"), Method: addr("btree"), Columns: []string{"a", "b"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "columns named key and index",
+			input: "create table t (key varchar(10), index int)",
+			want: []Statement{
+				&CreateTableStatement{
+					Name: "t",
+					Elements: []TableElement{
+						&ColumnDefinition{Name: "key", Type: &DataType{Name: "varchar", Modifiers: []Expression{&NumericLiteral{Value: 10, Text: "10"}}}},
+						&ColumnDefinition{Name: "index", Type: &DataType{Name: "int"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "mysql table options",
+			input: "create table t (id int) engine=InnoDB default charset=utf8mb4, collate utf8mb4_bin auto_increment = 5 comment 'people'",
+			want: []Statement{
+				&CreateTableStatement{
+					Name:     "t",
+					Elements: []TableElement{&ColumnDefinition{Name: "id", Type: &DataType{Name: "int"}}},
+					Options: []*UtilityOption{
+						{Name: "ENGINE", Value: addr("InnoDB")},
+						{Name: "DEFAULT CHARSET", Value: addr("utf8mb4")},
+						{Name: "COLLATE", Value: addr("utf8mb4_bin")},
+						{Name: "AUTO_INCREMENT", Value: addr("5")},
+						{Name: "COMMENT", Value: addr("people"), Quoted: true},
+					},
+				},
+			},
+		},
+		{
+			name:    "unknown table option",
+			input:   "create table t (id int) storage = disk",
+			wantErr: true,
+		},
+		{
+			name:    "default without a table option",
+			input:   "create table t (id int) default engine = InnoDB",
+			wantErr: true,
+		},
+		{
+			name:  "create table as",
+			input: "create table snapshot (a, b) as select x, y from src with no data",
+			want: []Statement{
+				&CreateTableStatement{
+					Name:     "snapshot",
+					Elements: []TableElement{&ColumnDefinition{Name: "a"}, &ColumnDefinition{Name: "b"}},
+					Query: &SelectStatement{
+						Expressions: []Expression{&ColumnExpression{Name: "x"}, &ColumnExpression{Name: "y"}},
+						From:        []TableExpression{&TableName{Name: "src"}},
+					},
+					WithNoData: true,
+				},
+			},
+		},
+		{
+			name: "deferrable constraints and nulls not distinct",
+			input: "create table t (a int unique nulls not distinct not deferrable not null, b int references p deferrable initially deferred, " +
+				"unique nulls not distinct (a, b), constraint fk foreign key (b) references p (id) initially immediate deferrable)",
+			want: []Statement{
+				&CreateTableStatement{
+					Name: "t",
+					Elements: []TableElement{
+						&ColumnDefinition{Name: "a", Type: &DataType{Name: "int"}, Constraints: []*ColumnConstraint{
+							{Kind: ConstraintUnique, NullsNotDistinct: true, Deferrable: addr(false)},
+							{Kind: ConstraintNotNull},
+						}},
+						&ColumnDefinition{Name: "b", Type: &DataType{Name: "int"}, Constraints: []*ColumnConstraint{
+							{Kind: ConstraintReferences, References: &ForeignKeyReference{Table: "p"}, Deferrable: addr(true), InitiallyDeferred: addr(true)},
+						}},
+						&TableConstraint{Kind: TableConstraintUnique, NullsNotDistinct: true, Columns: []string{"a", "b"}},
+						&TableConstraint{
+							Name:              addr("fk"),
+							Kind:              TableConstraintForeignKey,
+							Columns:           []string{"b"},
+							References:        &ForeignKeyReference{Table: "p", Columns: []string{"id"}},
+							Deferrable:        addr(true),
+							InitiallyDeferred: addr(false),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "partitions",
+			input: "create table m1 partition of m for values from (1, minvalue) to (10, maxvalue); " +
+				"create table m2 partition of m (a not null, check (a > 0)) for values in (1, 2) partition by range (b); " +
+				"create table m3 partition of m default",
+			want: []Statement{
+				&CreateTableStatement{
+					Name:        "m1",
+					PartitionOf: "m",
+					Bound: &PartitionBound{
+						From: []Expression{&NumericLiteral{Value: 1, Text: "1"}, &ColumnExpression{Name: "minvalue"}},
+						To:   []Expression{&NumericLiteral{Value: 10, Text: "10"}, &ColumnExpression{Name: "maxvalue"}},
+					},
+				},
+				&CreateTableStatement{
+					Name:        "m2",
+					PartitionOf: "m",
+					Elements: []TableElement{
+						&ColumnDefinition{Name: "a", Constraints: []*ColumnConstraint{{Kind: ConstraintNotNull}}},
+						&TableConstraint{
+							Kind:  TableConstraintCheck,
+							Check: &BinaryExpression{Left: &ColumnExpression{Name: "a"}, Operator: tokens.TokenGreaterThan, Right: &NumericLiteral{Value: 0, Text: "0"}},
+						},
+					},
+					Bound:       &PartitionBound{In: []Expression{&NumericLiteral{Value: 1, Text: "1"}, &NumericLiteral{Value: 2, Text: "2"}}},
+					PartitionBy: &PartitionSpec{Strategy: "RANGE", Keys: []Expression{&ColumnExpression{Name: "b"}}},
+				},
+				&CreateTableStatement{Name: "m3", PartitionOf: "m", Bound: &PartitionBound{Default: true}},
+			},
+		},
+		{
+			name:    "partition without bound",
+			input:   "create table m1 partition of m",
+			wantErr: true,
+		},
+		{
+			name:    "initially without timing",
+			input:   "create table t (a int unique initially)",
+			wantErr: true,
+		},
+		{
+			name:    "column without type",
+			input:   "create table t (a, b int)",
+			wantErr: true,
+		},
+		{
+			name:    "dangling constraint name",
+			input:   "create table t (a int constraint c)",
+			wantErr: true,
+		},
+		{
+			name:    "generated by default expression",
+			input:   "create table t (a int generated by default as (1) stored)",
+			wantErr: true,
+		},
+		{
+			name:    "unclosed elements",
+			input:   "create table t (a int",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseAlterTable(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name: "column actions",
+			input: `alter table if exists only public.users
+				add column if not exists age int not null default 0,
+				drop column if exists legacy cascade,
+				rename column name to full_name,
+				alter column score set data type bigint using score::bigint,
+				alter id set default nextval('s'),
+				alter column note drop default,
+				alter column email set not null,
+				alter column email drop not null`,
+			want: []Statement{
+				&AlterTableStatement{
+					IfExists: true,
+					Only:     true,
+					Name:     "public.users",
+					Actions: []*AlterTableAction{
+						{
+							Kind:        AlterAddColumn,
+							IfNotExists: true,
+							Column: &ColumnDefinition{
+								Name: "age",
+								Type: &DataType{Name: "int"},
+								Constraints: []*ColumnConstraint{
+									{Kind: ConstraintNotNull},
+									{Kind: ConstraintDefault, Expression: &NumericLiteral{Value: 0, Text: "0"}},
+								},
+							},
+						},
+						{Kind: AlterDropColumn, Name: "legacy", IfExists: true, Behavior: DropCascade},
+						{Kind: AlterRenameColumn, Name: "name", NewName: "full_name"},
+						{
+							Kind:  AlterColumnType,
+							Name:  "score",
+							Type:  &DataType{Name: "bigint"},
+							Using: &CastExpression{Expression: &ColumnExpression{Name: "score"}, Type: &DataType{Name: "bigint"}, DoubleColon: true},
+						},
+						{
+							Kind:    AlterColumnSetDefault,
+							Name:    "id",
+							Default: &FunctionCall{Name: "nextval", Arguments: []Expression{&StringLiteral{Value: "s"}}},
+						},
+						{Kind: AlterColumnDropDefault, Name: "note"},
+						{Kind: AlterColumnSetNotNull, Name: "email"},
+						{Kind: AlterColumnDropNotNull, Name: "email"},
+					},
+				},
+			},
+		},
+		{
+			name:  "constraint actions",
+			input: "alter table orders add constraint orders_user_fk foreign key (user_id) references users (id) not valid, validate constraint orders_user_fk, drop constraint if exists old_check restrict, rename constraint a to b",
+			want: []Statement{
+				&AlterTableStatement{
+					Name: "orders",
+					Actions: []*AlterTableAction{
+						{
+							Kind: AlterAddConstraint,
+							Constraint: &TableConstraint{
+								Name:       addr("orders_user_fk"),
+								Kind:       TableConstraintForeignKey,
+								Columns:    []string{"user_id"},
+								References: &ForeignKeyReference{Table: "users", Columns: []string{"id"}},
+							},
+							NotValid: true,
+						},
+						{Kind: AlterValidateConstraint, Name: "orders_user_fk"},
+						{Kind: AlterDropConstraint, Name: "old_check", IfExists: true, Behavior: DropRestrict},
+						{Kind: AlterRenameConstraint, Name: "a", NewName: "b"},
+					},
+				},
+			},
+		},
+		{
+			name:  "table actions",
+			input: "alter table t rename to t2; alter table t2 set schema archive, owner to admin",
+			want: []Statement{
+				&AlterTableStatement{Name: "t", Actions: []*AlterTableAction{{Kind: AlterRenameTable, NewName: "t2"}}},
+				&AlterTableStatement{
+					Name: "t2",
+					Actions: []*AlterTableAction{
+						{Kind: AlterSetSchema, NewName: "archive"},
+						{Kind: AlterOwnerTo, NewName: "admin"},
+					},
+				},
+			},
+		},
+		{
+			name: "partitions",
+			input: `alter table events attach partition events_2024 for values from ('2024-01-01') to ('2025-01-01'),
+				attach partition events_other default,
+				attach partition events_eu for values in ('de', 'fr'),
+				attach partition events_h0 for values with (modulus 4, remainder 0),
+				detach partition events_2023,
+				detach partition events_2022 concurrently,
+				detach partition events_2021 finalize`,
+			want: []Statement{
+				&AlterTableStatement{
+					Name: "events",
+					Actions: []*AlterTableAction{
+						{
+							Kind: AlterAttachPartition,
+							Name: "events_2024",
+							Bound: &PartitionBound{
+								From: []Expression{&StringLiteral{Value: "2024-01-01"}},
+								To:   []Expression{&StringLiteral{Value: "2025-01-01"}},
+							},
+						},
+						{Kind: AlterAttachPartition, Name: "events_other", Bound: &PartitionBound{Default: true}},
+						{
+							Kind:  AlterAttachPartition,
+							Name:  "events_eu",
+							Bound: &PartitionBound{In: []Expression{&StringLiteral{Value: "de"}, &StringLiteral{Value: "fr"}}},
+						},
+						{
+							Kind:  AlterAttachPartition,
+							Name:  "events_h0",
+							Bound: &PartitionBound{Modulus: &NumericLiteral{Value: 4, Text: "4"}, Remainder: &NumericLiteral{Value: 0, Text: "0"}},
+						},
+						{Kind: AlterDetachPartition, Name: "events_2023"},
+						{Kind: AlterDetachPartition, Name: "events_2022", Concurrent: true},
+						{Kind: AlterDetachPartition, Name: "events_2021", Finalize: true},
+					},
+				},
+			},
+		},
+		{
+			name:  "mysql modify and change",
+			input: "alter table t modify column a varchar(20) not null after b, change b c int first",
+			want: []Statement{
+				&AlterTableStatement{
+					Name: "t",
+					Actions: []*AlterTableAction{
+						{
+							Kind: AlterModifyColumn,
+							Column: &ColumnDefinition{
+								Name:        "a",
+								Type:        &DataType{Name: "varchar", Modifiers: []Expression{&NumericLiteral{Value: 20, Text: "20"}}},
+								Constraints: []*ColumnConstraint{{Kind: ConstraintNotNull}},
+							},
+							After: addr("b"),
+						},
+						{
+							Kind:   AlterChangeColumn,
+							Name:   "b",
+							Column: &ColumnDefinition{Name: "c", Type: &DataType{Name: "int"}},
+							First:  true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "mysql add index",
+			input: "alter table t add index idx (a), add key (b, c), add column key int",
+			want: []Statement{
+				&AlterTableStatement{
+					Name: "t",
+					Actions: []*AlterTableAction{
+						{Kind: AlterAddIndex, Index: &IndexDefinition{Name: addr("idx"), Columns: []string{"a"}}},
+						{Kind: AlterAddIndex, Index: &IndexDefinition{Columns: []string{"b", "c"}}},
+						{Kind: AlterAddColumn, Column: &ColumnDefinition{Name: "key", Type: &DataType{Name: "int"}}},
+					},
+				},
+			},
+		},
+		{
+			name:    "unknown action",
+			input:   "alter table t frobnicate",
+			wantErr: true,
+		},
+		{
+			name:    "add column without type",
+			input:   "alter table t add column a",
+			wantErr: true,
+		},
+		{
+			name:    "alter column without change",
+			input:   "alter table t alter column a",
+			wantErr: true,
+		},
+		{
+			name:    "attach partition without bound",
+			input:   "alter table t attach partition p",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseSchemaObjects(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "partial unique index",
+			input: "create unique index concurrently if not exists users_email_idx on only public.users using btree (lower(email) text_pattern_ops desc nulls last, org_id collate \"C\") include (name) where deleted_at is null",
+			want: []Statement{
+				&CreateIndexStatement{
+					Unique:       true,
+					Concurrently: true,
+					IfNotExists:  true,
+					Name:         addr("users_email_idx"),
+					Only:         true,
+					Table:        "public.users",
+					Method:       addr("btree"),
+					Elements: []*IndexElement{
+						{
+							Expression: &FunctionCall{Name: "lower", Arguments: []Expression{&ColumnExpression{Name: "email"}}},
+							OpClass:    addr("text_pattern_ops"),
+							Direction:  SortDesc,
+							Nulls:      NullsLast,
+						},
+						{Expression: &ColumnExpression{Name: "org_id"}, Collation: addr("C")},
+					},
+					Include: []string{"name"},
+					Where:   &IsExpression{Expression: &ColumnExpression{Name: "deleted_at"}, Right: &NullValue{}},
+				},
+			},
+		},
+		{
+			name:  "unnamed index",
+			input: "create index on t (a)",
+			want: []Statement{
+				&CreateIndexStatement{Table: "t", Elements: []*IndexElement{{Expression: &ColumnExpression{Name: "a"}}}},
+			},
+		},
+		{
+			name:  "views",
+			input: "create or replace temp view v (a) as select 1 with local check option; create materialized view if not exists mv as select 2 with no data",
+			want: []Statement{
+				&CreateViewStatement{
+					OrReplace:   true,
+					Temporary:   true,
+					Name:        "v",
+					Columns:     []string{"a"},
+					Query:       &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+					CheckOption: "LOCAL",
+				},
+				&CreateViewStatement{
+					Materialized: true,
+					IfNotExists:  true,
+					Name:         "mv",
+					Query:        &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 2, Text: "2"}}},
+					WithNoData:   true,
+				},
+			},
+		},
+		{
+			name:  "refresh materialized view",
+			input: "refresh materialized view concurrently reports.daily with data",
+			want:  []Statement{&RefreshMaterializedViewStatement{Concurrently: true, Name: "reports.daily"}},
+		},
+		{
+			name:  "schemas",
+			input: "create schema if not exists app authorization owner; create schema authorization admin",
+			want: []Statement{
+				&CreateSchemaStatement{IfNotExists: true, Name: "app", Authorization: addr("owner")},
+				&CreateSchemaStatement{Authorization: addr("admin")},
+			},
+		},
+		{
+			name:  "sequences",
+			input: "create sequence if not exists s as bigint increment by -1 minvalue -100 no maxvalue start with -1 cache 10 cycle owned by t.id",
+			want: []Statement{
+				&CreateSequenceStatement{
+					IfNotExists: true,
+					Name:        "s",
+					Options: []*SequenceOption{
+						{Name: "AS", Type: &DataType{Name: "bigint"}},
+						{Name: "INCREMENT", Value: &UnaryExpression{Operator: tokens.TokenMinus, Operand: &NumericLiteral{Value: 1, Text: "1"}}},
+						{Name: "MINVALUE", Value: &UnaryExpression{Operator: tokens.TokenMinus, Operand: &NumericLiteral{Value: 100, Text: "100"}}},
+						{Name: "NO MAXVALUE"},
+						{Name: "START", Value: &UnaryExpression{Operator: tokens.TokenMinus, Operand: &NumericLiteral{Value: 1, Text: "1"}}},
+						{Name: "CACHE", Value: &NumericLiteral{Value: 10, Text: "10"}},
+						{Name: "CYCLE"},
+						{Name: "OWNED BY", Value: &ColumnExpression{Table: addr("t"), Name: "id"}},
+					},
+				},
+			},
+		},
+		{
+			name:  "alter sequence",
+			input: "alter sequence if exists s restart with 5 no cycle",
+			want: []Statement{
+				&AlterSequenceStatement{
+					IfExists: true,
+					Name:     "s",
+					Options:  []*SequenceOption{{Name: "RESTART", Value: &NumericLiteral{Value: 5, Text: "5"}}, {Name: "NO CYCLE"}},
+				},
+			},
+		},
+		{
+			name:  "drop statements",
+			input: "drop table if exists a, s.b cascade; drop materialized view mv; drop index concurrently idx restrict; drop extension if exists pgcrypto",
+			want: []Statement{
+				&DropStatement{ObjectKind: "TABLE", IfExists: true, Names: []string{"a", "s.b"}, Behavior: DropCascade},
+				&DropStatement{ObjectKind: "MATERIALIZED VIEW", Names: []string{"mv"}},
+				&DropStatement{ObjectKind: "INDEX", Concurrently: true, Names: []string{"idx"}, Behavior: DropRestrict},
+				&DropStatement{ObjectKind: "EXTENSION", IfExists: true, Names: []string{"pgcrypto"}},
+			},
+		},
+		{
+			name:    "unknown drop kind",
+			input:   "drop widget w",
+			wantErr: true,
+		},
+		{
+			name:    "view without query",
+			input:   "create view v",
+			wantErr: true,
+		},
+		{
+			name:    "alter sequence without options",
+			input:   "alter sequence s",
+			wantErr: true,
+		},
+		{
+			name:    "or replace table",
+			input:   "create or replace table t (a int)",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseRoutinesAndTypes(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name: "plpgsql trigger function",
+			input: `create or replace function touch() returns trigger language plpgsql as $$
+begin
+  new.updated_at := now(); -- 'quotes' and ; are opaque
+  return new;
+end;
+$$;`,
+			want: []Statement{
+				&CreateFunctionStatement{
+					OrReplace:  true,
+					Name:       "touch",
+					Parameters: []*FunctionParameter{},
+					Returns:    &DataType{Name: "trigger"},
+					Language:   "plpgsql",
+					Body:       addr("\nbegin\n  new.updated_at := now(); -- 'quotes' and ; are opaque\n  return new;\nend;\n"),
+				},
+			},
+		},
+		{
+			name:  "parameter modes and defaults",
+			input: "create function f(in x double precision, out y int, variadic rest int[], z text = 'a', int default 1) returns setof record immutable strict as 'obj.so', 'f_sym' language c",
+			want: []Statement{
+				&CreateFunctionStatement{
+					Name: "f",
+					Parameters: []*FunctionParameter{
+						{Mode: ParameterModeIn, Name: addr("x"), Type: &DataType{Name: "double precision"}},
+						{Mode: ParameterModeOut, Name: addr("y"), Type: &DataType{Name: "int"}},
+						{Mode: ParameterModeVariadic, Name: addr("rest"), Type: &DataType{Name: "int", ArrayBounds: []Expression{nil}}},
+						{Name: addr("z"), Type: &DataType{Name: "text"}, Default: &StringLiteral{Value: "a"}},
+						{Type: &DataType{Name: "int"}, Default: &NumericLiteral{Value: 1, Text: "1"}},
+					},
+					Returns:      &DataType{Name: "record"},
+					ReturnsSetOf: true,
+					Volatility:   "IMMUTABLE",
+					Attributes:   []string{"STRICT"},
+					Body:         addr("obj.so"),
+					LinkSymbol:   addr("f_sym"),
+					Language:     "c",
+				},
+			},
+		},
+		{
+			name:  "returns table with attributes",
+			input: "create function t() returns table (id int, name text) language sql stable security definer parallel safe cost 10 set search_path = public, pg_temp as $fn$select 1, 'a'$fn$",
+			want: []Statement{
+				&CreateFunctionStatement{
+					Name:       "t",
+					Parameters: []*FunctionParameter{},
+					ReturnsTable: []*ColumnDefinition{
+						{Name: "id", Type: &DataType{Name: "int"}},
+						{Name: "name", Type: &DataType{Name: "text"}},
+					},
+					Language:   "sql",
+					Volatility: "STABLE",
+					Attributes: []string{"SECURITY DEFINER", "PARALLEL SAFE", "COST 10", "SET search_path = public, pg_temp"},
+					Body:       addr("select 1, 'a'"),
+				},
+			},
+		},
+		{
+			name:  "procedure and return body",
+			input: "create procedure p(inout n int) language sql as $$select 1$$; create function sq(x int) returns int return x * x",
+			want: []Statement{
+				&CreateFunctionStatement{
+					Procedure:  true,
+					Name:       "p",
+					Parameters: []*FunctionParameter{{Mode: ParameterModeInOut, Name: addr("n"), Type: &DataType{Name: "int"}}},
+					Language:   "sql",
+					Body:       addr("select 1"),
+				},
+				&CreateFunctionStatement{
+					Name:       "sq",
+					Parameters: []*FunctionParameter{{Name: addr("x"), Type: &DataType{Name: "int"}}},
+					Returns:    &DataType{Name: "int"},
+					Return:     &BinaryExpression{Left: &ColumnExpression{Name: "x"}, Operator: tokens.TokenMultiply, Right: &ColumnExpression{Name: "x"}},
+				},
+			},
+		},
+		{
+			name: "triggers",
+			input: `create or replace trigger trg before insert or update of a, b on s.t for each row when (new.a > 0) execute function f('x'); create constraint trigger c after delete on t execute procedure g();
+				create constraint trigger fk after insert on t from s.r deferrable initially deferred for each row execute function h();
+				create trigger audit after update on t referencing new table as n old table o for each statement execute function a()`,
+			want: []Statement{
+				&CreateTriggerStatement{
+					OrReplace:  true,
+					Name:       "trg",
+					Timing:     "BEFORE",
+					Events:     []*TriggerEvent{{Kind: "INSERT"}, {Kind: "UPDATE", Columns: []string{"a", "b"}}},
+					Table:      "s.t",
+					ForEachRow: true,
+					When:       &BinaryExpression{Left: &ColumnExpression{Table: addr("new"), Name: "a"}, Operator: tokens.TokenGreaterThan, Right: &NumericLiteral{Value: 0, Text: "0"}},
+					Function:   "f",
+					Arguments:  []Expression{&StringLiteral{Value: "x"}},
+				},
+				&CreateTriggerStatement{
+					Constraint: true,
+					Name:       "c",
+					Timing:     "AFTER",
+					Events:     []*TriggerEvent{{Kind: "DELETE"}},
+					Table:      "t",
+					Function:   "g",
+				},
+				&CreateTriggerStatement{
+					Constraint:        true,
+					Name:              "fk",
+					Timing:            "AFTER",
+					Events:            []*TriggerEvent{{Kind: "INSERT"}},
+					Table:             "t",
+					From:              "s.r",
+					Deferrable:        addr(true),
+					InitiallyDeferred: addr(true),
+					ForEachRow:        true,
+					Function:          "h",
+				},
+				&CreateTriggerStatement{
+					Name:     "audit",
+					Timing:   "AFTER",
+					Events:   []*TriggerEvent{{Kind: "UPDATE"}},
+					Table:    "t",
+					OldTable: "o",
+					NewTable: "n",
+					Function: "a",
+				},
+			},
+		},
+		{
+			name:  "types",
+			input: "create type mood as enum ('sad', 'ok'); create type pair as (a int, b text); create type later",
+			want: []Statement{
+				&CreateTypeStatement{Name: "mood", Kind: TypeKindEnum, Labels: []string{"sad", "ok"}},
+				&CreateTypeStatement{
+					Name:       "pair",
+					Kind:       TypeKindComposite,
+					Attributes: []*ColumnDefinition{{Name: "a", Type: &DataType{Name: "int"}}, {Name: "b", Type: &DataType{Name: "text"}}},
+				},
+				&CreateTypeStatement{Name: "later"},
+			},
+		},
+		{
+			name:  "alter type add value",
+			input: "alter type mood add value 'happy'; alter type s.mood add value if not exists 'meh' before 'ok'; alter type mood add value 'glad' after 'happy'",
+			want: []Statement{
+				&AlterTypeStatement{Name: "mood", Value: "happy"},
+				&AlterTypeStatement{Name: "s.mood", IfNotExists: true, Value: "meh", Before: addr("ok")},
+				&AlterTypeStatement{Name: "mood", Value: "glad", After: addr("happy")},
+			},
+		},
+		{
+			name:    "alter type without add value",
+			input:   "alter type mood rename to feeling",
+			wantErr: true,
+		},
+		{
+			name:  "domain and extension",
+			input: "create domain posint as integer not null check (value > 0); create extension if not exists pgcrypto with schema ext version '1.3' cascade",
+			want: []Statement{
+				&CreateDomainStatement{
+					Name: "posint",
+					Type: &DataType{Name: "integer"},
+					Constraints: []*ColumnConstraint{
+						{Kind: ConstraintNotNull},
+						{Kind: ConstraintCheck, Expression: &BinaryExpression{Left: &ColumnExpression{Name: "value"}, Operator: tokens.TokenGreaterThan, Right: &NumericLiteral{Value: 0, Text: "0"}}},
+					},
+				},
+				&CreateExtensionStatement{IfNotExists: true, Name: "pgcrypto", Schema: addr("ext"), Version: addr("1.3"), Cascade: true},
+			},
+		},
+		{
+			name:  "drop routines and triggers",
+			input: "drop function if exists f(int), g; drop trigger t on x cascade",
+			want: []Statement{
+				&DropStatement{
+					ObjectKind: "FUNCTION",
+					IfExists:   true,
+					Names:      []string{"f", "g"},
+					Signatures: [][]*FunctionParameter{{{Type: &DataType{Name: "int"}}}, nil},
+				},
+				&DropStatement{ObjectKind: "TRIGGER", Names: []string{"t"}, On: addr("x"), Behavior: DropCascade},
+			},
+		},
+		{
+			name:    "function without body",
+			input:   "create function f() returns int language sql",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated dollar quote",
+			input:   "create function f() returns int as $$select 1",
+			wantErr: true,
+		},
+		{
+			name:    "trigger without timing",
+			input:   "create trigger t insert on x execute function f()",
+			wantErr: true,
+		},
+		{
+			name:    "range type",
+			input:   "create type r as range (subtype = int)",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseTransactionAndSessionStatements(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "migration wrapped in a transaction",
+			input: "begin; create schema app; commit;",
+			want: []Statement{
+				&BeginStatement{},
+				&CreateSchemaStatement{Name: "app"},
+				&CommitStatement{},
+			},
+		},
+		{
+			name:  "transaction modes",
+			input: "start transaction isolation level repeatable read, read only; begin work read write not deferrable; begin transaction isolation level serializable deferrable",
+			want: []Statement{
+				&BeginStatement{Modes: TransactionModes{IsolationLevel: "REPEATABLE READ", ReadOnly: addr(true)}},
+				&BeginStatement{Modes: TransactionModes{ReadOnly: addr(false), Deferrable: addr(false)}},
+				&BeginStatement{Modes: TransactionModes{IsolationLevel: "SERIALIZABLE", Deferrable: addr(true)}},
+			},
+		},
+		{
+			name:  "commit and rollback",
+			input: "commit work and chain; end transaction; rollback and no chain; abort; rollback to savepoint sp; rollback transaction to sp",
+			want: []Statement{
+				&CommitStatement{Chain: true},
+				&CommitStatement{},
+				&RollbackStatement{},
+				&RollbackStatement{},
+				&RollbackStatement{Savepoint: addr("sp")},
+				&RollbackStatement{Savepoint: addr("sp")},
+			},
+		},
+		{
+			name:  "savepoints",
+			input: "savepoint sp; release savepoint sp; release sp",
+			want: []Statement{
+				&SavepointStatement{Name: "sp"},
+				&ReleaseSavepointStatement{Name: "sp"},
+				&ReleaseSavepointStatement{Name: "sp"},
+			},
+		},
+		{
+			name:  "set",
+			input: "set search_path to app, public; set local statement_timeout = '5s'; set session enable_seqscan = on; set app.user_id = 42; set time zone 'UTC'; set work_mem to default",
+			want: []Statement{
+				&SetStatement{Name: "search_path", Values: []Expression{&ColumnExpression{Name: "app"}, &ColumnExpression{Name: "public"}}},
+				&SetStatement{Scope: SetScopeLocal, Name: "statement_timeout", Values: []Expression{&StringLiteral{Value: "5s"}}},
+				&SetStatement{Scope: SetScopeSession, Name: "enable_seqscan", Values: []Expression{&ColumnExpression{Name: "on"}}},
+				&SetStatement{Name: "app.user_id", Values: []Expression{&NumericLiteral{Value: 42, Text: "42"}}},
+				&SetStatement{Name: "TIME ZONE", Values: []Expression{&StringLiteral{Value: "UTC"}}},
+				&SetStatement{Name: "work_mem", Default: true},
+			},
+		},
+		{
+			name:  "mysql set forms",
+			input: "set names utf8mb4; set names utf8mb4 collate utf8mb4_bin; set names default; set @x = 1; set global max_connections = 200",
+			want: []Statement{
+				&SetStatement{Name: "NAMES", Values: []Expression{&ColumnExpression{Name: "utf8mb4"}}},
+				&SetStatement{Name: "NAMES", Values: []Expression{&CollateExpression{Expression: &ColumnExpression{Name: "utf8mb4"}, Collation: "utf8mb4_bin"}}},
+				&SetStatement{Name: "NAMES", Default: true},
+				&SetStatement{Name: "@x", Values: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+				&SetStatement{Scope: SetScopeGlobal, Name: "max_connections", Values: []Expression{&NumericLiteral{Value: 200, Text: "200"}}},
+			},
+		},
+		{
+			name:    "set global user variable",
+			input:   "set global @x = 1",
+			wantErr: true,
+		},
+		{
+			name:  "set transaction",
+			input: "set transaction isolation level read committed; set session characteristics as transaction read only",
+			want: []Statement{
+				&SetTransactionStatement{Modes: TransactionModes{IsolationLevel: "READ COMMITTED"}},
+				&SetTransactionStatement{Session: true, Modes: TransactionModes{ReadOnly: addr(true)}},
+			},
+		},
+		{
+			name:  "reset, show and use",
+			input: "reset all; reset search_path; show time zone; show transaction isolation level; show server_version; use shop",
+			want: []Statement{
+				&ResetStatement{Name: "ALL"},
+				&ResetStatement{Name: "search_path"},
+				&ShowStatement{Name: "TIME ZONE"},
+				&ShowStatement{Name: "TRANSACTION ISOLATION LEVEL"},
+				&ShowStatement{Name: "server_version"},
+				&UseStatement{Database: "shop"},
+			},
+		},
+		{
+			name:    "unknown isolation level",
+			input:   "begin isolation level chaotic",
+			wantErr: true,
+		},
+		{
+			name:    "set transaction without modes",
+			input:   "set transaction",
+			wantErr: true,
+		},
+		{
+			name:    "set without value",
+			input:   "set search_path",
+			wantErr: true,
+		},
+		{
+			name:    "release without name",
+			input:   "release savepoint",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParsePrivilegeStatements(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "grant privileges with columns",
+			input: "grant select, update (email, name) on users, s.orders to app_rw, group staff with grant option granted by admin",
+			want: []Statement{
+				&GrantStatement{
+					Privileges:      []*Privilege{{Name: "SELECT"}, {Name: "UPDATE", Columns: []string{"email", "name"}}},
+					Objects:         []string{"users", "s.orders"},
+					Grantees:        []string{"app_rw", "staff"},
+					WithGrantOption: true,
+					GrantedBy:       addr("admin"),
+				},
+			},
+		},
+		{
+			name:  "grant on object kinds",
+			input: "grant all privileges on all tables in schema app to public; grant usage, select on sequence s to r; grant execute on function f(int, text), g to r; grant connect, temp on database shop to r",
+			want: []Statement{
+				&GrantStatement{Privileges: []*Privilege{{Name: "ALL"}}, ObjectKind: "ALL TABLES IN SCHEMA", Objects: []string{"app"}, Grantees: []string{"public"}},
+				&GrantStatement{Privileges: []*Privilege{{Name: "USAGE"}, {Name: "SELECT"}}, ObjectKind: "SEQUENCE", Objects: []string{"s"}, Grantees: []string{"r"}},
+				&GrantStatement{
+					Privileges: []*Privilege{{Name: "EXECUTE"}},
+					ObjectKind: "FUNCTION",
+					Objects:    []string{"f", "g"},
+					Signatures: [][]*FunctionParameter{{{Type: &DataType{Name: "int"}}, {Type: &DataType{Name: "text"}}}, nil},
+					Grantees:   []string{"r"},
+				},
+				&GrantStatement{Privileges: []*Privilege{{Name: "CONNECT"}, {Name: "TEMP"}}, ObjectKind: "DATABASE", Objects: []string{"shop"}, Grantees: []string{"r"}},
+			},
+		},
+		{
+			name:  "grant role membership",
+			input: "grant admin, auditor to alice with admin option",
+			want:  []Statement{&GrantStatement{Roles: []string{"admin", "auditor"}, Grantees: []string{"alice"}, WithGrantOption: true}},
+		},
+		{
+			name:  "revoke",
+			input: "revoke grant option for insert on table t from r granted by owner cascade; revoke all on schema app from public; revoke admin from alice restrict",
+			want: []Statement{
+				&RevokeStatement{
+					GrantOptionFor: true,
+					Privileges:     []*Privilege{{Name: "INSERT"}},
+					ObjectKind:     "TABLE",
+					Objects:        []string{"t"},
+					Grantees:       []string{"r"},
+					GrantedBy:      addr("owner"),
+					Behavior:       DropCascade,
+				},
+				&RevokeStatement{Privileges: []*Privilege{{Name: "ALL"}}, ObjectKind: "SCHEMA", Objects: []string{"app"}, Grantees: []string{"public"}},
+				&RevokeStatement{Roles: []string{"admin"}, Grantees: []string{"alice"}, Behavior: DropRestrict},
+			},
+		},
+		{
+			name:  "create role and user",
+			input: "create role app with login nosuperuser connection limit 10 password 'secret' valid until '2030-01-01' in role staff, readers; create user bob encrypted password null admin alice",
+			want: []Statement{
+				&CreateRoleStatement{
+					Name: "app",
+					Options: []*RoleOption{
+						{Name: "LOGIN"},
+						{Name: "NOSUPERUSER"},
+						{Name: "CONNECTION LIMIT", Value: &NumericLiteral{Value: 10, Text: "10"}},
+						{Name: "PASSWORD", Value: &StringLiteral{Value: "secret"}},
+						{Name: "VALID UNTIL", Value: &StringLiteral{Value: "2030-01-01"}},
+						{Name: "IN ROLE", Roles: []string{"staff", "readers"}},
+					},
+				},
+				&CreateRoleStatement{
+					User:    true,
+					Name:    "bob",
+					Options: []*RoleOption{{Name: "PASSWORD", Value: &NullValue{}}, {Name: "ADMIN", Roles: []string{"alice"}}},
+				},
+			},
+		},
+		{
+			name:  "alter and drop roles",
+			input: "alter role app nologin createdb; alter user bob rename to robert; drop role if exists app, bob",
+			want: []Statement{
+				&AlterRoleStatement{Name: "app", Options: []*RoleOption{{Name: "NOLOGIN"}, {Name: "CREATEDB"}}},
+				&AlterRoleStatement{User: true, Name: "bob", NewName: addr("robert")},
+				&DropStatement{ObjectKind: "ROLE", IfExists: true, Names: []string{"app", "bob"}},
+			},
+		},
+		{
+			name: "mysql user accounts",
+			input: "create user 'u'@'localhost' identified by 'pw'; alter user 'u'@'%' identified by 'new'; " +
+				"drop user if exists 'u'@'localhost', legacy",
+			want: []Statement{
+				&CreateRoleStatement{
+					User:    true,
+					Name:    "u",
+					Host:    addr("localhost"),
+					Options: []*RoleOption{{Name: "IDENTIFIED BY", Value: &StringLiteral{Value: "pw"}}},
+				},
+				&AlterRoleStatement{
+					User:    true,
+					Name:    "u",
+					Host:    addr("%"),
+					Options: []*RoleOption{{Name: "IDENTIFIED BY", Value: &StringLiteral{Value: "new"}}},
+				},
+				&DropStatement{ObjectKind: "USER", IfExists: true, Names: []string{"u", "legacy"}, Hosts: []*string{addr("localhost"), nil}},
+			},
+		},
+		{
+			name:    "identified by without a string",
+			input:   "create user 'u'@'localhost' identified by pw",
+			wantErr: true,
+		},
+		{
+			name:  "alter role set and reset",
+			input: "alter role app set search_path to app, public; alter user bob in database shop set statement_timeout = '5s'; alter role app reset all; alter role app in database shop reset work_mem",
+			want: []Statement{
+				&AlterRoleStatement{
+					Name: "app",
+					Set:  &SetStatement{Name: "search_path", Values: []Expression{&ColumnExpression{Name: "app"}, &ColumnExpression{Name: "public"}}},
+				},
+				&AlterRoleStatement{
+					User:     true,
+					Name:     "bob",
+					Database: addr("shop"),
+					Set:      &SetStatement{Name: "statement_timeout", Values: []Expression{&StringLiteral{Value: "5s"}}},
+				},
+				&AlterRoleStatement{Name: "app", Reset: &ResetStatement{Name: "ALL"}},
+				&AlterRoleStatement{Name: "app", Database: addr("shop"), Reset: &ResetStatement{Name: "work_mem"}},
+			},
+		},
+		{
+			name:  "alter default privileges",
+			input: "alter default privileges for role admin in schema app, audit grant select, insert on tables to app_rw; alter default privileges revoke grant option for execute on functions from public cascade",
+			want: []Statement{
+				&AlterDefaultPrivilegesStatement{
+					Roles:   []string{"admin"},
+					Schemas: []string{"app", "audit"},
+					Action:  &GrantStatement{Privileges: []*Privilege{{Name: "SELECT"}, {Name: "INSERT"}}, ObjectKind: "TABLES", Grantees: []string{"app_rw"}},
+				},
+				&AlterDefaultPrivilegesStatement{
+					Action: &RevokeStatement{GrantOptionFor: true, Privileges: []*Privilege{{Name: "EXECUTE"}}, ObjectKind: "FUNCTIONS", Grantees: []string{"public"}, Behavior: DropCascade},
+				},
+			},
+		},
+		{
+			name:  "mysql privilege levels and accounts",
+			input: "grant select, insert on shop.* to 'app'@'%', 'ro'@localhost; grant all on *.* to root@'10.0.0.1' with grant option; revoke select on shop.orders from 'app'@'%', admin",
+			want: []Statement{
+				&GrantStatement{
+					Privileges: []*Privilege{{Name: "SELECT"}, {Name: "INSERT"}},
+					Objects:    []string{"shop.*"},
+					Grantees:   []string{"app", "ro"},
+					Hosts:      []*string{addr("%"), addr("localhost")},
+				},
+				&GrantStatement{
+					Privileges:      []*Privilege{{Name: "ALL"}},
+					Objects:         []string{"*.*"},
+					Grantees:        []string{"root"},
+					Hosts:           []*string{addr("10.0.0.1")},
+					WithGrantOption: true,
+				},
+				&RevokeStatement{
+					Privileges: []*Privilege{{Name: "SELECT"}},
+					Objects:    []string{"shop.orders"},
+					Grantees:   []string{"app", "admin"},
+					Hosts:      []*string{addr("%"), nil},
+				},
+			},
+		},
+		{
+			name:    "alter default privileges on a named object",
+			input:   "alter default privileges grant select on table t to r",
+			wantErr: true,
+		},
+		{
+			name:    "alter default privileges granting a role",
+			input:   "alter default privileges grant admin to r",
+			wantErr: true,
+		},
+		{
+			name:    "alter role in database without set",
+			input:   "alter role app in database shop rename to svc",
+			wantErr: true,
+		},
+		{
+			name:    "mixed privileges and roles",
+			input:   "grant select, admin on t to r",
+			wantErr: true,
+		},
+		{
+			name:    "grant without grantees",
+			input:   "grant select on t",
+			wantErr: true,
+		},
+		{
+			name:    "revoke with to",
+			input:   "revoke select on t to r",
+			wantErr: true,
+		},
+		{
+			name:    "alter role without options",
+			input:   "alter role app",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseMerge(t *testing.T) {
+	col := func(table, name string) *ColumnExpression { return &ColumnExpression{Table: addr(table), Name: name} }
+	on := &BinaryExpression{Left: col("t", "id"), Operator: tokens.TokenEqual, Right: col("s", "id")}
+	runSQLTests(t, []sqlTest{
+		{
+			name: "upsert with ordered clauses",
+			input: `merge into inventory as t using staging s on t.id = s.id
+				when matched and s.qty = 0 then delete
+				when matched then update set qty = s.qty, (a, b) = (s.a, s.b)
+				when not matched then insert (id, qty) values (s.id, s.qty)`,
+			want: []Statement{
+				&MergeStatement{
+					Target: &TableName{Name: "inventory", Alias: addr("t")},
+					Source: &TableName{Name: "staging", Alias: addr("s")},
+					On:     on,
+					Clauses: []*MergeWhenClause{
+						{Match: MergeMatched, Condition: &BinaryExpression{Left: col("s", "qty"), Operator: tokens.TokenEqual, Right: &NumericLiteral{Value: 0, Text: "0"}}, Action: MergeDelete},
+						{
+							Match:  MergeMatched,
+							Action: MergeUpdate,
+							Assignments: []*Assignment{
+								{Column: "qty", Value: col("s", "qty")},
+								{Columns: []string{"a", "b"}, Value: &RowExpression{Items: []Expression{col("s", "a"), col("s", "b")}}},
+							},
+						},
+						{Match: MergeNotMatched, Action: MergeInsert, Columns: []string{"id", "qty"}, Values: []Expression{col("s", "id"), col("s", "qty")}},
+					},
+				},
+			},
+		},
+		{
+			name:  "subquery source, by source and by target, do nothing",
+			input: "merge t using (select id from u) as s on t.id = s.id when not matched by source then delete when not matched by target then insert default values when matched then do nothing returning t.id",
+			want: []Statement{
+				&MergeStatement{
+					Target: &TableName{Name: "t"},
+					Source: &SubqueryTable{Query: &SelectStatement{Expressions: []Expression{&ColumnExpression{Name: "id"}}, From: []TableExpression{&TableName{Name: "u"}}}, Alias: addr("s")},
+					On:     on,
+					Clauses: []*MergeWhenClause{
+						{Match: MergeNotMatchedBySource, Action: MergeDelete},
+						{Match: MergeNotMatched, Action: MergeInsert, DefaultValues: true},
+						{Match: MergeMatched, Action: MergeDoNothing},
+					},
+					Returning: []Expression{col("t", "id")},
+				},
+			},
+		},
+		{
+			name:  "with clause",
+			input: "with s as (select 1 as id) merge into t using s on t.id = s.id when matched then delete",
+			want: []Statement{
+				&MergeStatement{
+					With: &WithClause{
+						CTEs: []*CommonTableExpression{
+							{Name: "s", Query: &SelectStatement{Expressions: []Expression{&AliasedExpression{Expression: &NumericLiteral{Value: 1, Text: "1"}, Alias: "id"}}}},
+						},
+					},
+					Target:  &TableName{Name: "t"},
+					Source:  &TableName{Name: "s"},
+					On:      on,
+					Clauses: []*MergeWhenClause{{Match: MergeMatched, Action: MergeDelete}},
+				},
+			},
+		},
+		{
+			name:    "without when clauses",
+			input:   "merge into t using s on t.id = s.id",
+			wantErr: true,
+		},
+		{
+			name:    "insert when matched",
+			input:   "merge into t using s on t.id = s.id when matched then insert values (1)",
+			wantErr: true,
+		},
+		{
+			name:    "update when not matched",
+			input:   "merge into t using s on t.id = s.id when not matched then update set a = 1",
+			wantErr: true,
+		},
+		{
+			name:    "missing using",
+			input:   "merge into t on t.id = 1 when matched then delete",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseUtilityStatements(t *testing.T) {
+	selectWhereID := &SelectStatement{
+		Expressions: []Expression{&ColumnExpression{Name: "*"}},
+		From:        []TableExpression{&TableName{Name: "users"}},
+		Where:       &BinaryExpression{Left: &ColumnExpression{Name: "id"}, Operator: tokens.TokenEqual, Right: &PositionalParameter{Index: 1}},
+	}
+	runSQLTests(t, []sqlTest{
+		{
+			name:  "explain",
+			input: "explain select * from users where id = $1; explain analyze verbose delete from t; explain (analyze, costs off, format json) update t set a = 1",
+			want: []Statement{
+				&ExplainStatement{Statement: selectWhereID},
+				&ExplainStatement{
+					Options:   []*UtilityOption{{Name: "ANALYZE"}, {Name: "VERBOSE"}},
+					Statement: &DeleteStatement{Tables: []TableExpression{&TableName{Name: "t"}}},
+				},
+				&ExplainStatement{
+					Options:   []*UtilityOption{{Name: "ANALYZE"}, {Name: "COSTS", Value: addr("off")}, {Name: "FORMAT", Value: addr("json")}},
+					Statement: &UpdateStatement{Tables: []TableExpression{&TableName{Name: "t"}}, Assignments: []*Assignment{{Column: "a", Value: &NumericLiteral{Value: 1, Text: "1"}}}},
+				},
+			},
+		},
+		{
+			name:  "mysql explain format",
+			input: "explain format = json select 1",
+			want: []Statement{
+				&ExplainStatement{
+					Options:   []*UtilityOption{{Name: "FORMAT", Value: addr("json")}},
+					Statement: &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+				},
+			},
+		},
+		{
+			name:  "prepare, execute and deallocate",
+			input: "prepare find_user (int) as select * from users where id = $1; execute find_user (42); execute refresh_all; deallocate prepare find_user; deallocate all",
+			want: []Statement{
+				&PrepareStatement{Name: "find_user", Types: []*DataType{{Name: "int"}}, Statement: selectWhereID},
+				&ExecuteStatement{Name: "find_user", Arguments: []Expression{&NumericLiteral{Value: 42, Text: "42"}}},
+				&ExecuteStatement{Name: "refresh_all"},
+				&DeallocateStatement{Name: "find_user"},
+				&DeallocateStatement{Name: "ALL"},
+			},
+		},
+		{
+			name:  "mysql placeholders",
+			input: "select * from users where id = ? and name = ?; explain update t set a = ?",
+			want: []Statement{
+				&SelectStatement{
+					Expressions: []Expression{&ColumnExpression{Name: "*"}},
+					From:        []TableExpression{&TableName{Name: "users"}},
+					Where: &BinaryExpression{
+						Left:     &BinaryExpression{Left: &ColumnExpression{Name: "id"}, Operator: tokens.TokenEqual, Right: &PositionalParameter{Index: 1, Question: true}},
+						Operator: tokens.TokenAnd,
+						Right:    &BinaryExpression{Left: &ColumnExpression{Name: "name"}, Operator: tokens.TokenEqual, Right: &PositionalParameter{Index: 2, Question: true}},
+					},
+				},
+				&ExplainStatement{
+					Statement: &UpdateStatement{Tables: []TableExpression{&TableName{Name: "t"}}, Assignments: []*Assignment{{Column: "a", Value: &PositionalParameter{Index: 1, Question: true}}}},
+				},
+			},
+		},
+		{
+			name:  "explain execute",
+			input: "explain execute find_user(1)",
+			want:  []Statement{&ExplainStatement{Statement: &ExecuteStatement{Name: "find_user", Arguments: []Expression{&NumericLiteral{Value: 1, Text: "1"}}}}},
+		},
+		{
+			name:  "analyze and vacuum",
+			input: "analyze; analyze verbose users (email, name), s.orders; vacuum full analyze users; vacuum (verbose, truncate false, parallel 4) t",
+			want: []Statement{
+				&AnalyzeStatement{},
+				&AnalyzeStatement{
+					Options: []*UtilityOption{{Name: "VERBOSE"}},
+					Tables:  []*VacuumRelation{{Table: "users", Columns: []string{"email", "name"}}, {Table: "s.orders"}},
+				},
+				&VacuumStatement{Options: []*UtilityOption{{Name: "FULL"}, {Name: "ANALYZE"}}, Tables: []*VacuumRelation{{Table: "users"}}},
+				&VacuumStatement{
+					Options: []*UtilityOption{{Name: "VERBOSE"}, {Name: "TRUNCATE", Value: addr("false")}, {Name: "PARALLEL", Value: addr("4")}},
+					Tables:  []*VacuumRelation{{Table: "t"}},
+				},
+			},
+		},
+		{
+			name:    "explain without statement",
+			input:   "explain analyze",
+			wantErr: true,
+		},
+		{
+			name:    "explain explain",
+			input:   "explain explain select 1",
+			wantErr: true,
+		},
+		{
+			name:    "explain ddl",
+			input:   "explain create table t (a int)",
+			wantErr: true,
+		},
+		{
+			name:    "prepare without as",
+			input:   "prepare p select 1",
+			wantErr: true,
+		},
+		{
+			name:    "unclosed explain options",
+			input:   "explain (analyze select 1",
+			wantErr: true,
+		},
+	})
+}
+
+func TestParseCopyAndLoadData(t *testing.T) {
+	runSQLTests(t, []sqlTest{
+		{
+			name: "pg_dump data blocks",
+			input: "--\n-- PostgreSQL database dump\n--\n\nSET statement_timeout = 0;\n" +
+				"COPY public.users (id, name, note) FROM stdin;\n1\talice\tit's; fine\n2\tbob\t\\N\n\\.\n\n" +
+				"COPY public.empty (id) FROM stdin;\n\\.\n-- done\nselect 1;\n",
+			want: []Statement{
+				&SetStatement{Name: "statement_timeout", Values: []Expression{&NumericLiteral{Value: 0, Text: "0"}}},
+				&CopyStatement{Table: "public.users", Columns: []string{"id", "name", "note"}, Data: addr("1\talice\tit's; fine\n2\tbob\t\\N\n")},
+				&CopyStatement{Table: "public.empty", Columns: []string{"id"}, Data: addr("")},
+				&SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+			},
+		},
+		{
+			name:  "copy to and from files",
+			input: "copy t to '/tmp/t.csv' with (format csv, header true, delimiter ';'); copy t from program 'gunzip -c t.gz' where id > 0; copy (select id from t) to stdout with csv header delimiter as '|' null ''",
+			want: []Statement{
+				&CopyStatement{
+					Table:   "t",
+					To:      true,
+					File:    addr("/tmp/t.csv"),
+					Options: []*UtilityOption{{Name: "FORMAT", Value: addr("csv")}, {Name: "HEADER", Value: addr("true")}, {Name: "DELIMITER", Value: addr(";"), Quoted: true}},
+				},
+				&CopyStatement{
+					Table:   "t",
+					File:    addr("gunzip -c t.gz"),
+					Program: true,
+					Where:   &BinaryExpression{Left: &ColumnExpression{Name: "id"}, Operator: tokens.TokenGreaterThan, Right: &NumericLiteral{Value: 0, Text: "0"}},
+				},
+				&CopyStatement{
+					Query:   &SelectStatement{Expressions: []Expression{&ColumnExpression{Name: "id"}}, From: []TableExpression{&TableName{Name: "t"}}},
+					To:      true,
+					Options: []*UtilityOption{{Name: "CSV"}, {Name: "HEADER"}, {Name: "DELIMITER", Value: addr("|"), Quoted: true}, {Name: "NULL", Value: addr(""), Quoted: true}},
+				},
+			},
+		},
+		{
+			name: "load data infile",
+			input: "load data local infile '/tmp/u.csv' replace into table users character set utf8mb4 " +
+				"fields terminated by ',' optionally enclosed by '\"' lines terminated by '\\n' ignore 1 lines (id, name) set created_at = now()",
+			want: []Statement{
+				&LoadDataStatement{
+					Local:              true,
+					File:               "/tmp/u.csv",
+					Duplicates:         "REPLACE",
+					Table:              "users",
+					CharacterSet:       addr("utf8mb4"),
+					FieldsTerminatedBy: addr(","),
+					FieldsEnclosedBy:   addr(`"`),
+					OptionallyEnclosed: true,
+					LinesTerminatedBy:  addr(`\n`),
+					IgnoreLines:        1,
+					Columns:            []string{"id", "name"},
+					Assignments:        []*Assignment{{Column: "created_at", Value: &FunctionCall{Name: "now"}}},
+				},
+			},
+		},
+		{
+			name:  "copy options named by keywords or taking columns",
+			input: "copy t from '/tmp/t.csv' with (format csv, null '', default '\\D', force_null (a, b), force_not_null (c)); copy t to stdout with (format csv, force_quote *)",
+			want: []Statement{
+				&CopyStatement{
+					Table: "t",
+					File:  addr("/tmp/t.csv"),
+					Options: []*UtilityOption{
+						{Name: "FORMAT", Value: addr("csv")},
+						{Name: "NULL", Value: addr(""), Quoted: true},
+						{Name: "DEFAULT", Value: addr(`\D`), Quoted: true},
+						{Name: "FORCE_NULL", Columns: []string{"a", "b"}},
+						{Name: "FORCE_NOT_NULL", Columns: []string{"c"}},
+					},
+				},
+				&CopyStatement{
+					Table:   "t",
+					To:      true,
+					Options: []*UtilityOption{{Name: "FORMAT", Value: addr("csv")}, {Name: "FORCE_QUOTE", AllColumns: true}},
+				},
+			},
+		},
+		{
+			name:  "load data into user variables",
+			input: "load data infile '/tmp/u.csv' into table t (a, @b) set c = @b * 2",
+			want: []Statement{
+				&LoadDataStatement{
+					File:    "/tmp/u.csv",
+					Table:   "t",
+					Columns: []string{"a", "@b"},
+					Assignments: []*Assignment{{
+						Column: "c",
+						Value:  &BinaryExpression{Left: &UserVariable{Name: "b"}, Operator: tokens.TokenMultiply, Right: &NumericLiteral{Value: 2, Text: "2"}},
+					}},
+				},
+			},
+		},
+		{
+			name:    "copy option without a name",
+			input:   "copy t to stdout with ('x')",
+			wantErr: true,
+		},
+		{
+			name:    "copy query from file",
+			input:   "copy (select 1) from '/tmp/x'",
+			wantErr: true,
+		},
+		{
+			name:    "copy to stdin",
+			input:   "copy t to stdin",
+			wantErr: true,
+		},
+		{
+			name:    "load data without table",
+			input:   "load data infile 'x' into users",
+			wantErr: true,
+		},
+		{
+			name:    "fields without options",
+			input:   "load data infile 'x' into table t fields lines terminated by ';'",
+			wantErr: true,
+		},
+	})
+}
+
+func TestNodeSpans(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "select clauses",
+			input: "WITH t AS (SELECT 1) SELECT (a + b) * c AS x, -d::int FROM t WHERE f IN (1, 2) ORDER BY (g) DESC LIMIT 5;",
+			want: []string{
+				`*parser.SelectStatement "WITH t AS (SELECT 1) SELECT (a + b) * c AS x, -d::int FROM t WHERE f IN (1, 2) ORDER BY (g) DESC LIMIT 5"`,
+				`  *parser.WithClause "WITH t AS (SELECT 1)"`,
+				`    *parser.CommonTableExpression "t AS (SELECT 1)"`,
+				`      *parser.SelectStatement "SELECT 1"`,
+				`        *parser.NumericLiteral "1"`,
+				`  *parser.AliasedExpression "(a + b) * c AS x"`,
+				`    *parser.BinaryExpression "(a + b) * c"`,
+				`      *parser.BinaryExpression "a + b"`,
+				`        *parser.ColumnExpression "a"`,
+				`        *parser.ColumnExpression "b"`,
+				`      *parser.ColumnExpression "c"`,
+				`  *parser.UnaryExpression "-d::int"`,
+				`    *parser.CastExpression "d::int"`,
+				`      *parser.ColumnExpression "d"`,
+				`      *parser.DataType "int"`,
+				`  *parser.TableName "t"`,
+				`  *parser.InExpression "f IN (1, 2)"`,
+				`    *parser.ColumnExpression "f"`,
+				`    *parser.NumericLiteral "1"`,
+				`    *parser.NumericLiteral "2"`,
+				`  *parser.OrderByItem "(g) DESC"`,
+				`    *parser.ColumnExpression "g"`,
+				`  *parser.Limit "LIMIT 5"`,
+				`    *parser.NumericLiteral "5"`,
+			},
+		},
+		{
+			name:  "set operation with a parenthesized operand",
+			input: "(select 1) union all select 2 order by 1",
+			want: []string{
+				`*parser.SetOperation "(select 1) union all select 2 order by 1"`,
+				`  *parser.SelectStatement "select 1"`,
+				`    *parser.NumericLiteral "1"`,
+				`  *parser.SelectStatement "select 2"`,
+				`    *parser.NumericLiteral "2"`,
+				`  *parser.OrderByItem "1"`,
+				`    *parser.NumericLiteral "1"`,
+			},
+		},
+		{
+			name:  "statements after leading whitespace and comments",
+			input: "  -- columns\ncreate table x (id int primary key, name varchar(10) references y (id));\nupdate x set name = 'a' || name",
+			want: []string{
+				`*parser.CreateTableStatement "create table x (id int primary key, name varchar(10) references y (id))"`,
+				`  *parser.ColumnDefinition "id int primary key"`,
+				`    *parser.DataType "int"`,
+				`    *parser.ColumnConstraint "primary key"`,
+				`  *parser.ColumnDefinition "name varchar(10) references y (id)"`,
+				`    *parser.DataType "varchar(10)"`,
+				`      *parser.NumericLiteral "10"`,
+				`    *parser.ColumnConstraint "references y (id)"`,
+				`      *parser.ForeignKeyReference "references y (id)"`,
+				`*parser.UpdateStatement "update x set name = 'a' || name"`,
+				`  *parser.TableName "x"`,
+				`  *parser.Assignment "name = 'a' || name"`,
+				`    *parser.BinaryExpression "'a' || name"`,
+				`      *parser.StringLiteral "'a'"`,
+				`      *parser.ColumnExpression "name"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(lexer.NewLexer(tt.input).Lex()).Parse()
+			if err != nil {
+				t.Fatalf("Parser.Parse() error = %v", err)
+			}
+			var lines []string
+			var describe func(node Node, indent string)
+			describe = func(node Node, indent string) {
+				lines = append(lines, fmt.Sprintf("%s%T %q", indent, node, tt.input[node.Pos():node.End()]))
+				for _, child := range node.Children() {
+					describe(child, indent+"  ")
+				}
+			}
+			for _, stmt := range got {
+				describe(stmt, "")
+			}
+			if !reflect.DeepEqual(lines, tt.want) {
+				t.Errorf("spans =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,559 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/lexer"
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// Dialect selects the SQL dialect a printer writes, which decides how identifiers are quoted
+// and which spelling is used where the dialects differ.
+type Dialect int
+
+const (
+	// DialectPostgreSQL writes standard SQL as PostgreSQL accepts it, quoting identifiers with double quotes.
+	DialectPostgreSQL Dialect = iota
+	// DialectMySQL writes SQL as MySQL accepts it, quoting identifiers with backticks.
+	DialectMySQL
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case DialectPostgreSQL:
+		return "postgresql"
+	case DialectMySQL:
+		return "mysql"
+	default:
+		return "unknown_dialect"
+	}
+}
+
+// Case selects the letter case keywords or identifiers are written in.
+type Case int
+
+const (
+	// CaseDefault writes keywords in upper case and identifiers as they are in the tree.
+	CaseDefault Case = iota
+	// CaseUpper writes in upper case.
+	CaseUpper
+	// CaseLower writes in lower case.
+	CaseLower
+)
+
+func (c Case) String() string {
+	switch c {
+	case CaseDefault:
+		return "default"
+	case CaseUpper:
+		return "upper"
+	case CaseLower:
+		return "lower"
+	default:
+		return "unknown_case"
+	}
+}
+
+// defaultLineWidth is the line width used when PrintConfig.LineWidth is not set.
+const defaultLineWidth = 80
+
+// PrintConfig controls how an AST is written back as SQL. The zero value
+// writes PostgreSQL on a single line.
+type PrintConfig struct {
+	Dialect Dialect
+	// KeywordCase is the case keywords are written in.
+	KeywordCase Case
+	// IdentifierCase is the case identifiers are written in. Names written
+	// quoted in the parsed input are kept as they are, and other names are only
+	// changed if both the name and the changed name can be written bare.
+	IdentifierCase Case
+	// Indent is the number of spaces nested lines are indented by. Zero writes
+	// each statement on a single line, and any other value breaks statements
+	// into one clause per line and wraps what does not fit in LineWidth.
+	Indent int
+	// LineWidth is the number of columns lines are kept within where possible,
+	// 80 if zero.
+	LineWidth int
+	// CommaFirst starts the continuation lines of a wrapped list with its
+	// commas instead of ending the lines with them.
+	CommaFirst bool
+	// River right-aligns the first word of the keywords of each clause, so the
+	// clause bodies line up in a column.
+	River bool
+}
+
+// Print returns node written as SQL with the default configuration, or an
+// empty string if the node cannot be printed.
+func Print(node Node) string {
+	var b strings.Builder
+	if err := (&PrintConfig{}).Fprint(&b, node); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
+// Fprint writes node to w as SQL. Parsing the output yields a tree equal to
+// node apart from the spans. A statement is written without a terminating
+// semicolon, except a COPY FROM STDIN with inline data, which is followed by
+// its semicolon, the data and the \. line ending the data.
+func (c *PrintConfig) Fprint(w io.Writer, node Node) (err error) {
+	d, err := c.build(node, nil)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, c.render(d))
+	return err
+}
+
+// FprintScript writes stmts to w as a script, each statement followed by a semicolon and a newline.
+func (c *PrintConfig) FprintScript(w io.Writer, stmts []Statement) error {
+	var b strings.Builder
+	for _, stmt := range stmts {
+		d, err := c.build(stmt, nil)
+		if err != nil {
+			return err
+		}
+		b.WriteString(c.render(d))
+		// A COPY with inline data already ends with its semicolon and data.
+		if !hasCopyData(stmt) {
+			b.WriteString(";\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// hasCopyData reports whether stmt is a COPY whose inline data is printed with the statement.
+func hasCopyData(stmt Statement) bool {
+	copyStmt, ok := stmt.(*CopyStatement)
+	return ok && copyStmt.Data != nil
+}
+
+// ErrUnsupported is wrapped by the error of printing a tree that the dialect
+// has no way to write, such as an INSERT with ON CONFLICT for MySQL.
+var ErrUnsupported = errors.New("not supported by the dialect")
+
+// printError is raised by the doc builders for a tree that cannot be printed,
+// and recovered by build.
+type printError struct {
+	err error
+}
+
+// build converts node into a doc, turning a printError raised on the way into
+// an error. Those of comments that can be placed next to the nodes of node
+// are kept in the doc and taken out of comments.
+func (c *PrintConfig) build(node Node, comments *sourceComments) (d doc, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(printError)
+			if !ok {
+				panic(r)
+			}
+			err = e.err
+		}
+	}()
+	if isNilNode(node) {
+		return nil, fmt.Errorf("cannot print a nil node")
+	}
+	quoted := map[string]bool{}
+	Inspect(node, func(n Node) bool {
+		if s, ok := n.(spanned); ok {
+			for _, name := range s.quotedNames() {
+				quoted[name] = true
+			}
+		}
+		return true
+	})
+	return keepQuoted((&printer{config: c, comments: comments}).node(node), quoted), nil
+}
+
+// keepQuoted returns d with the identifiers named in quoted, which were
+// written quoted in the parsed input, marked to be written quoted again. The
+// names are collected per tree, so a name written both quoted and bare in one
+// statement is quoted in both places.
+func keepQuoted(d doc, quoted map[string]bool) doc {
+	if len(quoted) == 0 {
+		return d
+	}
+	switch d := d.(type) {
+	case docIdent:
+		if quoted[string(d)] {
+			return docQuotedIdent(d)
+		}
+	case docConcat:
+		for i, part := range d {
+			d[i] = keepQuoted(part, quoted)
+		}
+	case docList:
+		for i, item := range d {
+			d[i] = keepQuoted(item, quoted)
+		}
+	case docClauses:
+		for i, clause := range d {
+			d[i] = keepQuoted(clause, quoted)
+		}
+	case docParens:
+		d.body = keepQuoted(d.body, quoted)
+		return d
+	case docClause:
+		d.body = keepQuoted(d.body, quoted)
+		return d
+	case docChain:
+		for i, operand := range d.operands {
+			d.operands[i] = keepQuoted(operand, quoted)
+		}
+	case docCommented:
+		d.body = keepQuoted(d.body, quoted)
+		return d
+	case docJoins:
+		d.table = keepQuoted(d.table, quoted)
+		for i, join := range d.joins {
+			d.joins[i] = keepQuoted(join, quoted)
+		}
+		return d
+	}
+	return d
+}
+
+// printer converts the nodes of an AST into docs.
+type printer struct {
+	config   *PrintConfig
+	comments *sourceComments // the comments to keep in place, if any
+}
+
+// fail aborts printing with an error.
+func (p *printer) fail(format string, args ...any) {
+	panic(printError{fmt.Errorf(format, args...)})
+}
+
+// unsupported aborts printing because the dialect cannot write what is described.
+func (p *printer) unsupported(what string) {
+	p.fail("cannot print %s for %s: %w", what, p.config.Dialect, ErrUnsupported)
+}
+
+// doc is SQL text in a form that leaves the layout to the renderer: the
+// same doc is written on one line by Fprint and broken into lines by a formatter.
+type doc interface{}
+
+// docText is text written exactly as given, such as punctuation, operators and literals.
+type docText string
+
+// docKeyword is one or more SQL keywords separated by single spaces.
+type docKeyword string
+
+// docIdent is an identifier, written bare or quoted as the dialect requires.
+type docIdent string
+
+// docQuotedIdent is an identifier written quoted whatever its spelling.
+type docQuotedIdent string
+
+// docConcat is a sequence of docs written one after another.
+type docConcat []doc
+
+// docList is a comma-separated list, such as a select list or the columns of a table.
+type docList []doc
+
+// docParens is a doc enclosed in parentheses.
+type docParens struct {
+	body doc
+}
+
+// docClause is a clause introduced by keywords, such as WHERE condition. The body is nil
+// for clauses made of keywords alone.
+type docClause struct {
+	keyword string
+	body    doc
+}
+
+// docClauses is a sequence of clauses making up a statement or query, separated by spaces.
+type docClauses []doc
+
+// docChain is a condition joined by a logical operator, such as a AND b AND c.
+type docChain struct {
+	operator string
+	operands []doc
+}
+
+// docCommented is a doc with the comments of the source that stand on lines
+// of their own before it and the ones that follow it on its last line.
+type docCommented struct {
+	leading  []string
+	body     doc
+	trailing []string
+}
+
+// docJoins is a table followed by the joins to it, such as t JOIN u ON a = b.
+type docJoins struct {
+	table doc
+	joins []doc
+}
+
+// render writes d on a single line, or broken into lines if the configuration has an indent.
+func (c *PrintConfig) render(d doc) string {
+	if c.Indent > 0 {
+		return c.layout(d)
+	}
+	var b strings.Builder
+	c.renderFlat(&b, d)
+	return b.String()
+}
+
+func (c *PrintConfig) renderFlat(b *strings.Builder, d doc) {
+	switch d := d.(type) {
+	case nil:
+	case docText:
+		b.WriteString(string(d))
+	case docKeyword:
+		b.WriteString(c.keyword(string(d)))
+	case docIdent:
+		b.WriteString(c.identifier(string(d)))
+	case docQuotedIdent:
+		b.WriteString(c.quote(string(d)))
+	case docConcat:
+		for _, part := range d {
+			c.renderFlat(b, part)
+		}
+	case docList:
+		for i, item := range d {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			c.renderFlat(b, item)
+		}
+	case docParens:
+		b.WriteString("(")
+		c.renderFlat(b, d.body)
+		b.WriteString(")")
+	case docClause:
+		b.WriteString(c.keyword(d.keyword))
+		if d.body != nil {
+			b.WriteString(" ")
+			c.renderFlat(b, d.body)
+		}
+	case docClauses:
+		for i, clause := range d {
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			c.renderFlat(b, clause)
+		}
+	case docChain:
+		for i, operand := range d.operands {
+			if i > 0 {
+				b.WriteString(" " + c.keyword(d.operator) + " ")
+			}
+			c.renderFlat(b, operand)
+		}
+	case docCommented:
+		// The comments end lines, so a doc holding any never fits on a line of a layout.
+		for _, comment := range d.leading {
+			b.WriteString(comment + "\n")
+		}
+		c.renderFlat(b, d.body)
+		for _, comment := range d.trailing {
+			b.WriteString(" " + comment)
+		}
+		if len(d.trailing) > 0 {
+			b.WriteString("\n")
+		}
+	case docJoins:
+		c.renderFlat(b, d.table)
+		for _, join := range d.joins {
+			b.WriteString(" ")
+			c.renderFlat(b, join)
+		}
+	default:
+		panic(fmt.Sprintf("unexpected doc %T", d))
+	}
+}
+
+// keyword returns keywords in the configured case.
+func (c *PrintConfig) keyword(keywords string) string {
+	if c.KeywordCase == CaseLower {
+		return strings.ToLower(keywords)
+	}
+	return keywords
+}
+
+// identifier returns name as written in SQL in the configured case.
+func (c *PrintConfig) identifier(name string) string {
+	cased := name
+	switch c.IdentifierCase {
+	case CaseUpper:
+		cased = strings.ToUpper(name)
+	case CaseLower:
+		cased = strings.ToLower(name)
+	}
+	if cased != name && isBareIdentifier(name) && isBareIdentifier(cased) {
+		return cased
+	}
+	return c.quoteIdentifier(name)
+}
+
+// quoteIdentifier returns name as written in SQL: bare if the lexer reads it
+// back as the same identifier, and quoted otherwise, as for keywords, names
+// with spaces or punctuation, and names starting with a digit.
+func (c *PrintConfig) quoteIdentifier(name string) string {
+	if isBareIdentifier(name) {
+		return name
+	}
+	return c.quote(name)
+}
+
+// quote returns name as a quoted identifier of the dialect.
+func (c *PrintConfig) quote(name string) string {
+	quote := `"`
+	if c.Dialect == DialectMySQL {
+		quote = "`"
+	}
+	return quote + strings.ReplaceAll(name, quote, quote+quote) + quote
+}
+
+// isBareIdentifier reports whether name lexes as a single unquoted identifier.
+func isBareIdentifier(name string) bool {
+	if name == "" || strings.ContainsAny(name[:1], "\"`") {
+		return false
+	}
+	lexed := lexer.NewLexer(name).Lex()
+	return len(lexed) == 2 && lexed[0].Type == tokens.TokenIdentifier && lexed[0].Literal == name
+}
+
+// quoteString returns value as a single-quoted string literal.
+func quoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// dollarQuote returns value as a dollar-quoted string literal, with a tag whose
+// first occurrence after the opening one is the closing tag: one that neither
+// occurs in value nor forms with the end of value, as $$ does after a dollar sign.
+func dollarQuote(value string) string {
+	tag := "$$"
+	for i := 1; strings.Index(value+tag, tag) < len(value); i++ {
+		tag = "$body" + strconv.Itoa(i) + "$"
+		if i == 1 {
+			tag = "$body$"
+		}
+	}
+	return tag + value + tag
+}
+
+// formatNumber returns the shortest decimal form of a numeric literal that the lexer reads back.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// words joins the non-nil parts with single spaces, returning nil if every part is nil
+// and the part itself if there is only one.
+func words(parts ...doc) doc {
+	var joined docConcat
+	for _, part := range parts {
+		if part == nil {
+			continue
+		}
+		if len(joined) > 0 {
+			joined = append(joined, docText(" "))
+		}
+		joined = append(joined, part)
+	}
+	switch len(joined) {
+	case 0:
+		return nil
+	case 1:
+		return joined[0]
+	}
+	return joined
+}
+
+// when returns d if cond holds, and nil otherwise.
+func when(cond bool, d doc) doc {
+	if !cond {
+		return nil
+	}
+	return d
+}
+
+// clause returns the clause of keyword and body, or nil if body is nil.
+func clause(keyword string, body doc) doc {
+	if body == nil {
+		return nil
+	}
+	return docClause{keyword: keyword, body: body}
+}
+
+// clauses collects the non-nil clauses of a statement.
+func clauses(parts ...doc) docClauses {
+	var collected docClauses
+	for _, part := range parts {
+		if part != nil {
+			collected = append(collected, part)
+		}
+	}
+	return collected
+}
+
+// qualifiedName returns a possibly qualified name, quoting each of its dot-separated parts.
+func qualifiedName(name string) doc {
+	parts := strings.Split(name, ".")
+	joined := make(docConcat, 0, 2*len(parts)-1)
+	for i, part := range parts {
+		if i > 0 {
+			joined = append(joined, docText("."))
+		}
+		joined = append(joined, docIdent(part))
+	}
+	return joined
+}
+
+// identifiers returns the names as a list of identifiers, or nil if there are none.
+func identifiers(names []string) doc {
+	if len(names) == 0 {
+		return nil
+	}
+	list := make(docList, len(names))
+	for i, name := range names {
+		list[i] = docIdent(name)
+	}
+	return list
+}
+
+// identifierList returns the names as a parenthesized list, or nil if there are none.
+func identifierList(names []string) doc {
+	if len(names) == 0 {
+		return nil
+	}
+	return docParens{identifiers(names)}
+}
+
+// qualifiedNames returns the possibly qualified names as a list.
+func qualifiedNames(names []string) doc {
+	list := make(docList, len(names))
+	for i, name := range names {
+		list[i] = qualifiedName(name)
+	}
+	return list
+}
+
+// optionalIdent returns the identifier name points to, or nil if name is nil.
+func optionalIdent(name *string) doc {
+	if name == nil {
+		return nil
+	}
+	return docIdent(*name)
+}
+
+// node converts any node into a doc.
+func (p *printer) node(node Node) doc {
+	switch n := node.(type) {
+	case Statement:
+		return p.statement(n)
+	case Expression:
+		return p.expression(n)
+	case TableExpression:
+		return p.tableExpression(n)
+	}
+	return p.clauseNode(node)
+}
@@ -0,0 +1,661 @@
+package parser
+
+import "strings"
+
+// ifNotExists returns IF NOT EXISTS, or nil if cond is false.
+func ifNotExists(cond bool) doc {
+	return when(cond, docKeyword("IF NOT EXISTS"))
+}
+
+// ifExists returns IF EXISTS, or nil if cond is false.
+func ifExists(cond bool) doc {
+	return when(cond, docKeyword("IF EXISTS"))
+}
+
+// createKeyword returns CREATE followed by the words that are written, such as OR REPLACE and TEMPORARY.
+func createKeyword(parts ...string) string {
+	keyword := "CREATE"
+	for _, part := range parts {
+		if part != "" {
+			keyword += " " + part
+		}
+	}
+	return keyword
+}
+
+// word returns w if cond holds, and an empty string otherwise.
+func word(cond bool, w string) string {
+	if !cond {
+		return ""
+	}
+	return w
+}
+
+func (p *printer) createTable(s *CreateTableStatement) doc {
+	var elements docList
+	for _, element := range s.Elements {
+		elements = append(elements, p.tableElement(element))
+	}
+	var body doc
+	if len(elements) > 0 {
+		body = docParens{elements}
+	}
+	var partitionOf, bound, inherits, partitionBy, query doc
+	if s.PartitionOf != "" {
+		partitionOf = words(docKeyword("PARTITION OF"), qualifiedName(s.PartitionOf))
+	}
+	if s.Bound != nil {
+		bound = p.partitionBound(s.Bound)
+	}
+	if len(s.Inherits) > 0 {
+		inherits = docParens{qualifiedNames(s.Inherits)}
+	}
+	if s.PartitionBy != nil {
+		partitionBy = words(docKeyword(s.PartitionBy.Strategy), docParens{p.expressions(s.PartitionBy.Keys)})
+	}
+	if s.Query != nil {
+		query = p.query(s.Query)
+	}
+	return clauses(
+		docClause{
+			keyword: createKeyword(word(s.Temporary, "TEMPORARY"), word(s.Unlogged, "UNLOGGED"), "TABLE"),
+			body:    words(ifNotExists(s.IfNotExists), qualifiedName(s.Name), partitionOf, body, bound),
+		},
+		p.tableOptions(s.Options),
+		clause("INHERITS", inherits),
+		clause("PARTITION BY", partitionBy),
+		clause("AS", query),
+		when(s.WithNoData, docKeyword("WITH NO DATA")),
+	)
+}
+
+// tableElement converts a column definition, table constraint or LIKE clause.
+func (p *printer) tableElement(e TableElement) (d doc) {
+	defer func() { d = p.commented(d, e) }()
+	switch e := e.(type) {
+	case *ColumnDefinition:
+		return p.columnDefinition(e)
+	case *TableConstraint:
+		return p.tableConstraint(e)
+	case *TableLikeClause:
+		return words(docKeyword("LIKE"), qualifiedName(e.Table), p.keywords(e.Options))
+	case *IndexDefinition:
+		return p.indexDefinition(e)
+	}
+	p.fail("cannot print table element %T", e)
+	return nil
+}
+
+// indexDefinition converts a MySQL index definition of CREATE TABLE or ALTER TABLE ADD,
+// which PostgreSQL writes as a separate CREATE INDEX.
+func (p *printer) indexDefinition(i *IndexDefinition) doc {
+	if p.config.Dialect != DialectMySQL {
+		p.unsupported("an index definition in a table")
+	}
+	var name, method doc
+	if i.Name != nil {
+		name = docIdent(*i.Name)
+	}
+	if i.Method != nil {
+		method = words(docKeyword("USING"), docIdent(*i.Method))
+	}
+	return words(docKeyword("INDEX"), name, method, identifierList(i.Columns))
+}
+
+// tableOptions converts MySQL table options, which have no PostgreSQL equivalent.
+func (p *printer) tableOptions(options []*UtilityOption) doc {
+	if len(options) == 0 {
+		return nil
+	}
+	if p.config.Dialect != DialectMySQL {
+		p.unsupported("table options")
+	}
+	parts := make([]doc, len(options))
+	for i, option := range options {
+		parts[i] = words(docKeyword(option.Name), docText("="), utilityValue(option))
+	}
+	return words(parts...)
+}
+
+// keywords returns words written as keywords one after another, or nil if there are none.
+func (p *printer) keywords(list []string) doc {
+	parts := make([]doc, len(list))
+	for i, w := range list {
+		parts[i] = docKeyword(w)
+	}
+	return words(parts...)
+}
+
+func (p *printer) columnDefinition(c *ColumnDefinition) doc {
+	var dataType, collation doc
+	if c.Type != nil {
+		dataType = p.dataType(c.Type)
+	}
+	if c.Collation != nil {
+		collation = words(docKeyword("COLLATE"), docIdent(*c.Collation))
+	}
+	return words(docIdent(c.Name), dataType, collation, p.columnConstraints(c.Constraints))
+}
+
+// columnConstraints converts the constraints of a column or domain, or returns nil if there are none.
+func (p *printer) columnConstraints(constraints []*ColumnConstraint) doc {
+	parts := make([]doc, len(constraints))
+	for i, constraint := range constraints {
+		parts[i] = p.columnConstraint(constraint)
+	}
+	return words(parts...)
+}
+
+func (p *printer) columnConstraint(c *ColumnConstraint) doc {
+	var body doc
+	switch c.Kind {
+	case ConstraintDefault:
+		body = words(docKeyword("DEFAULT"), p.expression(c.Expression))
+	case ConstraintCheck:
+		body = words(docKeyword("CHECK"), docParens{p.expression(c.Expression)})
+	case ConstraintReferences:
+		body = p.references(c.References)
+	case ConstraintGenerated:
+		body = words(docKeyword("GENERATED ALWAYS AS"), docParens{p.expression(c.Expression)}, docKeyword("STORED"))
+	case ConstraintIdentity:
+		keyword := "GENERATED ALWAYS AS IDENTITY"
+		if c.Generated == GeneratedByDefault {
+			keyword = "GENERATED BY DEFAULT AS IDENTITY"
+		}
+		var options doc
+		if len(c.IdentityOptions) > 0 {
+			options = docParens{p.sequenceOptions(c.IdentityOptions)}
+		}
+		body = words(docKeyword(keyword), options)
+	case ConstraintUnique:
+		body = words(docKeyword("UNIQUE"), when(c.NullsNotDistinct, docKeyword("NULLS NOT DISTINCT")))
+	case ConstraintNotNull, ConstraintNull, ConstraintPrimaryKey, ConstraintAutoIncrement:
+		body = docKeyword(c.Kind.String())
+	default:
+		p.fail("cannot print column constraint %s", c.Kind)
+	}
+	return words(constraintName(c.Name), body, deferrable(c.Deferrable, c.InitiallyDeferred))
+}
+
+// deferrable returns the [NOT] DEFERRABLE and INITIALLY clauses of a constraint, or nil if neither was written.
+func deferrable(deferrable, initiallyDeferred *bool) doc {
+	var parts [2]doc
+	if deferrable != nil {
+		parts[0] = docKeyword("NOT DEFERRABLE")
+		if *deferrable {
+			parts[0] = docKeyword("DEFERRABLE")
+		}
+	}
+	if initiallyDeferred != nil {
+		parts[1] = docKeyword("INITIALLY IMMEDIATE")
+		if *initiallyDeferred {
+			parts[1] = docKeyword("INITIALLY DEFERRED")
+		}
+	}
+	return words(parts[:]...)
+}
+
+// constraintName returns CONSTRAINT name, or nil if the constraint is unnamed.
+func constraintName(name *string) doc {
+	if name == nil {
+		return nil
+	}
+	return words(docKeyword("CONSTRAINT"), docIdent(*name))
+}
+
+// sequenceOptions converts the options of a sequence or identity column, separated by spaces.
+func (p *printer) sequenceOptions(options []*SequenceOption) doc {
+	parts := make([]doc, len(options))
+	for i, option := range options {
+		parts[i] = p.sequenceOption(option)
+	}
+	return words(parts...)
+}
+
+func (p *printer) sequenceOption(s *SequenceOption) doc {
+	if s.Type != nil {
+		return words(docKeyword(s.Name), p.dataType(s.Type))
+	}
+	keyword := s.Name
+	switch {
+	case s.Value == nil:
+		return docKeyword(keyword)
+	case keyword == "START" || keyword == "RESTART":
+		keyword += " WITH"
+	case keyword == "INCREMENT":
+		keyword += " BY"
+	}
+	return words(docKeyword(keyword), p.expression(s.Value))
+}
+
+// references converts the REFERENCES part of a foreign key.
+func (p *printer) references(r *ForeignKeyReference) doc {
+	var match, onDelete, onUpdate doc
+	if r.Match != "" {
+		match = docKeyword("MATCH " + r.Match)
+	}
+	if r.OnDelete != ActionDefault {
+		onDelete = docKeyword("ON DELETE " + r.OnDelete.String())
+	}
+	if r.OnUpdate != ActionDefault {
+		onUpdate = docKeyword("ON UPDATE " + r.OnUpdate.String())
+	}
+	return words(docKeyword("REFERENCES"), qualifiedName(r.Table), identifierList(r.Columns), match, onDelete, onUpdate)
+}
+
+func (p *printer) tableConstraint(t *TableConstraint) doc {
+	var body doc
+	switch t.Kind {
+	case TableConstraintPrimaryKey, TableConstraintUnique:
+		body = words(docKeyword(t.Kind.String()), when(t.NullsNotDistinct, docKeyword("NULLS NOT DISTINCT")), identifierList(t.Columns))
+	case TableConstraintForeignKey:
+		body = words(docKeyword("FOREIGN KEY"), identifierList(t.Columns), p.references(t.References))
+	case TableConstraintCheck:
+		body = words(docKeyword("CHECK"), docParens{p.expression(t.Check)})
+	case TableConstraintExclude:
+		var method, where doc
+		if t.IndexMethod != nil {
+			method = words(docKeyword("USING"), docIdent(*t.IndexMethod))
+		}
+		elements := make(docList, len(t.Exclusions))
+		for i, exclusion := range t.Exclusions {
+			elements[i] = words(p.expression(exclusion.Expression), docKeyword("WITH"), docText(exclusion.Operator))
+		}
+		if t.Where != nil {
+			where = words(docKeyword("WHERE"), docParens{p.expression(t.Where)})
+		}
+		body = words(docKeyword("EXCLUDE"), method, docParens{elements}, where)
+	default:
+		p.fail("cannot print table constraint %s", t.Kind)
+	}
+	return words(constraintName(t.Name), body, deferrable(t.Deferrable, t.InitiallyDeferred))
+}
+
+func (p *printer) alterTable(s *AlterTableStatement) doc {
+	actions := make(docList, len(s.Actions))
+	for i, action := range s.Actions {
+		actions[i] = p.commented(p.alterTableAction(action), action)
+	}
+	return clauses(
+		docClause{keyword: "ALTER TABLE", body: words(ifExists(s.IfExists), when(s.Only, docKeyword("ONLY")), qualifiedName(s.Name))},
+		actions,
+	)
+}
+
+func (p *printer) alterTableAction(a *AlterTableAction) doc {
+	var position doc
+	switch {
+	case a.First:
+		position = docKeyword("FIRST")
+	case a.After != nil:
+		position = words(docKeyword("AFTER"), docIdent(*a.After))
+	}
+	name := docIdent(a.Name)
+	newName := docIdent(a.NewName)
+	switch a.Kind {
+	case AlterAddColumn:
+		return words(docKeyword("ADD COLUMN"), ifNotExists(a.IfNotExists), p.columnDefinition(a.Column), position)
+	case AlterDropColumn:
+		return words(docKeyword("DROP COLUMN"), ifExists(a.IfExists), name, dropBehavior(a.Behavior))
+	case AlterRenameColumn:
+		return words(docKeyword("RENAME COLUMN"), name, docKeyword("TO"), newName)
+	case AlterColumnType:
+		var using doc
+		if a.Using != nil {
+			using = words(docKeyword("USING"), p.expression(a.Using))
+		}
+		return words(docKeyword("ALTER COLUMN"), name, docKeyword("TYPE"), p.dataType(a.Type), using)
+	case AlterColumnSetDefault:
+		return words(docKeyword("ALTER COLUMN"), name, docKeyword("SET DEFAULT"), p.expression(a.Default))
+	case AlterColumnDropDefault:
+		return words(docKeyword("ALTER COLUMN"), name, docKeyword("DROP DEFAULT"))
+	case AlterColumnSetNotNull:
+		return words(docKeyword("ALTER COLUMN"), name, docKeyword("SET NOT NULL"))
+	case AlterColumnDropNotNull:
+		return words(docKeyword("ALTER COLUMN"), name, docKeyword("DROP NOT NULL"))
+	case AlterAddConstraint:
+		return words(docKeyword("ADD"), p.tableConstraint(a.Constraint), when(a.NotValid, docKeyword("NOT VALID")))
+	case AlterAddIndex:
+		return words(docKeyword("ADD"), p.indexDefinition(a.Index))
+	case AlterDropConstraint:
+		return words(docKeyword("DROP CONSTRAINT"), ifExists(a.IfExists), name, dropBehavior(a.Behavior))
+	case AlterValidateConstraint:
+		return words(docKeyword("VALIDATE CONSTRAINT"), name)
+	case AlterRenameConstraint:
+		return words(docKeyword("RENAME CONSTRAINT"), name, docKeyword("TO"), newName)
+	case AlterRenameTable:
+		return words(docKeyword("RENAME TO"), newName)
+	case AlterSetSchema:
+		return words(docKeyword("SET SCHEMA"), newName)
+	case AlterOwnerTo:
+		return words(docKeyword("OWNER TO"), newName)
+	case AlterAttachPartition:
+		return words(docKeyword("ATTACH PARTITION"), qualifiedName(a.Name), p.partitionBound(a.Bound))
+	case AlterDetachPartition:
+		return words(docKeyword("DETACH PARTITION"), qualifiedName(a.Name),
+			when(a.Concurrent, docKeyword("CONCURRENTLY")), when(a.Finalize, docKeyword("FINALIZE")))
+	case AlterModifyColumn:
+		return words(docKeyword("MODIFY COLUMN"), p.columnDefinition(a.Column), position)
+	case AlterChangeColumn:
+		return words(docKeyword("CHANGE COLUMN"), name, p.columnDefinition(a.Column), position)
+	}
+	p.fail("cannot print ALTER TABLE action %d", a.Kind)
+	return nil
+}
+
+func (p *printer) partitionBound(b *PartitionBound) doc {
+	switch {
+	case b.Default:
+		return docKeyword("DEFAULT")
+	case b.In != nil:
+		return words(docKeyword("FOR VALUES IN"), docParens{p.expressions(b.In)})
+	case b.Modulus != nil:
+		return words(docKeyword("FOR VALUES WITH"), docParens{docList{
+			words(docKeyword("MODULUS"), p.expression(b.Modulus)),
+			words(docKeyword("REMAINDER"), p.expression(b.Remainder)),
+		}})
+	}
+	return words(docKeyword("FOR VALUES FROM"), docParens{p.expressions(b.From)}, docKeyword("TO"), docParens{p.expressions(b.To)})
+}
+
+func (p *printer) createIndex(s *CreateIndexStatement) doc {
+	var method, include doc
+	if s.Method != nil {
+		method = words(docKeyword("USING"), docIdent(*s.Method))
+	}
+	elements := make(docList, len(s.Elements))
+	for i, element := range s.Elements {
+		elements[i] = p.indexElement(element)
+	}
+	if len(s.Include) > 0 {
+		include = identifierList(s.Include)
+	}
+	var name doc
+	if s.Name != nil {
+		name = qualifiedName(*s.Name)
+	}
+	return clauses(
+		docClause{
+			keyword: createKeyword(word(s.Unique, "UNIQUE"), "INDEX", word(s.Concurrently, "CONCURRENTLY")),
+			body:    words(ifNotExists(s.IfNotExists), name),
+		},
+		docClause{keyword: "ON", body: words(when(s.Only, docKeyword("ONLY")), qualifiedName(s.Table), method, docParens{elements})},
+		clause("INCLUDE", include),
+		clause("WHERE", p.optionalExpression(s.Where)),
+	)
+}
+
+func (p *printer) indexElement(e *IndexElement) doc {
+	var collation, opClass doc
+	expr := p.operand(e.Expression, precedenceCollate)
+	if e.Collation != nil {
+		collation = words(docKeyword("COLLATE"), docIdent(*e.Collation))
+	}
+	if e.OpClass != nil {
+		opClass = qualifiedName(*e.OpClass)
+	}
+	return words(expr, collation, opClass, sortOptions(e.Direction, e.Nulls))
+}
+
+func (p *printer) createView(s *CreateViewStatement) doc {
+	var option doc
+	switch {
+	case s.WithNoData:
+		option = docKeyword("WITH NO DATA")
+	case s.CheckOption != "":
+		option = docKeyword("WITH " + s.CheckOption + " CHECK OPTION")
+	}
+	return clauses(
+		docClause{
+			keyword: createKeyword(word(s.OrReplace, "OR REPLACE"), word(s.Temporary, "TEMPORARY"), word(s.Materialized, "MATERIALIZED"), "VIEW"),
+			body:    words(ifNotExists(s.IfNotExists), qualifiedName(s.Name), identifierList(s.Columns)),
+		},
+		docClause{keyword: "AS", body: p.query(s.Query)},
+		option,
+	)
+}
+
+func (p *printer) refreshMaterializedView(s *RefreshMaterializedViewStatement) doc {
+	return clauses(
+		docClause{keyword: "REFRESH MATERIALIZED VIEW", body: words(when(s.Concurrently, docKeyword("CONCURRENTLY")), qualifiedName(s.Name))},
+		when(s.WithNoData, docKeyword("WITH NO DATA")),
+	)
+}
+
+func (p *printer) createSchema(s *CreateSchemaStatement) doc {
+	var name, authorization doc
+	if s.Name != "" {
+		name = docIdent(s.Name)
+	}
+	if s.Authorization != nil {
+		authorization = words(docKeyword("AUTHORIZATION"), docIdent(*s.Authorization))
+	}
+	return docClause{keyword: "CREATE SCHEMA", body: words(ifNotExists(s.IfNotExists), name, authorization)}
+}
+
+func (p *printer) createSequence(s *CreateSequenceStatement) doc {
+	return clauses(
+		docClause{
+			keyword: createKeyword(word(s.Temporary, "TEMPORARY"), "SEQUENCE"),
+			body:    words(ifNotExists(s.IfNotExists), qualifiedName(s.Name)),
+		},
+		p.sequenceOptions(s.Options),
+	)
+}
+
+func (p *printer) alterSequence(s *AlterSequenceStatement) doc {
+	return clauses(
+		docClause{keyword: "ALTER SEQUENCE", body: words(ifExists(s.IfExists), qualifiedName(s.Name))},
+		p.sequenceOptions(s.Options),
+	)
+}
+
+func (p *printer) drop(s *DropStatement) doc {
+	var on doc
+	if s.On != nil {
+		on = words(docKeyword("ON"), qualifiedName(*s.On))
+	}
+	keyword := "DROP " + s.ObjectKind
+	if s.Concurrently {
+		keyword += " CONCURRENTLY"
+	}
+	names := p.objectNames(s.Names, s.Signatures)
+	if s.Hosts != nil {
+		names = grantees(s.Names, s.Hosts)
+	}
+	return docClause{keyword: keyword, body: words(ifExists(s.IfExists), names, on, dropBehavior(s.Behavior))}
+}
+
+// objectNames converts the names of functions or other objects, each followed
+// by its parameter types if signatures has one for it.
+func (p *printer) objectNames(names []string, signatures [][]*FunctionParameter) doc {
+	list := make(docList, len(names))
+	for i, name := range names {
+		list[i] = qualifiedName(name)
+		if signatures != nil && signatures[i] != nil {
+			list[i] = docConcat{list[i], p.functionParameters(signatures[i])}
+		}
+	}
+	return list
+}
+
+// functionParameters converts a parenthesized, possibly empty parameter list.
+func (p *printer) functionParameters(parameters []*FunctionParameter) doc {
+	list := make(docList, len(parameters))
+	for i, parameter := range parameters {
+		list[i] = p.functionParameter(parameter)
+	}
+	return docParens{list}
+}
+
+func (p *printer) functionParameter(f *FunctionParameter) doc {
+	var mode, defaultValue doc
+	if f.Mode != ParameterModeDefault {
+		mode = docKeyword(f.Mode.String())
+	}
+	if f.Default != nil {
+		defaultValue = words(docKeyword("DEFAULT"), p.expression(f.Default))
+	}
+	return words(mode, optionalIdent(f.Name), p.dataType(f.Type), defaultValue)
+}
+
+func (p *printer) createFunction(s *CreateFunctionStatement) doc {
+	kind := "FUNCTION"
+	if s.Procedure {
+		kind = "PROCEDURE"
+	}
+	var returns, language, body doc
+	switch {
+	case s.ReturnsTable != nil:
+		columns := make(docList, len(s.ReturnsTable))
+		for i, column := range s.ReturnsTable {
+			columns[i] = p.columnDefinition(column)
+		}
+		returns = docClause{keyword: "RETURNS TABLE", body: docParens{columns}}
+	case s.Returns != nil:
+		returns = docClause{keyword: "RETURNS", body: words(when(s.ReturnsSetOf, docKeyword("SETOF")), p.dataType(s.Returns))}
+	}
+	if s.Language != "" {
+		language = docClause{keyword: "LANGUAGE", body: wordOrString(s.Language)}
+	}
+	if s.Body != nil {
+		definition := docText(quoteString(*s.Body))
+		if p.config.Dialect == DialectPostgreSQL && s.LinkSymbol == nil {
+			definition = docText(dollarQuote(*s.Body))
+		}
+		if s.LinkSymbol != nil {
+			body = docClause{keyword: "AS", body: docList{definition, docText(quoteString(*s.LinkSymbol))}}
+		} else {
+			body = docClause{keyword: "AS", body: definition}
+		}
+	}
+	list := clauses(
+		docClause{
+			keyword: createKeyword(word(s.OrReplace, "OR REPLACE"), kind),
+			body:    docConcat{qualifiedName(s.Name), p.functionParameters(s.Parameters)},
+		},
+		returns,
+		language,
+	)
+	if s.Volatility != "" {
+		list = append(list, docKeyword(s.Volatility))
+	}
+	for _, attribute := range s.Attributes {
+		// A SET attribute is kept as written, such as SET search_path = public.
+		if strings.HasPrefix(attribute, "SET ") {
+			list = append(list, docText(attribute))
+		} else {
+			list = append(list, docKeyword(attribute))
+		}
+	}
+	return append(list, clauses(body, clause("RETURN", p.optionalExpression(s.Return)))...)
+}
+
+func (p *printer) createTrigger(s *CreateTriggerStatement) doc {
+	events := make([]doc, len(s.Events))
+	for i, event := range s.Events {
+		if i > 0 {
+			events[i] = words(docKeyword("OR"), p.triggerEvent(event))
+		} else {
+			events[i] = p.triggerEvent(event)
+		}
+	}
+	var condition doc
+	if s.When != nil {
+		condition = docParens{p.expression(s.When)}
+	}
+	var from doc
+	if s.From != "" {
+		from = qualifiedName(s.From)
+	}
+	var oldTable, newTable doc
+	if s.OldTable != "" {
+		oldTable = words(docKeyword("OLD TABLE AS"), docIdent(s.OldTable))
+	}
+	if s.NewTable != "" {
+		newTable = words(docKeyword("NEW TABLE AS"), docIdent(s.NewTable))
+	}
+	var forEachRow doc
+	if s.ForEachRow {
+		forEachRow = docKeyword("FOR EACH ROW")
+	}
+	return clauses(
+		docClause{
+			keyword: createKeyword(word(s.OrReplace, "OR REPLACE"), word(s.Constraint, "CONSTRAINT"), "TRIGGER"),
+			body:    docIdent(s.Name),
+		},
+		docClause{keyword: s.Timing, body: words(events...)},
+		docClause{keyword: "ON", body: qualifiedName(s.Table)},
+		clause("FROM", from),
+		deferrable(s.Deferrable, s.InitiallyDeferred),
+		clause("REFERENCING", words(oldTable, newTable)),
+		forEachRow,
+		clause("WHEN", condition),
+		docClause{keyword: "EXECUTE FUNCTION", body: docConcat{qualifiedName(s.Function), docParens{p.expressions(s.Arguments)}}},
+	)
+}
+
+func (p *printer) triggerEvent(e *TriggerEvent) doc {
+	if len(e.Columns) > 0 {
+		return words(docKeyword(e.Kind), docKeyword("OF"), identifiers(e.Columns))
+	}
+	return docKeyword(e.Kind)
+}
+
+func (p *printer) createType(s *CreateTypeStatement) doc {
+	var definition doc
+	switch s.Kind {
+	case TypeKindEnum:
+		labels := make(docList, len(s.Labels))
+		for i, label := range s.Labels {
+			labels[i] = docText(quoteString(label))
+		}
+		definition = words(docKeyword("AS ENUM"), docParens{labels})
+	case TypeKindComposite:
+		attributes := make(docList, len(s.Attributes))
+		for i, attribute := range s.Attributes {
+			attributes[i] = p.columnDefinition(attribute)
+		}
+		definition = words(docKeyword("AS"), docParens{attributes})
+	}
+	return docClause{keyword: "CREATE TYPE", body: words(qualifiedName(s.Name), definition)}
+}
+
+func (p *printer) alterType(s *AlterTypeStatement) doc {
+	var position doc
+	if s.Before != nil {
+		position = words(docKeyword("BEFORE"), docText(quoteString(*s.Before)))
+	}
+	if s.After != nil {
+		position = words(docKeyword("AFTER"), docText(quoteString(*s.After)))
+	}
+	return docClause{
+		keyword: "ALTER TYPE",
+		body:    words(qualifiedName(s.Name), docKeyword("ADD VALUE"), ifNotExists(s.IfNotExists), docText(quoteString(s.Value)), position),
+	}
+}
+
+func (p *printer) createDomain(s *CreateDomainStatement) doc {
+	var collation doc
+	if s.Collation != nil {
+		collation = words(docKeyword("COLLATE"), docIdent(*s.Collation))
+	}
+	return clauses(
+		docClause{keyword: "CREATE DOMAIN", body: words(qualifiedName(s.Name), docKeyword("AS"), p.dataType(s.Type), collation)},
+		p.columnConstraints(s.Constraints),
+	)
+}
+
+func (p *printer) createExtension(s *CreateExtensionStatement) doc {
+	var schema, version doc
+	if s.Schema != nil {
+		schema = words(docKeyword("SCHEMA"), docIdent(*s.Schema))
+	}
+	if s.Version != nil {
+		version = words(docKeyword("VERSION"), wordOrString(*s.Version))
+	}
+	return docClause{
+		keyword: "CREATE EXTENSION",
+		body:    words(ifNotExists(s.IfNotExists), docIdent(s.Name), schema, version, when(s.Cascade, docKeyword("CASCADE"))),
+	}
+}
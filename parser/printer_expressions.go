@@ -0,0 +1,487 @@
+package parser
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// Printing precedence of the expressions that are not infix operations, all of
+// which bind tighter than any operator.
+const (
+	precedenceUnary   = precedenceCollate + 1 + iota // prefix - and +
+	precedencePostfix                                // ::type casts
+	precedencePrimary                                // literals, columns, calls and parenthesized forms
+)
+
+// expressionPrecedence returns how tightly the printed form of e binds, which
+// decides whether it needs parentheses as the operand of an operator.
+func expressionPrecedence(e Expression) int {
+	switch e := e.(type) {
+	case *BinaryExpression:
+		return binaryPrecedence[e.Operator]
+	case *UnaryExpression:
+		if e.Operator == tokens.TokenNot {
+			return precedenceNot
+		}
+		return precedenceUnary
+	case *IsExpression:
+		return precedenceIs
+	case *InExpression, *BetweenExpression, *LikeExpression:
+		return precedenceLike
+	case *AtTimeZoneExpression:
+		return precedenceAtTimeZone
+	case *CollateExpression:
+		return precedenceCollate
+	case *CastExpression:
+		if e.DoubleColon {
+			return precedencePostfix
+		}
+	case *NumericLiteral:
+		if e.Value < 0 {
+			return precedenceUnary
+		}
+	}
+	return precedencePrimary
+}
+
+// operand returns e as the operand of an operator, parenthesized unless it binds
+// at least as tightly as minPrecedence.
+func (p *printer) operand(e Expression, minPrecedence int) doc {
+	d := p.expression(e)
+	if expressionPrecedence(e) < minPrecedence {
+		return docParens{d}
+	}
+	return d
+}
+
+// operator returns the doc of a binary or unary operator.
+func operator(op tokens.TokenType) doc {
+	switch op {
+	case tokens.TokenAnd, tokens.TokenOr, tokens.TokenNot:
+		return docKeyword(operatorToString(op))
+	}
+	return docText(operatorToString(op))
+}
+
+// chain converts a run of AND or OR operations into a single chain, as the
+// operator is left-associative and a AND b AND c is ((a AND b) AND c).
+func (p *printer) chain(e *BinaryExpression) docChain {
+	precedence := binaryPrecedence[e.Operator]
+	var chain docChain
+	if left, ok := e.Left.(*BinaryExpression); ok && left.Operator == e.Operator {
+		chain = p.chain(left)
+	} else {
+		chain = docChain{operator: operatorToString(e.Operator), operands: []doc{p.operand(e.Left, precedence)}}
+	}
+	chain.operands = append(chain.operands, p.operand(e.Right, precedence+1))
+	return chain
+}
+
+// expressions returns the expressions as a comma-separated list, or nil if there are none.
+func (p *printer) expressions(exprs []Expression) doc {
+	if len(exprs) == 0 {
+		return nil
+	}
+	list := make(docList, len(exprs))
+	for i, e := range exprs {
+		list[i] = p.expression(e)
+	}
+	return list
+}
+
+// optionalExpression returns the expression, or nil if e is nil.
+func (p *printer) optionalExpression(e Expression) doc {
+	if e == nil {
+		return nil
+	}
+	return p.expression(e)
+}
+
+// expression converts an expression into a doc.
+func (p *printer) expression(e Expression) (d doc) {
+	if isNilNode(e) {
+		p.fail("cannot print a missing expression")
+	}
+	defer func() { d = p.commented(d, e) }()
+	switch e := e.(type) {
+	case *AliasedExpression:
+		return words(p.expression(e.Expression), docKeyword("AS"), docIdent(e.Alias))
+	case *ColumnExpression:
+		var name doc = docIdent(e.Name)
+		if e.Name == "*" {
+			name = docText("*")
+		}
+		if e.Table == nil {
+			return name
+		}
+		return docConcat{qualifiedName(*e.Table), docText("."), name}
+	case *BinaryExpression:
+		precedence := binaryPrecedence[e.Operator]
+		if precedence == 0 {
+			p.fail("cannot print binary operator %d", e.Operator)
+		}
+		if e.Operator == tokens.TokenAnd || e.Operator == tokens.TokenOr {
+			return p.chain(e)
+		}
+		if e.Operator == tokens.TokenConcat && p.config.Dialect == DialectMySQL {
+			return p.functionCall(mysqlConcat(e))
+		}
+		left := precedence
+		if precedence == precedenceComparison {
+			// Comparisons do not associate in PostgreSQL, so one compared with another is always parenthesized.
+			left++
+		}
+		return words(p.operand(e.Left, left), operator(e.Operator), p.operand(e.Right, precedence+1))
+	case *UnaryExpression:
+		switch e.Operator {
+		case tokens.TokenNot:
+			return words(docKeyword("NOT"), p.operand(e.Operand, precedenceNot))
+		case tokens.TokenMinus, tokens.TokenPlus:
+			operand := p.operand(e.Operand, precedenceUnary)
+			// A space keeps - -x from being read as the start of a comment.
+			if expressionPrecedence(e.Operand) == precedenceUnary {
+				return words(operator(e.Operator), operand)
+			}
+			return docConcat{operator(e.Operator), operand}
+		}
+		p.fail("cannot print unary operator %d", e.Operator)
+	case *IsExpression:
+		right := p.expression(e.Right)
+		if e.DistinctFrom {
+			right = words(docKeyword("DISTINCT FROM"), p.operand(e.Right, precedenceIs+1))
+		}
+		return words(p.operand(e.Expression, precedenceIs), docKeyword("IS"), when(e.Not, docKeyword("NOT")), right)
+	case *InExpression:
+		list := p.expressions(e.List)
+		if e.Query != nil {
+			list = p.query(e.Query)
+		}
+		return words(p.operand(e.Expression, precedenceLike), when(e.Not, docKeyword("NOT")), docKeyword("IN"), docParens{list})
+	case *BetweenExpression:
+		return words(p.operand(e.Expression, precedenceLike), when(e.Not, docKeyword("NOT")), docKeyword("BETWEEN"),
+			p.operand(e.Low, precedenceLike+1), docKeyword("AND"), p.operand(e.High, precedenceLike+1))
+	case *LikeExpression:
+		keyword := docKeyword("LIKE")
+		if e.CaseInsensitive {
+			keyword = "ILIKE"
+		}
+		var escape doc
+		if e.Escape != nil {
+			escape = words(docKeyword("ESCAPE"), p.operand(e.Escape, precedenceLike+1))
+		}
+		return words(p.operand(e.Expression, precedenceLike), when(e.Not, docKeyword("NOT")), keyword,
+			p.operand(e.Pattern, precedenceLike+1), escape)
+	case *CollateExpression:
+		return words(p.operand(e.Expression, precedenceCollate), docKeyword("COLLATE"), docIdent(e.Collation))
+	case *AtTimeZoneExpression:
+		return words(p.operand(e.Expression, precedenceAtTimeZone), docKeyword("AT TIME ZONE"), p.operand(e.TimeZone, precedenceAtTimeZone+1))
+	case *CastExpression:
+		// MySQL has no :: casts, so they are written with CAST.
+		if e.DoubleColon && p.config.Dialect != DialectMySQL {
+			return docConcat{p.operand(e.Expression, precedencePostfix), docText("::"), p.dataType(e.Type)}
+		}
+		return docConcat{docKeyword("CAST"), docParens{words(p.expression(e.Expression), docKeyword("AS"), p.dataType(e.Type))}}
+	case *FunctionCall:
+		return p.functionCall(e)
+	case *CaseExpression:
+		parts := []doc{docKeyword("CASE"), p.optionalExpression(e.Operand)}
+		for _, branch := range e.Whens {
+			parts = append(parts, p.whenClause(branch))
+		}
+		if e.Else != nil {
+			parts = append(parts, docKeyword("ELSE"), p.expression(e.Else))
+		}
+		return words(append(parts, docKeyword("END"))...)
+	case *ExtractExpression:
+		return docConcat{docKeyword("EXTRACT"), docParens{words(wordOrString(e.Field), docKeyword("FROM"), p.expression(e.Source))}}
+	case *PositionExpression:
+		return docConcat{docKeyword("POSITION"), docParens{words(p.expression(e.Substring), docKeyword("IN"), p.expression(e.Source))}}
+	case *SubstringExpression:
+		var from, length doc
+		if e.From != nil {
+			from = words(docKeyword("FROM"), p.expression(e.From))
+		}
+		if e.For != nil {
+			length = words(docKeyword("FOR"), p.expression(e.For))
+		}
+		return docConcat{docKeyword("SUBSTRING"), docParens{words(p.expression(e.Source), from, length)}}
+	case *TrimExpression:
+		var side doc
+		switch e.Side {
+		case TrimBoth:
+			side = docKeyword("BOTH")
+		case TrimLeading:
+			side = docKeyword("LEADING")
+		case TrimTrailing:
+			side = docKeyword("TRAILING")
+		}
+		return docConcat{docKeyword("TRIM"), docParens{words(side, p.optionalExpression(e.Characters), docKeyword("FROM"), p.expression(e.Source))}}
+	case *TypedLiteral:
+		return words(docIdent(e.Type.Name), docText(quoteString(e.Value)), when(e.Type.IntervalFields != "", docKeyword(e.Type.IntervalFields)))
+	case *SubqueryExpression:
+		return docParens{p.query(e.Query)}
+	case *ExistsExpression:
+		return words(docKeyword("EXISTS"), docParens{p.query(e.Query)})
+	case *RowExpression:
+		return docParens{p.expressions(e.Items)}
+	case *NumericLiteral:
+		// The text as written keeps digits a float64 cannot hold and trailing zeros.
+		if e.Text != "" {
+			return docText(e.Text)
+		}
+		return docText(formatNumber(e.Value))
+	case *StringLiteral:
+		return docText(p.stringLiteral(e))
+	case *BooleanLiteral:
+		if e.Value {
+			return docKeyword("TRUE")
+		}
+		return docKeyword("FALSE")
+	case *NullValue:
+		return docKeyword("NULL")
+	case *DefaultExpression:
+		return docKeyword("DEFAULT")
+	case *PositionalParameter:
+		if e.Question {
+			return docText("?")
+		}
+		return docText("$" + strconv.Itoa(e.Index))
+	case *UserVariable:
+		return docText("@" + e.Name)
+	}
+	p.fail("cannot print expression %T", e)
+	return nil
+}
+
+// whenClause converts a WHEN condition THEN result branch of a CASE expression.
+func (p *printer) whenClause(w *WhenClause) doc {
+	return words(docKeyword("WHEN"), p.expression(w.Condition), docKeyword("THEN"), p.expression(w.Result))
+}
+
+// wordOrString returns value bare if it reads back as a single identifier, and as a string literal otherwise.
+func wordOrString(value string) doc {
+	if isBareIdentifier(value) {
+		return docText(value)
+	}
+	return docText(quoteString(value))
+}
+
+// functionCall converts a function call with its FILTER and OVER clauses.
+func (p *printer) functionCall(f *FunctionCall) doc {
+	// MySQL's VALUES(column) is the only call whose name is a keyword.
+	name := qualifiedName(f.Name)
+	if strings.EqualFold(f.Name, "VALUES") {
+		name = docText(f.Name)
+	}
+	var orderBy doc
+	if len(f.OrderBy) > 0 {
+		orderBy = words(docKeyword("ORDER BY"), p.orderBy(f.OrderBy))
+	}
+	call := docConcat{name, docParens{words(when(f.Distinct, docKeyword("DISTINCT")), p.expressions(f.Arguments), orderBy)}}
+	var filter, over doc
+	if f.Filter != nil {
+		filter = words(docKeyword("FILTER"), docParens{words(docKeyword("WHERE"), p.expression(f.Filter))})
+	}
+	if f.Over != nil {
+		over = words(docKeyword("OVER"), p.over(f.Over))
+	}
+	return words(call, filter, over)
+}
+
+// mysqlConcat returns the CONCAT() call that takes the place of a chain of ||
+// operators in MySQL, where || is a logical OR.
+func mysqlConcat(e *BinaryExpression) *FunctionCall {
+	arguments := []Expression{e.Right}
+	for {
+		left, ok := e.Left.(*BinaryExpression)
+		if !ok || left.Operator != tokens.TokenConcat {
+			break
+		}
+		e = left
+		arguments = append(arguments, e.Right)
+	}
+	arguments = append(arguments, e.Left)
+	slices.Reverse(arguments)
+	return &FunctionCall{Name: "concat", Arguments: arguments}
+}
+
+// over returns the window of an OVER clause, which is a bare name when it only refers to a named window.
+func (p *printer) over(spec *WindowSpec) doc {
+	if spec.Name != nil && len(spec.PartitionBy) == 0 && len(spec.OrderBy) == 0 && spec.Frame == nil {
+		return docIdent(*spec.Name)
+	}
+	return p.windowSpec(spec)
+}
+
+// windowSpec converts a parenthesized window specification.
+func (p *printer) windowSpec(spec *WindowSpec) doc {
+	return docParens{clauses(
+		optionalIdent(spec.Name),
+		clause("PARTITION BY", p.expressions(spec.PartitionBy)),
+		clause("ORDER BY", p.orderBy(spec.OrderBy)),
+		p.windowFrame(spec.Frame),
+	)}
+}
+
+// windowFrame converts the frame clause of a window, or returns nil if frame is nil.
+func (p *printer) windowFrame(frame *WindowFrame) doc {
+	if frame == nil {
+		return nil
+	}
+	extent := p.frameBound(frame.StartBound)
+	if frame.EndBound != nil {
+		extent = words(docKeyword("BETWEEN"), extent, docKeyword("AND"), p.frameBound(frame.EndBound))
+	}
+	var exclude doc
+	switch frame.Exclude {
+	case ExcludeCurrentRow:
+		exclude = docKeyword("EXCLUDE CURRENT ROW")
+	case ExcludeGroup:
+		exclude = docKeyword("EXCLUDE GROUP")
+	case ExcludeTies:
+		exclude = docKeyword("EXCLUDE TIES")
+	case ExcludeNoOthers:
+		exclude = docKeyword("EXCLUDE NO OTHERS")
+	}
+	return words(docKeyword(frame.Units.String()), extent, exclude)
+}
+
+// frameBound converts the start or end of a window frame.
+func (p *printer) frameBound(bound *FrameBound) doc {
+	switch bound.Type {
+	case UnboundedPreceding:
+		return docKeyword("UNBOUNDED PRECEDING")
+	case Preceding:
+		return words(p.expression(bound.Offset), docKeyword("PRECEDING"))
+	case CurrentRow:
+		return docKeyword("CURRENT ROW")
+	case Following:
+		return words(p.expression(bound.Offset), docKeyword("FOLLOWING"))
+	case UnboundedFollowing:
+		return docKeyword("UNBOUNDED FOLLOWING")
+	}
+	p.fail("cannot print frame bound %d", bound.Type)
+	return nil
+}
+
+// namedWindows converts the definitions of a WINDOW clause, or returns nil if there are none.
+func (p *printer) namedWindows(windows []*NamedWindow) doc {
+	if len(windows) == 0 {
+		return nil
+	}
+	list := make(docList, len(windows))
+	for i, window := range windows {
+		list[i] = words(docIdent(window.Name), docKeyword("AS"), p.windowSpec(window.Spec))
+	}
+	return list
+}
+
+// orderBy converts the sort keys of an ORDER BY clause, or returns nil if there are none.
+func (p *printer) orderBy(items []*OrderByItem) doc {
+	if len(items) == 0 {
+		return nil
+	}
+	list := make(docList, len(items))
+	for i, item := range items {
+		list[i] = p.commented(p.orderByItem(item), item)
+	}
+	return list
+}
+
+// orderByItem converts a sort key with its collation and sort options.
+func (p *printer) orderByItem(item *OrderByItem) doc {
+	var collation doc
+	if item.Collation != nil {
+		collation = words(docKeyword("COLLATE"), docIdent(*item.Collation))
+	}
+	return words(p.expression(item.Expression), collation, sortOptions(item.Direction, item.Nulls))
+}
+
+// sortOptions converts the ASC or DESC and NULLS FIRST or NULLS LAST of a sort key.
+func sortOptions(direction SortDirection, nulls NullsOrder) doc {
+	var dir, order doc
+	switch direction {
+	case SortAsc:
+		dir = docKeyword("ASC")
+	case SortDesc:
+		dir = docKeyword("DESC")
+	}
+	switch nulls {
+	case NullsFirst:
+		order = docKeyword("NULLS FIRST")
+	case NullsLast:
+		order = docKeyword("NULLS LAST")
+	}
+	return words(dir, order)
+}
+
+// dataType converts a data type with its arguments, modifiers and array bounds.
+func (p *printer) dataType(t *DataType) doc {
+	if t == nil {
+		p.fail("cannot print a missing data type")
+	}
+	// Multi-word standard names such as DOUBLE PRECISION are kept as written.
+	name := qualifiedName(t.Name)
+	if strings.Contains(t.Name, " ") {
+		name = docText(t.Name)
+	}
+	d := docConcat{name}
+	if len(t.TypeArguments) > 0 {
+		arguments := make(docList, len(t.TypeArguments))
+		for i, argument := range t.TypeArguments {
+			arguments[i] = p.dataType(argument)
+		}
+		d = append(d, docText("<"), arguments, docText(">"))
+	}
+	if len(t.Fields) > 0 {
+		fields := make(docList, len(t.Fields))
+		for i, field := range t.Fields {
+			fields[i] = words(when(field.Name != "", docIdent(field.Name)), p.dataType(field.Type))
+		}
+		d = append(d, docText("<"), fields, docText(">"))
+	}
+	if t.IntervalFields != "" {
+		d = append(d, docText(" "), docKeyword(t.IntervalFields))
+	}
+	if len(t.Modifiers) > 0 {
+		d = append(d, docParens{p.expressions(t.Modifiers)})
+	}
+	switch t.TimeZone {
+	case WithTimeZone:
+		d = append(d, docText(" "), docKeyword("WITH TIME ZONE"))
+	case WithoutTimeZone:
+		d = append(d, docText(" "), docKeyword("WITHOUT TIME ZONE"))
+	}
+	for _, bound := range t.ArrayBounds {
+		d = append(d, docText("["), p.optionalExpression(bound), docText("]"))
+	}
+	return d
+}
+
+// dataTypes converts a list of data types.
+func (p *printer) dataTypes(types []*DataType) doc {
+	list := make(docList, len(types))
+	for i, t := range types {
+		list[i] = p.dataType(t)
+	}
+	return list
+}
+
+// stringLiteral writes a string literal with its prefix. Escape strings
+// escape their backslashes, and MySQL, where every string is one, drops the E.
+func (p *printer) stringLiteral(s *StringLiteral) string {
+	switch s.Prefix {
+	case "X", "B":
+		return s.Prefix + "'" + s.Value + "'"
+	case "E":
+		value := quoteString(strings.ReplaceAll(s.Value, `\`, `\\`))
+		if p.config.Dialect == DialectMySQL {
+			return value
+		}
+		return "E" + value
+	}
+	return s.Prefix + quoteString(s.Value)
+}
@@ -0,0 +1,657 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+)
+
+// statement converts a statement into a doc.
+func (p *printer) statement(stmt Statement) doc {
+	switch s := stmt.(type) {
+	case *SelectStatement, *SetOperation:
+		return p.query(s.(QueryExpression))
+	case *InsertStatement:
+		return p.insert(s)
+	case *UpdateStatement:
+		return p.update(s)
+	case *DeleteStatement:
+		return p.delete(s)
+	case *MergeStatement:
+		return p.merge(s)
+	case *TruncateStatement:
+		return p.truncate(s)
+	case *CreateTableStatement:
+		return p.createTable(s)
+	case *AlterTableStatement:
+		return p.alterTable(s)
+	case *CreateIndexStatement:
+		return p.createIndex(s)
+	case *CreateViewStatement:
+		return p.createView(s)
+	case *RefreshMaterializedViewStatement:
+		return p.refreshMaterializedView(s)
+	case *CreateSchemaStatement:
+		return p.createSchema(s)
+	case *CreateSequenceStatement:
+		return p.createSequence(s)
+	case *AlterSequenceStatement:
+		return p.alterSequence(s)
+	case *DropStatement:
+		return p.drop(s)
+	case *CreateFunctionStatement:
+		return p.createFunction(s)
+	case *CreateTriggerStatement:
+		return p.createTrigger(s)
+	case *CreateTypeStatement:
+		return p.createType(s)
+	case *AlterTypeStatement:
+		return p.alterType(s)
+	case *CreateDomainStatement:
+		return p.createDomain(s)
+	case *CreateExtensionStatement:
+		return p.createExtension(s)
+	case *BeginStatement, *CommitStatement, *RollbackStatement, *SavepointStatement, *ReleaseSavepointStatement:
+		return p.transaction(s)
+	case *SetStatement, *SetTransactionStatement, *ResetStatement, *ShowStatement, *UseStatement:
+		return p.session(s)
+	case *GrantStatement:
+		return p.grant(s)
+	case *RevokeStatement:
+		return p.revoke(s)
+	case *AlterDefaultPrivilegesStatement:
+		return p.alterDefaultPrivileges(s)
+	case *CreateRoleStatement:
+		return p.createRole(s)
+	case *AlterRoleStatement:
+		return p.alterRole(s)
+	case *ExplainStatement:
+		return p.explain(s)
+	case *PrepareStatement:
+		return p.prepare(s)
+	case *ExecuteStatement:
+		return p.execute(s)
+	case *DeallocateStatement:
+		return p.deallocate(s)
+	case *AnalyzeStatement:
+		return p.analyze(s)
+	case *VacuumStatement:
+		return p.vacuum(s)
+	case *CopyStatement:
+		return p.copy(s)
+	case *LoadDataStatement:
+		return p.loadData(s)
+	}
+	p.fail("cannot print statement %T", stmt)
+	return nil
+}
+
+// clauseNode converts the nodes that only occur as part of a statement, such as
+// sort keys and column definitions, as they are written inside it.
+func (p *printer) clauseNode(node Node) doc {
+	switch n := node.(type) {
+	case *WithClause:
+		return p.with(n)
+	case *CommonTableExpression:
+		return p.commonTableExpression(n)
+	case *OrderByItem:
+		return p.orderByItem(n)
+	case *Limit:
+		if n.Style == LimitStyleTop && p.config.Dialect != DialectMySQL {
+			return p.top(n)
+		}
+		return clauses(p.limit(n)...)
+	case *Assignment:
+		return p.assignment(n)
+	case *OnConflict:
+		return clauses(p.onConflict(n)...)
+	case *MergeWhenClause:
+		return p.mergeWhenClause(n)
+	case *WhenClause:
+		return p.whenClause(n)
+	case *WindowSpec:
+		return p.windowSpec(n)
+	case *NamedWindow:
+		return p.namedWindows([]*NamedWindow{n})
+	case *WindowFrame:
+		return p.windowFrame(n)
+	case *DataType:
+		return p.dataType(n)
+	case *ColumnDefinition:
+		return p.columnDefinition(n)
+	case *ColumnConstraint:
+		return p.columnConstraint(n)
+	case *TableConstraint:
+		return p.tableConstraint(n)
+	case *SequenceOption:
+		return p.sequenceOption(n)
+	case *IndexElement:
+		return p.indexElement(n)
+	case *FunctionParameter:
+		return p.functionParameter(n)
+	case *AlterTableAction:
+		return p.alterTableAction(n)
+	case *RoleOption:
+		return p.roleOption(n)
+	}
+	p.fail("cannot print %T on its own", node)
+	return nil
+}
+
+// query converts a SELECT or a set operation.
+func (p *printer) query(q QueryExpression) doc {
+	switch q := q.(type) {
+	case *SelectStatement:
+		return p.selectStatement(q)
+	case *SetOperation:
+		return p.setOperation(q)
+	}
+	p.fail("cannot print query %T", q)
+	return nil
+}
+
+func (p *printer) selectStatement(s *SelectStatement) doc {
+	keyword := "SELECT"
+	if s.Distinct {
+		keyword = "SELECT DISTINCT"
+	}
+	var top doc
+	if s.Limit != nil && s.Limit.Style == LimitStyleTop && p.config.Dialect != DialectMySQL {
+		top = p.top(s.Limit)
+	}
+	list := clauses(
+		p.with(s.With),
+		docClause{keyword: keyword, body: words(top, p.expressions(s.Expressions))},
+		clause("FROM", p.tableExpressions(s.From)),
+		clause("WHERE", p.optionalExpression(s.Where)),
+		clause("GROUP BY", p.expressions(s.GroupBy)),
+		clause("HAVING", p.optionalExpression(s.Having)),
+		clause("WINDOW", p.namedWindows(s.Windows)),
+		clause("ORDER BY", p.orderBy(s.OrderBy)),
+	)
+	return append(list, p.limit(s.Limit)...)
+}
+
+func (p *printer) setOperation(s *SetOperation) doc {
+	precedence := setOperationPrecedence[s.Operator]
+	if precedence == 0 {
+		p.fail("cannot print set operator %d", s.Operator)
+	}
+	keyword := operatorToString(s.Operator)
+	switch {
+	case s.All:
+		keyword += " ALL"
+	case s.Distinct:
+		keyword += " DISTINCT"
+	}
+	list := clauses(
+		p.with(s.With),
+		p.setOperand(s.Left, precedence),
+		docKeyword(keyword),
+		p.setOperand(s.Right, precedence+1),
+		clause("ORDER BY", p.orderBy(s.OrderBy)),
+	)
+	return append(list, p.limit(s.Limit)...)
+}
+
+// setOperand returns an operand of a set operation, parenthesized when it has
+// clauses of its own that would otherwise apply to the whole operation, or
+// when it is a set operation binding more loosely than minPrecedence.
+func (p *printer) setOperand(q QueryExpression, minPrecedence int) doc {
+	d := p.query(q)
+	switch q := q.(type) {
+	case *SelectStatement:
+		if q.With != nil || len(q.OrderBy) > 0 || q.Limit != nil && q.Limit.Style != LimitStyleTop {
+			return docParens{d}
+		}
+	case *SetOperation:
+		if q.With != nil || len(q.OrderBy) > 0 || q.Limit != nil || setOperationPrecedence[q.Operator] < minPrecedence {
+			return docParens{d}
+		}
+	}
+	return d
+}
+
+// with converts a WITH clause, or returns nil if with is nil.
+func (p *printer) with(with *WithClause) doc {
+	if with == nil {
+		return nil
+	}
+	keyword := "WITH"
+	if with.Recursive {
+		keyword = "WITH RECURSIVE"
+	}
+	list := make(docList, len(with.CTEs))
+	for i, cte := range with.CTEs {
+		list[i] = p.commented(p.commonTableExpression(cte), cte)
+	}
+	return docClause{keyword: keyword, body: list}
+}
+
+func (p *printer) commonTableExpression(cte *CommonTableExpression) doc {
+	var materialized, search, cycle doc
+	switch cte.Materialized {
+	case Materialized:
+		materialized = docKeyword("MATERIALIZED")
+	case NotMaterialized:
+		materialized = docKeyword("NOT MATERIALIZED")
+	}
+	if s := cte.Search; s != nil {
+		order := "SEARCH BREADTH FIRST BY"
+		if s.DepthFirst {
+			order = "SEARCH DEPTH FIRST BY"
+		}
+		search = words(docKeyword(order), identifiers(s.Columns), docKeyword("SET"), docIdent(s.SetColumn))
+	}
+	if c := cte.Cycle; c != nil {
+		var values doc
+		if c.MarkValue != nil {
+			values = words(docKeyword("TO"), p.expression(c.MarkValue), docKeyword("DEFAULT"), p.expression(c.DefaultValue))
+		}
+		cycle = words(docKeyword("CYCLE"), identifiers(c.Columns), docKeyword("SET"), docIdent(c.SetColumn),
+			values, docKeyword("USING"), docIdent(c.PathColumn))
+	}
+	name := docConcat{docIdent(cte.Name)}
+	if columns := identifierList(cte.Columns); columns != nil {
+		name = append(name, docText(" "), columns)
+	}
+	return words(name, docKeyword("AS"), materialized, docParens{p.query(cte.Query)}, search, cycle)
+}
+
+// top converts the TOP n [PERCENT] [WITH TIES] limit of a T-SQL SELECT.
+func (p *printer) top(l *Limit) doc {
+	count := p.expression(l.Count)
+	if number, ok := l.Count.(*NumericLiteral); !ok || number.Value < 0 {
+		count = docParens{count}
+	}
+	return words(docKeyword("TOP"), count, when(l.Percent, docKeyword("PERCENT")), when(l.WithTies, docKeyword("WITH TIES")))
+}
+
+// limit converts the row-limiting clauses that follow ORDER BY, returning nil
+// if there are none or the limit is a TOP printed with the select list.
+func (p *printer) limit(l *Limit) []doc {
+	if l == nil {
+		return nil
+	}
+	if p.config.Dialect == DialectMySQL && l.Style != LimitStyleComma {
+		return p.mysqlLimit(l)
+	}
+	switch l.Style {
+	case LimitStyleLimit:
+		if l.Count == nil && l.Offset == nil {
+			return []doc{docKeyword("LIMIT ALL")}
+		}
+		return clauses(clause("LIMIT", p.optionalExpression(l.Count)), clause("OFFSET", p.optionalExpression(l.Offset)))
+	case LimitStyleComma:
+		if p.config.Dialect != DialectMySQL {
+			// LIMIT m, n is MySQL's spelling of LIMIT n OFFSET m.
+			return clauses(clause("LIMIT", p.expression(l.Count)), clause("OFFSET", p.expression(l.Offset)))
+		}
+		return []doc{docClause{keyword: "LIMIT", body: docList{p.expression(l.Offset), p.expression(l.Count)}}}
+	case LimitStyleFetch:
+		var list []doc
+		if l.Offset != nil {
+			list = append(list, docClause{keyword: "OFFSET", body: words(p.expression(l.Offset), docKeyword("ROWS"))})
+		}
+		// OFFSET m ROWS alone means the same as fetching all the remaining rows.
+		if l.Count != nil || l.WithTies || l.Offset == nil {
+			ending := docKeyword("ONLY")
+			if l.WithTies {
+				ending = "WITH TIES"
+			}
+			list = append(list, docClause{keyword: "FETCH FIRST", body: words(
+				p.optionalExpression(l.Count), when(l.Percent, docKeyword("PERCENT")), docKeyword("ROWS"), ending)})
+		}
+		return list
+	case LimitStyleTop:
+		return nil
+	}
+	p.fail("cannot print limit style %s", l.Style)
+	return nil
+}
+
+// mysqlLimit converts a LIMIT, FETCH or TOP limit to the LIMIT clause MySQL
+// has in their place, which always has a row count.
+func (p *printer) mysqlLimit(l *Limit) []doc {
+	switch {
+	case l.Style == LimitStyleLimit && l.Count == nil && l.Offset == nil:
+		// LIMIT ALL is the same as no limit at all.
+		return nil
+	case l.Percent:
+		p.unsupported("a limit in percent")
+	case l.WithTies:
+		p.unsupported("a limit WITH TIES")
+	case l.Count == nil:
+		p.unsupported("OFFSET without a row count")
+	}
+	return clauses(clause("LIMIT", p.expression(l.Count)), clause("OFFSET", p.optionalExpression(l.Offset)))
+}
+
+// tableExpressions converts a comma-separated list of table expressions, or returns nil if there are none.
+func (p *printer) tableExpressions(tables []TableExpression) doc {
+	if len(tables) == 0 {
+		return nil
+	}
+	list := make(docList, len(tables))
+	for i, table := range tables {
+		list[i] = p.tableExpression(table)
+	}
+	return list
+}
+
+// tableExpression converts a table, derived table or join.
+func (p *printer) tableExpression(t TableExpression) (d doc) {
+	defer func() { d = p.commented(d, t) }()
+	switch t := t.(type) {
+	case *TableName:
+		return words(when(t.Only, docKeyword("ONLY")), qualifiedName(t.Name), when(t.Descendants, docText("*")), alias(t.Alias))
+	case *SubqueryTable:
+		return words(docParens{p.query(t.Query)}, alias(t.Alias))
+	case *Join:
+		return p.joins(t)
+	}
+	p.fail("cannot print table expression %T", t)
+	return nil
+}
+
+// joins converts j and the joins nested on its left into the table they start
+// from followed by one doc per join, so a formatter can start each join on a line of its own.
+func (p *printer) joins(j *Join) docJoins {
+	var d docJoins
+	if left, ok := j.Left.(*Join); ok {
+		d = p.joins(left)
+	} else {
+		d.table = p.tableExpression(j.Left)
+	}
+	keyword := j.Type.String()
+	if j.Type == JoinInner {
+		keyword = "JOIN"
+	}
+	var condition doc
+	switch {
+	case j.On != nil:
+		condition = words(docKeyword("ON"), p.expression(j.On))
+	case j.Using != nil:
+		condition = words(docKeyword("USING"), identifierList(j.Using))
+	}
+	d.joins = append(d.joins, words(when(j.Natural, docKeyword("NATURAL")), docKeyword(keyword), p.tablePrimary(j.Right), condition))
+	return d
+}
+
+// tablePrimary converts a table expression where only a table or derived
+// table may appear, parenthesizing joins.
+func (p *printer) tablePrimary(t TableExpression) doc {
+	d := p.tableExpression(t)
+	if _, ok := t.(*Join); ok {
+		return docParens{d}
+	}
+	return d
+}
+
+// alias returns the AS alias of a table, or nil if it has none.
+func alias(name *string) doc {
+	if name == nil {
+		return nil
+	}
+	return words(docKeyword("AS"), docIdent(*name))
+}
+
+// assignments converts the assignments of a SET clause, or returns nil if there are none.
+func (p *printer) assignments(assignments []*Assignment) doc {
+	if len(assignments) == 0 {
+		return nil
+	}
+	list := make(docList, len(assignments))
+	for i, assignment := range assignments {
+		list[i] = p.commented(p.assignment(assignment), assignment)
+	}
+	return list
+}
+
+func (p *printer) assignment(a *Assignment) doc {
+	target := qualifiedName(a.Column)
+	if a.Columns != nil {
+		target = identifierList(a.Columns)
+	}
+	return words(target, docText("="), p.expression(a.Value))
+}
+
+// valuesRows converts the parenthesized rows of a VALUES list.
+func (p *printer) valuesRows(rows [][]Expression) doc {
+	list := make(docList, len(rows))
+	for i, row := range rows {
+		list[i] = docParens{p.expressions(row)}
+	}
+	return list
+}
+
+func (p *printer) insert(s *InsertStatement) doc {
+	var overriding, source doc
+	switch s.Overriding {
+	case OverridingSystemValue:
+		overriding = docKeyword("OVERRIDING SYSTEM VALUE")
+	case OverridingUserValue:
+		overriding = docKeyword("OVERRIDING USER VALUE")
+	}
+	switch {
+	case s.DefaultValues:
+		source = docKeyword("DEFAULT VALUES")
+	case s.Query != nil:
+		source = p.query(s.Query)
+	default:
+		source = docClause{keyword: "VALUES", body: p.valuesRows(s.Values)}
+	}
+	list := clauses(
+		p.with(s.With),
+		docClause{keyword: "INSERT INTO", body: words(p.tableExpression(s.Table), identifierList(s.Columns), overriding)},
+		source,
+	)
+	if s.OnConflict != nil {
+		list = append(list, p.onConflict(s.OnConflict)...)
+	}
+	if len(s.OnDuplicateKeyUpdate) > 0 && p.config.Dialect != DialectMySQL {
+		p.unsupported("ON DUPLICATE KEY UPDATE")
+	}
+	return append(list, clauses(
+		clause("ON DUPLICATE KEY UPDATE", p.assignments(s.OnDuplicateKeyUpdate)),
+		p.returning(s.Returning),
+	)...)
+}
+
+// returning converts the RETURNING clause of a data-modifying statement, which MySQL lacks.
+func (p *printer) returning(list []Expression) doc {
+	if len(list) > 0 && p.config.Dialect == DialectMySQL {
+		p.unsupported("RETURNING")
+	}
+	return clause("RETURNING", p.expressions(list))
+}
+
+// onConflict converts the ON CONFLICT clause of an INSERT and its action.
+func (p *printer) onConflict(c *OnConflict) []doc {
+	if p.config.Dialect == DialectMySQL {
+		p.unsupported("ON CONFLICT")
+	}
+	var target doc
+	switch {
+	case c.Constraint != nil:
+		target = words(docKeyword("ON CONSTRAINT"), docIdent(*c.Constraint))
+	case c.Target != nil:
+		var where doc
+		if c.TargetWhere != nil {
+			where = words(docKeyword("WHERE"), p.expression(c.TargetWhere))
+		}
+		target = words(docParens{p.expressions(c.Target)}, where)
+	}
+	var conflict doc = docKeyword("ON CONFLICT")
+	if target != nil {
+		conflict = docClause{keyword: "ON CONFLICT", body: target}
+	}
+	if c.Action == ConflictDoNothing {
+		return []doc{conflict, docKeyword("DO NOTHING")}
+	}
+	return clauses(conflict, clause("DO UPDATE SET", p.assignments(c.Assignments)), clause("WHERE", p.optionalExpression(c.Where)))
+}
+
+func (p *printer) update(s *UpdateStatement) doc {
+	tables, from := s.Tables, s.From
+	if p.config.Dialect == DialectMySQL {
+		// MySQL joins the tables of a FROM list by naming them after the one updated.
+		tables, from = append(slices.Clip(tables), from...), nil
+	}
+	p.checkMySQLForms("UPDATE", multipleTables(tables), s.OrderBy, s.Limit)
+	list := clauses(
+		p.with(s.With),
+		clause("UPDATE", p.tableExpressions(tables)),
+		clause("SET", p.assignments(s.Assignments)),
+		clause("FROM", p.tableExpressions(from)),
+		clause("WHERE", p.optionalExpression(s.Where)),
+		clause("ORDER BY", p.orderBy(s.OrderBy)),
+	)
+	list = append(list, p.limit(s.Limit)...)
+	return append(list, clauses(p.returning(s.Returning))...)
+}
+
+func (p *printer) delete(s *DeleteStatement) doc {
+	tables, using := s.Tables, s.Using
+	// PostgreSQL's USING list holds only the tables joined to the one deleted
+	// from, and MySQL's names that one as well, so they can be told apart.
+	mysqlUsing := len(using) > 0 && namesAll(tableNames(using), tableNames(tables))
+	if p.config.Dialect == DialectMySQL && len(using) > 0 && !mysqlUsing {
+		tables = make([]TableExpression, len(s.Tables))
+		for i, name := range tableNames(s.Tables) {
+			tables[i] = &TableName{Name: name}
+		}
+		using = append(slices.Clip(s.Tables), using...)
+	}
+	p.checkMySQLForms("DELETE", len(s.From) > 0 || mysqlUsing || multipleTables(tables), s.OrderBy, s.Limit)
+	target := clause("DELETE FROM", p.tableExpressions(tables))
+	if len(s.From) > 0 {
+		target = docClauses{clause("DELETE", p.tableExpressions(tables)), clause("FROM", p.tableExpressions(s.From))}
+	}
+	where := clause("WHERE", p.optionalExpression(s.Where))
+	if s.CurrentOf != nil {
+		where = docClause{keyword: "WHERE CURRENT OF", body: docIdent(*s.CurrentOf)}
+	}
+	list := clauses(
+		p.with(s.With),
+		target,
+		clause("USING", p.tableExpressions(using)),
+		where,
+		clause("ORDER BY", p.orderBy(s.OrderBy)),
+	)
+	list = append(list, p.limit(s.Limit)...)
+	return append(list, clauses(p.returning(s.Returning))...)
+}
+
+// checkMySQLForms fails for the MySQL forms of an UPDATE or DELETE, which
+// PostgreSQL lacks: several tables to change, and ORDER BY and LIMIT.
+func (p *printer) checkMySQLForms(statement string, multiple bool, orderBy []*OrderByItem, limit *Limit) {
+	switch {
+	case p.config.Dialect == DialectMySQL:
+	case multiple:
+		p.unsupported("a multiple-table " + statement)
+	case len(orderBy) > 0 || limit != nil:
+		p.unsupported("ORDER BY or LIMIT in " + statement)
+	}
+}
+
+// multipleTables reports whether tables is MySQL's list of several tables or joins to update or delete from.
+func multipleTables(tables []TableExpression) bool {
+	if len(tables) > 1 {
+		return true
+	}
+	for _, t := range tables {
+		if _, ok := t.(*Join); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// tableNames returns the names the tables and joins of list are referred to
+// by: the alias of each table, or else the table name itself.
+func tableNames(list []TableExpression) []string {
+	var names []string
+	for _, t := range list {
+		switch t := t.(type) {
+		case *TableName:
+			if t.Alias != nil {
+				names = append(names, *t.Alias)
+			} else {
+				names = append(names, t.Name)
+			}
+		case *SubqueryTable:
+			if t.Alias != nil {
+				names = append(names, *t.Alias)
+			}
+		case *Join:
+			names = append(names, tableNames([]TableExpression{t.Left, t.Right})...)
+		}
+	}
+	return names
+}
+
+// namesAll reports whether names holds every one of want, ignoring case.
+func namesAll(names, want []string) bool {
+	for _, w := range want {
+		if !slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(name, w) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *printer) merge(s *MergeStatement) doc {
+	list := clauses(
+		p.with(s.With),
+		clause("MERGE INTO", p.tableExpression(s.Target)),
+		clause("USING", p.tablePrimary(s.Source)),
+		clause("ON", p.expression(s.On)),
+	)
+	for _, when := range s.Clauses {
+		list = append(list, p.mergeWhenClause(when))
+	}
+	return append(list, clauses(p.returning(s.Returning))...)
+}
+
+func (p *printer) mergeWhenClause(c *MergeWhenClause) doc {
+	var condition, action doc
+	if c.Condition != nil {
+		condition = words(docKeyword("AND"), p.expression(c.Condition))
+	}
+	switch c.Action {
+	case MergeDoNothing:
+		action = docKeyword("DO NOTHING")
+	case MergeUpdate:
+		action = words(docKeyword("UPDATE SET"), p.assignments(c.Assignments))
+	case MergeDelete:
+		action = docKeyword("DELETE")
+	case MergeInsert:
+		values := words(docKeyword("VALUES"), docParens{p.expressions(c.Values)})
+		if c.DefaultValues {
+			values = docKeyword("DEFAULT VALUES")
+		}
+		action = words(docKeyword("INSERT"), identifierList(c.Columns), values)
+	default:
+		p.fail("cannot print MERGE action %s", c.Action)
+	}
+	return docClause{keyword: "WHEN " + c.Match.String(), body: words(condition, docKeyword("THEN"), action)}
+}
+
+func (p *printer) truncate(s *TruncateStatement) doc {
+	var identity doc
+	switch s.Identity {
+	case IdentityRestart:
+		identity = docKeyword("RESTART IDENTITY")
+	case IdentityContinue:
+		identity = docKeyword("CONTINUE IDENTITY")
+	}
+	return docClause{keyword: "TRUNCATE TABLE", body: words(p.tableExpressions(s.Tables), identity, dropBehavior(s.Behavior))}
+}
+
+// dropBehavior returns CASCADE or RESTRICT, or nil if neither was written.
+func dropBehavior(behavior DropBehavior) doc {
+	if behavior == DropBehaviorDefault {
+		return nil
+	}
+	return docKeyword(behavior.String())
+}
@@ -0,0 +1,443 @@
+package parser
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sanemat/go-sql-parser/lexer"
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// checkRoundTrip parses input and prints the statements as a script in each dialect
+// and layout, checking that the output parses to the same statements with the same
+// names quoted.
+func checkRoundTrip(t *testing.T, input string) {
+	t.Helper()
+	stmts, err := NewParser(lexer.NewLexer(input).Lex()).Parse()
+	if err != nil {
+		t.Fatalf("Parser.Parse() error = %v", err)
+	}
+	wantQuoted := quotedNamesOf(stmts)
+	printed := false
+	for _, dialect := range []Dialect{DialectPostgreSQL, DialectMySQL} {
+		configs := []PrintConfig{
+			{Dialect: dialect},
+			{Dialect: dialect, Indent: 4, LineWidth: 10},
+			{Dialect: dialect, Indent: 2, LineWidth: 10, CommaFirst: true, River: true, KeywordCase: CaseLower},
+		}
+		for _, config := range configs {
+			var b strings.Builder
+			if err := config.FprintScript(&b, stmts); errors.Is(err, ErrUnsupported) {
+				continue
+			} else if err != nil {
+				t.Errorf("FprintScript(%+v) error = %v", config, err)
+				continue
+			}
+			printed = true
+			reparsed, err := NewParser(lexer.NewLexer(b.String()).Lex()).Parse()
+			if err != nil {
+				t.Errorf("FprintScript(%+v) = %q, which does not parse: %v", config, b.String(), err)
+				continue
+			}
+			if quoted := quotedNamesOf(reparsed); !reflect.DeepEqual(quoted, wantQuoted) {
+				t.Errorf("FprintScript(%+v) = %q, which quotes %q, want %q", config, b.String(), quoted, wantQuoted)
+			}
+			want, _ := NewParser(lexer.NewLexer(input).Lex()).Parse()
+			clearSpans(want)
+			clearSpans(reparsed)
+			if dialect == DialectMySQL {
+				asMySQL(want)
+			} else {
+				asPostgreSQL(want)
+			}
+			if !reflect.DeepEqual(reparsed, want) {
+				t.Errorf("FprintScript(%+v) = %q, which parses to %v, want %v", config, b.String(), reparsed, want)
+			}
+		}
+	}
+	if !printed {
+		t.Errorf("FprintScript() error = %v in every dialect", ErrUnsupported)
+	}
+}
+
+// asMySQL rewrites stmts to the forms the MySQL dialect prints in place of the ones it lacks.
+func asMySQL(stmts []Statement) {
+	for _, stmt := range stmts {
+		Apply(stmt, func(c *Cursor) bool {
+			switch node := c.Node().(type) {
+			case *CastExpression:
+				node.DoubleColon = false
+			case *StringLiteral:
+				if node.Prefix == "E" {
+					node.Prefix = ""
+				}
+			case *BinaryExpression:
+				if node.Operator == tokens.TokenConcat {
+					c.Replace(mysqlConcat(node))
+				}
+			case *Limit:
+				if node.Style == LimitStyleLimit && node.Count == nil && node.Offset == nil {
+					c.Replace(nil)
+				} else if node.Style == LimitStyleFetch || node.Style == LimitStyleTop {
+					node.Style = LimitStyleLimit
+				}
+			case *UpdateStatement:
+				node.Tables, node.From = append(node.Tables, node.From...), nil
+			case *DeleteStatement:
+				if len(node.Using) > 0 && !namesAll(tableNames(node.Using), tableNames(node.Tables)) {
+					tables := node.Tables
+					node.Tables = nil
+					for _, name := range tableNames(tables) {
+						node.Tables = append(node.Tables, &TableName{Name: name})
+					}
+					node.Using = append(tables, node.Using...)
+				}
+			}
+			return true
+		}, nil)
+	}
+}
+
+// asPostgreSQL rewrites stmts to the forms the PostgreSQL dialect prints in place of the ones it lacks.
+func asPostgreSQL(stmts []Statement) {
+	for _, stmt := range stmts {
+		Inspect(stmt, func(node Node) bool {
+			if limit, ok := node.(*Limit); ok && limit.Style == LimitStyleComma {
+				limit.Style = LimitStyleLimit
+			}
+			return true
+		})
+	}
+}
+
+// quotedNamesOf returns the sorted names written quoted anywhere in stmts.
+func quotedNamesOf(stmts []Statement) []string {
+	var names []string
+	for _, stmt := range stmts {
+		names = append(names, stmt.(spanned).quotedNames()...)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+func TestFprint(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		dialect Dialect
+		want    string
+	}{
+		{
+			name:  "keywords and clauses are upper-cased",
+			input: "select distinct a, count(*) from t where b is not null group by a having count(*) > 1 order by 2 desc limit 10",
+			want:  "SELECT DISTINCT a, count(*) FROM t WHERE b IS NOT NULL GROUP BY a HAVING count(*) > 1 ORDER BY 2 DESC LIMIT 10",
+		},
+		{
+			name:  "prefixed strings keep their prefix",
+			input: `select E'a\\b\n', X'1F', n'x'`,
+			want:  `SELECT E'a\\b` + "\n" + `', X'1F', N'x'`,
+		},
+		{
+			name:    "escape strings are plain strings in mysql",
+			input:   `select E'a\\b'`,
+			dialect: DialectMySQL,
+			want:    `SELECT 'a\\b'`,
+		},
+		{
+			name:  "keyword and unusual identifiers are quoted",
+			input: `update s.t as "order" set "select" = "two words", "1st" = "say ""hi"""`,
+			want:  `UPDATE s.t AS "order" SET "select" = "two words", "1st" = "say ""hi"""`,
+		},
+		{
+			name:    "mysql quotes identifiers with backticks",
+			input:   "update t as `table` set `from` = `a``b`",
+			dialect: DialectMySQL,
+			want:    "UPDATE t AS `table` SET `from` = `a``b`",
+		},
+		{
+			name:  "names written quoted stay quoted",
+			input: `select "MyCol", a::public."MyType", b collate "C" from t where "Col" = 1 and Col2 = 2`,
+			want:  `SELECT "MyCol", a::public."MyType", b COLLATE "C" FROM t WHERE "Col" = 1 AND Col2 = 2`,
+		},
+		{
+			name:    "names written quoted stay quoted in mysql",
+			input:   `update "T" set "Name" = lower("Name")`,
+			dialect: DialectMySQL,
+			want:    "UPDATE `T` SET `Name` = lower(`Name`)",
+		},
+		{
+			name:  "parentheses follow precedence",
+			input: "select (a + b) * c, a - (b - c), a - b - c, not (a and b), (a or b) and c, -(a + b)",
+			want:  "SELECT (a + b) * c, a - (b - c), a - b - c, NOT (a AND b), (a OR b) AND c, -(a + b)",
+		},
+		{
+			name:  "comparisons compared are parenthesized",
+			input: "select (a = 1) = (b = 2), (a < b) <> c, a = (b > c)",
+			want:  "SELECT (a = 1) = (b = 2), (a < b) <> c, a = (b > c)",
+		},
+		{
+			name:  "numbers keep their text",
+			input: "select 12345678901234567890, 1.50, 007",
+			want:  "SELECT 12345678901234567890, 1.50, 007",
+		},
+		{
+			name:  "negative numbers keep apart from minus",
+			input: "select 1 - -2, - -3",
+			want:  "SELECT 1 - -2, - -3",
+		},
+		{
+			name:  "set operations keep their grouping",
+			input: "select 1 union (select 2 union select 3) except select 4",
+			want:  "SELECT 1 UNION (SELECT 2 UNION SELECT 3) EXCEPT SELECT 4",
+		},
+		{
+			name:  "joins",
+			input: "delete from t using a join b using (id) left join (c cross join d) on a.x = c.x",
+			want:  "DELETE FROM t USING a JOIN b USING (id) LEFT JOIN (c CROSS JOIN d) ON a.x = c.x",
+		},
+		{
+			name:  "table elements keep their order",
+			input: "create table t (a int, like u including all, b int, primary key (a), c int)",
+			want:  "CREATE TABLE t (a int, LIKE u INCLUDING ALL, b int, PRIMARY KEY (a), c int)",
+		},
+		{
+			name:    "mysql index definitions and table options",
+			input:   "create table t (id int, key idx (id)) engine=InnoDB default character set utf8mb4 comment='x'",
+			dialect: DialectMySQL,
+			want:    "CREATE TABLE t (id int, INDEX idx (id)) ENGINE = InnoDB DEFAULT CHARACTER SET = utf8mb4 COMMENT = 'x'",
+		},
+		{
+			name:  "function body is dollar-quoted with a tag it does not contain",
+			input: "create function f() returns text language sql as 'select ''$$'''",
+			want:  "CREATE FUNCTION f() RETURNS text LANGUAGE sql AS $body$select '$$'$body$",
+		},
+		{
+			name:  "function body ending in a dollar-quoted string",
+			input: "create function f() returns text as 'select $$x$$' language sql",
+			want:  "CREATE FUNCTION f() RETURNS text LANGUAGE sql AS $body$select $$x$$$body$",
+		},
+		{
+			name:  "function body ending in a dollar sign",
+			input: "create function f() returns text as 'select 1 $' language sql",
+			want:  "CREATE FUNCTION f() RETURNS text LANGUAGE sql AS $body$select 1 $$body$",
+		},
+		{
+			name:    "mysql spelling of set and begin",
+			input:   "set sql_mode = 'ANSI'",
+			dialect: DialectMySQL,
+			want:    "SET sql_mode = 'ANSI'",
+		},
+		{
+			name:    "mysql set names",
+			input:   "set names utf8mb4",
+			dialect: DialectMySQL,
+			want:    "SET NAMES utf8mb4",
+		},
+		{
+			name:    "mysql set user variable",
+			input:   "set @x = 1",
+			dialect: DialectMySQL,
+			want:    "SET @x = 1",
+		},
+		{
+			name:    "mysql set global",
+			input:   "set global max_connections = 200",
+			dialect: DialectMySQL,
+			want:    "SET GLOBAL max_connections = 200",
+		},
+		{
+			name:    "mysql user accounts",
+			input:   "create user 'u'@'localhost' identified by 'pw'",
+			dialect: DialectMySQL,
+			want:    "CREATE USER 'u'@'localhost' IDENTIFIED BY 'pw'",
+		},
+		{
+			name:    "mysql writes casts with cast",
+			input:   "select a::int, (b + 1)::text from t",
+			dialect: DialectMySQL,
+			want:    "SELECT CAST(a AS int), CAST(b + 1 AS text) FROM t",
+		},
+		{
+			name:    "mysql writes fetch first with limit",
+			input:   "select a from t order by a offset 5 rows fetch first 10 rows only",
+			dialect: DialectMySQL,
+			want:    "SELECT a FROM t ORDER BY a LIMIT 10 OFFSET 5",
+		},
+		{
+			name:    "mysql writes top with limit",
+			input:   "select top 3 a from t",
+			dialect: DialectMySQL,
+			want:    "SELECT a FROM t LIMIT 3",
+		},
+		{
+			name:    "mysql writes concatenation with concat",
+			input:   "select a || b || (c || d) from t",
+			dialect: DialectMySQL,
+			want:    "SELECT concat(a, b, concat(c, d)) FROM t",
+		},
+		{
+			name:    "mysql drops limit all",
+			input:   "select a from t limit all",
+			dialect: DialectMySQL,
+			want:    "SELECT a FROM t",
+		},
+		{
+			name:    "mysql names the from list of an update with the table updated",
+			input:   "update t as x set a = u.a from u where x.id = u.id",
+			dialect: DialectMySQL,
+			want:    "UPDATE t AS x, u SET a = u.a WHERE x.id = u.id",
+		},
+		{
+			name:    "mysql names the table deleted from in the using list",
+			input:   "delete from t as x using u where x.id = u.id",
+			dialect: DialectMySQL,
+			want:    "DELETE FROM x USING t AS x, u WHERE x.id = u.id",
+		},
+		{
+			name:    "mysql using list that names the table deleted from",
+			input:   "delete from t using t join u on t.id = u.id",
+			dialect: DialectMySQL,
+			want:    "DELETE FROM t USING t JOIN u ON t.id = u.id",
+		},
+		{
+			name:  "postgresql writes limit with a comma as limit and offset",
+			input: "select a from t limit 5, 10",
+			want:  "SELECT a FROM t LIMIT 10 OFFSET 5",
+		},
+		{
+			name:  "utility options use the word form when possible",
+			input: "vacuum (full, analyze) t (a)",
+			want:  "VACUUM FULL ANALYZE t (a)",
+		},
+		{
+			name:  "utility options in another order stay in parentheses",
+			input: "explain (verbose, analyze, format json) select 1",
+			want:  "EXPLAIN (VERBOSE, ANALYZE, FORMAT json) SELECT 1",
+		},
+		{
+			name:  "option values written as strings stay strings",
+			input: "copy t to stdout with (format csv, quote 'Q', delimiter ';')",
+			want:  "COPY t TO STDOUT WITH (FORMAT csv, QUOTE 'Q', DELIMITER ';')",
+		},
+		{
+			name:  "explain format written as a string",
+			input: "explain (format 'JSON') select 1",
+			want:  "EXPLAIN (FORMAT 'JSON') SELECT 1",
+		},
+		{
+			name:  "copy with inline data",
+			input: "copy t from stdin;\n1\t2\n\\.\n",
+			want:  "COPY t FROM STDIN;\n1\t2\n\\.\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmts, err := NewParser(lexer.NewLexer(tt.input).Lex()).Parse()
+			if err != nil {
+				t.Fatalf("Parser.Parse() error = %v", err)
+			}
+			var b strings.Builder
+			if err := (&PrintConfig{Dialect: tt.dialect}).Fprint(&b, stmts[0]); err != nil {
+				t.Fatalf("Fprint() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("Fprint() = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFprintUnsupported(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		dialect Dialect
+	}{
+		{name: "on conflict", input: "insert into t values (1) on conflict do nothing", dialect: DialectMySQL},
+		{name: "fetch with ties", input: "select a from t order by a fetch first 3 rows with ties", dialect: DialectMySQL},
+		{name: "top percent", input: "select top 10 percent a from t", dialect: DialectMySQL},
+		{name: "offset without a count", input: "select a from t offset 5 rows", dialect: DialectMySQL},
+		{name: "index definition", input: "create table t (a int, index (a))", dialect: DialectPostgreSQL},
+		{name: "table options", input: "create table t (a int) engine = InnoDB", dialect: DialectPostgreSQL},
+		{name: "alter table add index", input: "alter table t add index idx (a)", dialect: DialectPostgreSQL},
+		{name: "set user variable", input: "set @x = 1", dialect: DialectPostgreSQL},
+		{name: "set global", input: "set global max_connections = 200", dialect: DialectPostgreSQL},
+		{name: "returning", input: "insert into t values (1) returning id", dialect: DialectMySQL},
+		{name: "update returning", input: "update t set a = 1 returning id", dialect: DialectMySQL},
+		{name: "delete returning", input: "delete from t returning id", dialect: DialectMySQL},
+		{name: "limit all with an offset", input: "select a from t limit all offset 5", dialect: DialectMySQL},
+		{name: "on duplicate key update", input: "insert into t values (1) on duplicate key update a = 1", dialect: DialectPostgreSQL},
+		{name: "multiple-table update", input: "update t, u set t.a = u.a where t.id = u.id", dialect: DialectPostgreSQL},
+		{name: "update with a join", input: "update t join u on t.id = u.id set t.a = u.a", dialect: DialectPostgreSQL},
+		{name: "update with a limit", input: "update t set a = 1 limit 10", dialect: DialectPostgreSQL},
+		{name: "multiple-table delete", input: "delete t from t join u on t.id = u.id", dialect: DialectPostgreSQL},
+		{name: "delete using the table deleted from", input: "delete from t using t join u on t.id = u.id", dialect: DialectPostgreSQL},
+		{name: "delete with order by", input: "delete from t order by a limit 1", dialect: DialectPostgreSQL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmts, err := NewParser(lexer.NewLexer(tt.input).Lex()).Parse()
+			if err != nil {
+				t.Fatalf("Parser.Parse() error = %v", err)
+			}
+			var b strings.Builder
+			if err := (&PrintConfig{Dialect: tt.dialect}).Fprint(&b, stmts[0]); !errors.Is(err, ErrUnsupported) {
+				t.Errorf("Fprint() error = %v, want ErrUnsupported", err)
+			}
+		})
+	}
+}
+
+func TestFprintScript(t *testing.T) {
+	stmts, err := NewParser(lexer.NewLexer("begin; copy t from stdin;\n1\n\\.\ncommit").Lex()).Parse()
+	if err != nil {
+		t.Fatalf("Parser.Parse() error = %v", err)
+	}
+	var b strings.Builder
+	if err := (&PrintConfig{}).FprintScript(&b, stmts); err != nil {
+		t.Fatalf("FprintScript() error = %v", err)
+	}
+	want := "BEGIN;\nCOPY t FROM STDIN;\n1\n\\.\nCOMMIT;\n"
+	if b.String() != want {
+		t.Errorf("FprintScript() = %q, want %q", b.String(), want)
+	}
+}
+
+func TestPrintNode(t *testing.T) {
+	tests := []struct {
+		name string
+		node Node
+		want string
+	}{
+		{
+			name: "expression",
+			node: &BinaryExpression{Left: &StringLiteral{Value: "a"}, Operator: tokens.TokenConcat, Right: &ColumnExpression{Table: addr("t"), Name: "b"}},
+			want: "'a' || t.b",
+		},
+		{
+			name: "clause node",
+			node: &OrderByItem{Expression: &ColumnExpression{Name: "a"}, Direction: SortDesc},
+			want: "a DESC",
+		},
+		{
+			name: "nil node",
+			node: nil,
+			want: "",
+		},
+		{
+			name: "nil statement",
+			node: (*SelectStatement)(nil),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Print(tt.node); got != tt.want {
+				t.Errorf("Print() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
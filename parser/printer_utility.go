@@ -0,0 +1,531 @@
+package parser
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/lexer"
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// transaction converts the statements that begin and end transactions and savepoints.
+func (p *printer) transaction(stmt Statement) doc {
+	switch s := stmt.(type) {
+	case *BeginStatement:
+		keyword := "BEGIN"
+		if p.config.Dialect == DialectMySQL {
+			keyword = "START TRANSACTION"
+		}
+		return docClause{keyword: keyword, body: transactionModes(s.Modes)}
+	case *CommitStatement:
+		return docKeyword("COMMIT" + word(s.Chain, " AND CHAIN"))
+	case *RollbackStatement:
+		if s.Savepoint != nil {
+			return docClause{keyword: "ROLLBACK TO SAVEPOINT", body: docIdent(*s.Savepoint)}
+		}
+		return docKeyword("ROLLBACK" + word(s.Chain, " AND CHAIN"))
+	case *SavepointStatement:
+		return docClause{keyword: "SAVEPOINT", body: docIdent(s.Name)}
+	case *ReleaseSavepointStatement:
+		return docClause{keyword: "RELEASE SAVEPOINT", body: docIdent(s.Name)}
+	}
+	p.fail("cannot print transaction statement %T", stmt)
+	return nil
+}
+
+// transactionModes returns the comma-separated transaction modes, or nil if none is given.
+func transactionModes(modes TransactionModes) doc {
+	if modes == (TransactionModes{}) {
+		return nil
+	}
+	return docKeyword(modes.String())
+}
+
+// session converts the statements that change or show configuration parameters.
+func (p *printer) session(stmt Statement) doc {
+	switch s := stmt.(type) {
+	case *SetStatement:
+		keyword := "SET"
+		if s.Scope == SetScopeGlobal && p.config.Dialect != DialectMySQL {
+			p.unsupported("SET GLOBAL")
+		}
+		if s.Scope != SetScopeDefault {
+			keyword += " " + s.Scope.String()
+		}
+		var value doc = docKeyword("DEFAULT")
+		if !s.Default {
+			values := make(docList, len(s.Values))
+			for i, v := range s.Values {
+				values[i] = p.setValue(v)
+			}
+			value = values
+		}
+		if s.Name == "TIME ZONE" || s.Name == "NAMES" {
+			return docClause{keyword: keyword + " " + s.Name, body: value}
+		}
+		name := qualifiedName(s.Name)
+		if strings.HasPrefix(s.Name, "@") {
+			if p.config.Dialect != DialectMySQL {
+				p.unsupported("user variables")
+			}
+			name = docText(s.Name)
+		}
+		var assign doc = docKeyword("TO")
+		if p.config.Dialect == DialectMySQL {
+			assign = docText("=")
+		}
+		return docClause{keyword: keyword, body: words(name, assign, value)}
+	case *SetTransactionStatement:
+		keyword := "SET TRANSACTION"
+		if s.Session {
+			keyword = "SET SESSION CHARACTERISTICS AS TRANSACTION"
+		}
+		return docClause{keyword: keyword, body: transactionModes(s.Modes)}
+	case *ResetStatement:
+		return docClause{keyword: "RESET", body: settingName(s.Name)}
+	case *ShowStatement:
+		return docClause{keyword: "SHOW", body: settingName(s.Name)}
+	case *UseStatement:
+		return docClause{keyword: "USE", body: docIdent(s.Database)}
+	}
+	p.fail("cannot print session statement %T", stmt)
+	return nil
+}
+
+// setValue converts a value of SET, writing the ON the parser keeps as a bare word as a keyword.
+func (p *printer) setValue(value Expression) doc {
+	if column, ok := value.(*ColumnExpression); ok && column.Table == nil && strings.EqualFold(column.Name, "ON") {
+		return docKeyword(column.Name)
+	}
+	return p.expression(value)
+}
+
+// settingName returns the configuration parameter of RESET or SHOW, keeping the special forms as keywords.
+func settingName(name string) doc {
+	switch name {
+	case "ALL", "TIME ZONE", "TRANSACTION ISOLATION LEVEL":
+		return docKeyword(name)
+	}
+	return qualifiedName(name)
+}
+
+func (p *printer) grant(s *GrantStatement) doc {
+	option := when(s.WithGrantOption, docKeyword("WITH GRANT OPTION"))
+	if s.Privileges == nil {
+		option = when(s.WithGrantOption, docKeyword("WITH ADMIN OPTION"))
+	}
+	list := clauses(docClause{keyword: "GRANT", body: p.privilegesOrRoles(s.Privileges, s.Roles)})
+	return append(list, clauses(
+		p.privilegeObjects(s.Privileges, s.ObjectKind, s.Objects, s.Signatures),
+		docClause{keyword: "TO", body: grantees(s.Grantees, s.Hosts)},
+		option,
+		grantedBy(s.GrantedBy),
+	)...)
+}
+
+func (p *printer) revoke(s *RevokeStatement) doc {
+	keyword := "REVOKE"
+	switch {
+	case s.GrantOptionFor && s.Privileges == nil:
+		keyword = "REVOKE ADMIN OPTION FOR"
+	case s.GrantOptionFor:
+		keyword = "REVOKE GRANT OPTION FOR"
+	}
+	return clauses(
+		docClause{keyword: keyword, body: p.privilegesOrRoles(s.Privileges, s.Roles)},
+		p.privilegeObjects(s.Privileges, s.ObjectKind, s.Objects, s.Signatures),
+		docClause{keyword: "FROM", body: grantees(s.Grantees, s.Hosts)},
+		grantedBy(s.GrantedBy),
+		dropBehavior(s.Behavior),
+	)
+}
+
+// privilegesOrRoles converts the privileges or roles granted or revoked.
+func (p *printer) privilegesOrRoles(privileges []*Privilege, roles []string) doc {
+	if privileges == nil {
+		return identifiers(roles)
+	}
+	list := make(docList, len(privileges))
+	for i, privilege := range privileges {
+		name := privilege.Name
+		if name == "ALL" {
+			name = "ALL PRIVILEGES"
+		}
+		list[i] = words(docKeyword(name), identifierList(privilege.Columns))
+	}
+	return list
+}
+
+// privilegeObjects returns the ON clause naming the objects of privileges, or nil when roles are granted.
+func (p *printer) privilegeObjects(privileges []*Privilege, kind string, objects []string, signatures [][]*FunctionParameter) doc {
+	if privileges == nil {
+		return nil
+	}
+	keyword := "ON"
+	if kind != "" {
+		keyword += " " + kind
+	}
+	if objects == nil {
+		return docKeyword(keyword)
+	}
+	if signatures == nil {
+		list := make(docList, len(objects))
+		for i, object := range objects {
+			list[i] = privilegeLevel(object)
+		}
+		return docClause{keyword: keyword, body: list}
+	}
+	return docClause{keyword: keyword, body: p.objectNames(objects, signatures)}
+}
+
+// privilegeLevel returns a possibly qualified name privileges are granted on, keeping * parts unquoted.
+func privilegeLevel(name string) doc {
+	parts := strings.Split(name, ".")
+	joined := make(docConcat, 0, 2*len(parts)-1)
+	for i, part := range parts {
+		if i > 0 {
+			joined = append(joined, docText("."))
+		}
+		if part == "*" {
+			joined = append(joined, docText(part))
+		} else {
+			joined = append(joined, docIdent(part))
+		}
+	}
+	return joined
+}
+
+// grantees returns the grantees of GRANT or REVOKE, writing MySQL accounts as 'user'@'host'.
+func grantees(names []string, hosts []*string) doc {
+	list := make(docList, len(names))
+	for i, name := range names {
+		if hosts != nil {
+			list[i] = account(name, hosts[i])
+		} else {
+			list[i] = docIdent(name)
+		}
+	}
+	return list
+}
+
+// account converts a role name, or a MySQL account 'user'@'host' if host is not nil.
+func account(name string, host *string) doc {
+	if host == nil {
+		return docIdent(name)
+	}
+	return docConcat{docText(quoteString(name)), docText("@"), docText(quoteString(*host))}
+}
+
+func (p *printer) alterDefaultPrivileges(s *AlterDefaultPrivilegesStatement) doc {
+	return clauses(
+		docKeyword("ALTER DEFAULT PRIVILEGES"),
+		clause("FOR ROLE", identifiers(s.Roles)),
+		clause("IN SCHEMA", identifiers(s.Schemas)),
+		p.statement(s.Action),
+	)
+}
+
+// grantedBy returns GRANTED BY role, or nil if role is nil.
+func grantedBy(role *string) doc {
+	if role == nil {
+		return nil
+	}
+	return docClause{keyword: "GRANTED BY", body: docIdent(*role)}
+}
+
+func (p *printer) createRole(s *CreateRoleStatement) doc {
+	keyword := "CREATE ROLE"
+	if s.User {
+		keyword = "CREATE USER"
+	}
+	return clauses(docClause{keyword: keyword, body: account(s.Name, s.Host)}, p.roleOptionsClause(s.Options))
+}
+
+func (p *printer) alterRole(s *AlterRoleStatement) doc {
+	keyword := "ALTER ROLE"
+	if s.User {
+		keyword = "ALTER USER"
+	}
+	var action doc
+	switch {
+	case s.NewName != nil:
+		action = clause("RENAME TO", optionalIdent(s.NewName))
+	case s.Set != nil:
+		action = p.session(s.Set)
+	case s.Reset != nil:
+		action = p.session(s.Reset)
+	default:
+		action = p.roleOptionsClause(s.Options)
+	}
+	return clauses(docClause{keyword: keyword, body: account(s.Name, s.Host)}, clause("IN DATABASE", optionalIdent(s.Database)), action)
+}
+
+// roleOptionsClause converts the options of CREATE or ALTER ROLE after WITH,
+// which MySQL does not write, or returns nil if there are none.
+func (p *printer) roleOptionsClause(options []*RoleOption) doc {
+	if len(options) == 0 {
+		return nil
+	}
+	if p.config.Dialect == DialectMySQL {
+		return p.roleOptions(options)
+	}
+	return docClause{keyword: "WITH", body: p.roleOptions(options)}
+}
+
+// roleOptions converts the options of CREATE or ALTER ROLE, separated by spaces.
+func (p *printer) roleOptions(options []*RoleOption) doc {
+	parts := make([]doc, len(options))
+	for i, option := range options {
+		parts[i] = p.roleOption(option)
+	}
+	return words(parts...)
+}
+
+func (p *printer) roleOption(o *RoleOption) doc {
+	switch {
+	case o.Value != nil:
+		return words(docKeyword(o.Name), p.expression(o.Value))
+	case len(o.Roles) > 0:
+		return words(docKeyword(o.Name), identifiers(o.Roles))
+	}
+	return docKeyword(o.Name)
+}
+
+func (p *printer) explain(s *ExplainStatement) doc {
+	var options doc
+	if p.config.Dialect == DialectMySQL && len(s.Options) == 1 && s.Options[0].Name == "FORMAT" && s.Options[0].Value != nil {
+		options = words(docKeyword("FORMAT"), docText("="), utilityValue(s.Options[0]))
+	} else {
+		options = p.utilityOptions(s.Options, "ANALYZE", "VERBOSE")
+	}
+	return clauses(docClause{keyword: "EXPLAIN", body: options}, p.statement(s.Statement))
+}
+
+// utilityOptions converts the options of EXPLAIN, ANALYZE, VACUUM or COPY,
+// written as the bare words the statement allows when they are words in
+// the allowed order, and as a parenthesized list otherwise. It returns nil
+// if there are no options.
+func (p *printer) utilityOptions(options []*UtilityOption, allowed ...string) doc {
+	if len(options) == 0 {
+		return nil
+	}
+	if bareUtilityOptions(options, allowed) {
+		parts := make([]doc, len(options))
+		for i, option := range options {
+			parts[i] = docKeyword(option.Name)
+		}
+		return words(parts...)
+	}
+	list := make(docList, len(options))
+	for i, option := range options {
+		var value doc
+		switch {
+		case option.Value != nil:
+			value = utilityValue(option)
+		case option.Columns != nil:
+			value = identifierList(option.Columns)
+		case option.AllColumns:
+			value = docText("*")
+		}
+		list[i] = words(docKeyword(option.Name), value)
+	}
+	return docParens{list}
+}
+
+// bareUtilityOptions reports whether options are valueless and form a subsequence of allowed.
+func bareUtilityOptions(options []*UtilityOption, allowed []string) bool {
+	next := 0
+	for _, option := range options {
+		if option.Value != nil || option.Columns != nil || option.AllColumns {
+			return false
+		}
+		i := slices.Index(allowed[next:], option.Name)
+		if i < 0 {
+			return false
+		}
+		next += i + 1
+	}
+	return true
+}
+
+// utilityValue returns the value of an option as a string literal if it was
+// written as one, and otherwise bare if it reads back as the same word, number
+// or boolean, and as a string literal if it does not.
+func utilityValue(option *UtilityOption) doc {
+	value := *option.Value
+	if option.Quoted {
+		return docText(quoteString(value))
+	}
+	lexed := lexer.NewLexer(value).Lex()
+	if len(lexed) == 2 && lexed[0].Literal == value {
+		switch lexed[0].Type {
+		case tokens.TokenIdentifier, tokens.TokenNumericLiteral, tokens.TokenBooleanLiteral, tokens.TokenOn:
+			return docText(value)
+		}
+	}
+	return docText(quoteString(value))
+}
+
+func (p *printer) prepare(s *PrepareStatement) doc {
+	var types doc
+	if len(s.Types) > 0 {
+		types = docParens{p.dataTypes(s.Types)}
+	}
+	return clauses(
+		docClause{keyword: "PREPARE", body: words(docIdent(s.Name), types)},
+		docClause{keyword: "AS", body: p.statement(s.Statement)},
+	)
+}
+
+func (p *printer) execute(s *ExecuteStatement) doc {
+	var arguments doc
+	if len(s.Arguments) > 0 {
+		arguments = docParens{p.expressions(s.Arguments)}
+	}
+	return docClause{keyword: "EXECUTE", body: words(docIdent(s.Name), arguments)}
+}
+
+func (p *printer) deallocate(s *DeallocateStatement) doc {
+	if s.Name == "ALL" {
+		return docKeyword("DEALLOCATE ALL")
+	}
+	return docClause{keyword: "DEALLOCATE", body: docIdent(s.Name)}
+}
+
+func (p *printer) analyze(s *AnalyzeStatement) doc {
+	return docClause{keyword: "ANALYZE", body: words(p.utilityOptions(s.Options, "VERBOSE"), vacuumRelations(s.Tables))}
+}
+
+func (p *printer) vacuum(s *VacuumStatement) doc {
+	return docClause{keyword: "VACUUM", body: words(p.utilityOptions(s.Options, "FULL", "FREEZE", "VERBOSE", "ANALYZE"), vacuumRelations(s.Tables))}
+}
+
+// vacuumRelations returns the tables of ANALYZE or VACUUM, or nil if there are none.
+func vacuumRelations(tables []*VacuumRelation) doc {
+	if len(tables) == 0 {
+		return nil
+	}
+	list := make(docList, len(tables))
+	for i, table := range tables {
+		list[i] = words(qualifiedName(table.Table), identifierList(table.Columns))
+	}
+	return list
+}
+
+func (p *printer) copy(s *CopyStatement) doc {
+	source := words(qualifiedName(s.Table), identifierList(s.Columns))
+	if s.Query != nil {
+		source = docParens{p.query(s.Query)}
+	}
+	direction, stdio := "FROM", "STDIN"
+	if s.To {
+		direction, stdio = "TO", "STDOUT"
+	}
+	var target doc = docKeyword(stdio)
+	if s.File != nil {
+		target = words(when(s.Program, docKeyword("PROGRAM")), docText(quoteString(*s.File)))
+	}
+	list := clauses(
+		docClause{keyword: "COPY", body: source},
+		docClause{keyword: direction, body: target},
+		p.copyOptions(s.Options),
+		clause("WHERE", p.optionalExpression(s.Where)),
+	)
+	if s.Data != nil {
+		data := *s.Data
+		if data != "" && !strings.HasSuffix(data, "\n") {
+			data += "\n"
+		}
+		// The data rows start on the line after the statement and end at a line holding \.
+		return docConcat{list, docText(";\n" + data + `\.` + "\n")}
+	}
+	return list
+}
+
+// copyOptions converts the options of COPY, in the legacy form without
+// parentheses when each option has one, and as WITH (options) otherwise.
+func (p *printer) copyOptions(options []*UtilityOption) doc {
+	if len(options) == 0 {
+		return nil
+	}
+	legacy := true
+	for _, option := range options {
+		if slices.Contains(copyOptionWords, option.Name) {
+			legacy = legacy && option.Value == nil && option.Columns == nil && !option.AllColumns
+		} else {
+			legacy = legacy && slices.Contains(copyOptionValues, option.Name) && option.Value != nil && option.Quoted
+		}
+	}
+	if !legacy {
+		return docClause{keyword: "WITH", body: p.utilityOptions(options)}
+	}
+	parts := make([]doc, len(options))
+	for i, option := range options {
+		parts[i] = docKeyword(option.Name)
+		if option.Value != nil {
+			parts[i] = words(docKeyword(option.Name), docText(quoteString(*option.Value)))
+		}
+	}
+	return words(parts...)
+}
+
+func (p *printer) loadData(s *LoadDataStatement) doc {
+	var duplicates, characterSet, fields, lines, ignore doc
+	if s.Duplicates != "" {
+		duplicates = docKeyword(s.Duplicates)
+	}
+	if s.CharacterSet != nil {
+		characterSet = docClause{keyword: "CHARACTER SET", body: docIdent(*s.CharacterSet)}
+	}
+	enclosedBy := "ENCLOSED BY"
+	if s.OptionallyEnclosed {
+		enclosedBy = "OPTIONALLY ENCLOSED BY"
+	}
+	fields = clause("FIELDS", words(
+		stringOption("TERMINATED BY", s.FieldsTerminatedBy),
+		stringOption(enclosedBy, s.FieldsEnclosedBy),
+		stringOption("ESCAPED BY", s.FieldsEscapedBy),
+	))
+	lines = clause("LINES", words(
+		stringOption("STARTING BY", s.LinesStartingBy),
+		stringOption("TERMINATED BY", s.LinesTerminatedBy),
+	))
+	if s.IgnoreLines > 0 {
+		ignore = docClause{keyword: "IGNORE", body: words(docText(strconv.Itoa(s.IgnoreLines)), docKeyword("LINES"))}
+	}
+	return clauses(
+		docClause{keyword: "LOAD DATA" + word(s.Local, " LOCAL") + " INFILE", body: docText(quoteString(s.File))},
+		duplicates,
+		docClause{keyword: "INTO TABLE", body: qualifiedName(s.Table)},
+		characterSet,
+		fields,
+		lines,
+		ignore,
+		loadDataColumns(s.Columns),
+		clause("SET", p.assignments(s.Assignments)),
+	)
+}
+
+// loadDataColumns returns the columns of LOAD DATA as a parenthesized list, or nil if there are none.
+func loadDataColumns(columns []string) doc {
+	if len(columns) == 0 {
+		return nil
+	}
+	list := make(docList, len(columns))
+	for i, column := range columns {
+		list[i] = docIdent(column)
+		if strings.HasPrefix(column, "@") {
+			list[i] = docText(column)
+		}
+	}
+	return docParens{list}
+}
+
+// stringOption returns keyword followed by the string value points to, or nil if value is nil.
+func stringOption(keyword string, value *string) doc {
+	if value == nil {
+		return nil
+	}
+	return words(docKeyword(keyword), docText(quoteString(*value)))
+}
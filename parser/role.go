@@ -0,0 +1,182 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// roleFlags lists the role options that take no argument, each of which also has a NO form.
+var roleFlags = []string{"SUPERUSER", "CREATEDB", "CREATEROLE", "INHERIT", "LOGIN", "REPLICATION", "BYPASSRLS"}
+
+// parseCreateRole parses CREATE {ROLE | USER} name [[WITH] option ...], starting at ROLE or USER.
+// The name of a user may be a MySQL account 'user'@'host'.
+func (p *Parser) parseCreateRole() (*CreateRoleStatement, error) {
+	stmt := &CreateRoleStatement{User: p.peekWord("USER")}
+	p.pos++ // Skip ROLE or USER
+	var err error
+	stmt.Name, stmt.Host, err = p.parseRoleName(stmt.User)
+	if err != nil {
+		return nil, err
+	}
+	stmt.Options, err = p.parseRoleOptions()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseAlterRole parses ALTER {ROLE | USER} name [WITH] option ...,
+// ALTER {ROLE | USER} name RENAME TO new_name and
+// ALTER {ROLE | USER} name [IN DATABASE database] {SET ... | RESET ...},
+// starting at ROLE or USER. The name of a user may be a MySQL account 'user'@'host'.
+func (p *Parser) parseAlterRole() (*AlterRoleStatement, error) {
+	stmt := &AlterRoleStatement{User: p.peekWord("USER")}
+	p.pos++ // Skip ROLE or USER
+	var err error
+	stmt.Name, stmt.Host, err = p.parseRoleName(stmt.User)
+	if err != nil {
+		return nil, err
+	}
+	if p.consumeKeywords("IN", "DATABASE") {
+		database, err := p.parseIdentifier("database name")
+		if err != nil {
+			return nil, err
+		}
+		stmt.Database = &database
+	}
+	pos := p.peek().Pos
+	switch {
+	case p.peek().Type == tokens.TokenSet:
+		set, err := p.parseSet()
+		if err != nil {
+			return nil, err
+		}
+		setStmt, ok := set.(*SetStatement)
+		if !ok || setStmt.Scope != SetScopeDefault {
+			return nil, fmt.Errorf("expected configuration parameter after SET in ALTER ROLE, at position %d", pos)
+		}
+		stmt.Set = finishNode(p, pos, setStmt)
+		return stmt, nil
+	case p.peekWord("RESET"):
+		reset, err := p.parseReset()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Reset = finishNode(p, pos, reset)
+		return stmt, nil
+	case stmt.Database != nil:
+		return nil, fmt.Errorf("expected SET or RESET after IN DATABASE, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if p.consumeWord("RENAME") {
+		if _, err := p.expect(tokens.TokenTo, "TO after RENAME"); err != nil {
+			return nil, err
+		}
+		newName, err := p.parseIdentifier("new role name")
+		if err != nil {
+			return nil, err
+		}
+		stmt.NewName = &newName
+		return stmt, nil
+	}
+	stmt.Options, err = p.parseRoleOptions()
+	if err != nil {
+		return nil, err
+	}
+	if len(stmt.Options) == 0 {
+		return nil, fmt.Errorf("expected role option or RENAME, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	return stmt, nil
+}
+
+// parseRoleName parses the name of a role, or the name or MySQL account of a user if user is set.
+func (p *Parser) parseRoleName(user bool) (string, *string, error) {
+	if user {
+		return p.parseAccount()
+	}
+	name, err := p.parseIdentifier("role name")
+	return name, nil, err
+}
+
+// parseRoleOptions parses the options of CREATE and ALTER ROLE, preceded by an optional WITH.
+func (p *Parser) parseRoleOptions() ([]*RoleOption, error) {
+	p.consume(tokens.TokenWith)
+	var options []*RoleOption
+	for {
+		pos := p.peek().Pos
+		option, err := p.parseRoleOption()
+		if err != nil {
+			return nil, err
+		}
+		if option == nil {
+			return options, nil
+		}
+		options = append(options, finishNode(p, pos, option))
+	}
+}
+
+// parseRoleOption parses a single role option, returning nil if none is found.
+func (p *Parser) parseRoleOption() (*RoleOption, error) {
+	if p.peek().Type == tokens.TokenIdentifier {
+		word := strings.ToUpper(p.peek().Literal)
+		for _, flag := range roleFlags {
+			if word == flag || word == "NO"+flag {
+				p.pos++
+				return &RoleOption{Name: word}, nil
+			}
+		}
+	}
+	switch {
+	case p.consumeKeywords("CONNECTION", "LIMIT"):
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CONNECTION LIMIT: %w", err)
+		}
+		return &RoleOption{Name: "CONNECTION LIMIT", Value: value}, nil
+	case p.consumeWord("ENCRYPTED") || p.peekWord("PASSWORD"):
+		if err := p.expectWord("PASSWORD"); err != nil {
+			return nil, err
+		}
+		option := &RoleOption{Name: "PASSWORD"}
+		switch p.peek().Type {
+		case tokens.TokenStringLiteral:
+			password := p.next()
+			option.Value = finishNode(p, password.Pos, &StringLiteral{Value: password.RawValue()})
+		case tokens.TokenNull:
+			option.Value = finishNode(p, p.next().Pos, &NullValue{})
+		default:
+			return nil, fmt.Errorf("expected password string or NULL, found %q at position %d", p.peek().Literal, p.pos)
+		}
+		return option, nil
+	case p.consumeKeywords("IDENTIFIED", "BY"):
+		password, err := p.expect(tokens.TokenStringLiteral, "password string after IDENTIFIED BY")
+		if err != nil {
+			return nil, err
+		}
+		return &RoleOption{Name: "IDENTIFIED BY", Value: finishNode(p, password.Pos, &StringLiteral{Value: password.RawValue()})}, nil
+	case p.consumeKeywords("VALID", "UNTIL"):
+		timestamp, err := p.expect(tokens.TokenStringLiteral, "timestamp after VALID UNTIL")
+		if err != nil {
+			return nil, err
+		}
+		return &RoleOption{Name: "VALID UNTIL", Value: finishNode(p, timestamp.Pos, &StringLiteral{Value: timestamp.RawValue()})}, nil
+
+	case p.consumeKeywords("IN", "ROLE") || p.consumeKeywords("IN", "GROUP"):
+		return p.parseRoleOptionRoles("IN ROLE")
+	case p.consumeWord("ROLE") || p.consumeWord("USER"):
+		return p.parseRoleOptionRoles("ROLE")
+	case p.consumeWord("ADMIN"):
+		return p.parseRoleOptionRoles("ADMIN")
+	}
+	return nil, nil
+}
+
+// parseRoleOptionRoles parses the role names of an IN ROLE, ROLE or ADMIN option.
+func (p *Parser) parseRoleOptionRoles(name string) (*RoleOption, error) {
+	roles, err := p.parseIdentifiers()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+	return &RoleOption{Name: name, Roles: roles}, nil
+}
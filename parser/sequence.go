@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"fmt"
+)
+
+// parseCreateSequence parses the remainder of CREATE [TEMPORARY] SEQUENCE [IF NOT EXISTS] name [options].
+func (p *Parser) parseCreateSequence(temporary bool) (*CreateSequenceStatement, error) {
+	p.pos++ // Skip the SEQUENCE token
+	stmt := &CreateSequenceStatement{Temporary: temporary}
+	var err error
+	stmt.IfNotExists, err = p.parseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Name, err = p.parseQualifiedName("sequence name")
+	if err != nil {
+		return nil, err
+	}
+	stmt.Options, err = p.parseSequenceOptions()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseAlterSequence parses ALTER SEQUENCE [IF EXISTS] name options. The ALTER token has already been consumed.
+func (p *Parser) parseAlterSequence() (*AlterSequenceStatement, error) {
+	p.pos++ // Skip the SEQUENCE token
+	stmt := &AlterSequenceStatement{IfExists: p.parseIfExists()}
+	var err error
+	stmt.Name, err = p.parseQualifiedName("sequence name")
+	if err != nil {
+		return nil, err
+	}
+	stmt.Options, err = p.parseSequenceOptions()
+	if err != nil {
+		return nil, err
+	}
+	if len(stmt.Options) == 0 {
+		return nil, fmt.Errorf("expected sequence option, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	return stmt, nil
+}
@@ -0,0 +1,131 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseSet parses SET [SESSION | LOCAL] name {TO | =} value, SET TIME ZONE value,
+// SET TRANSACTION modes and SET SESSION CHARACTERISTICS AS TRANSACTION modes.
+func (p *Parser) parseSet() (Statement, error) {
+	p.pos++ // Skip SET
+	if p.consumeKeywords("SESSION", "CHARACTERISTICS", "AS", "TRANSACTION") {
+		return p.parseSetTransaction(true)
+	}
+	stmt := &SetStatement{}
+	switch {
+	case p.consumeWord("SESSION"):
+		stmt.Scope = SetScopeSession
+	case p.consumeWord("LOCAL"):
+		stmt.Scope = SetScopeLocal
+	case p.consumeWord("GLOBAL"):
+		stmt.Scope = SetScopeGlobal
+	}
+	switch {
+	case stmt.Scope == SetScopeDefault && p.consumeWord("TRANSACTION"):
+		return p.parseSetTransaction(false)
+	case p.consumeKeywords("TIME", "ZONE"):
+		stmt.Name = "TIME ZONE"
+	case stmt.Scope == SetScopeDefault && p.consumeWord("NAMES"):
+		stmt.Name = "NAMES"
+	default:
+		if variable := p.peek(); variable.Type == tokens.TokenVariable && stmt.Scope == SetScopeDefault {
+			p.pos++
+			stmt.Name = variable.Literal
+		} else {
+			var err error
+			stmt.Name, err = p.parseQualifiedName("configuration parameter or user variable")
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !p.consume(tokens.TokenTo) && !p.consume(tokens.TokenEqual) {
+			return nil, fmt.Errorf("expected TO or = after SET %s, found %q at position %d", stmt.Name, p.peek().Literal, p.pos)
+		}
+	}
+	if p.consume(tokens.TokenDefault) {
+		stmt.Default = true
+		return stmt, nil
+	}
+	for {
+		value, err := p.parseSetValue()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing value of %s: %w", stmt.Name, err)
+		}
+		stmt.Values = append(stmt.Values, value)
+
+		if !p.consume(tokens.TokenComma) {
+			return stmt, nil
+		}
+	}
+}
+
+// parseSetValue parses a value of SET. ON is a reserved word elsewhere but a
+// valid boolean setting here, so it is returned as a bare word like off.
+func (p *Parser) parseSetValue() (Expression, error) {
+	if p.peek().Type == tokens.TokenOn {
+		on := p.next()
+		return finishNode(p, on.Pos, &ColumnExpression{Name: on.Literal}), nil
+
+	}
+	return p.parseExpression()
+}
+
+// parseSetTransaction parses the transaction modes of SET TRANSACTION, at least one of which is required.
+func (p *Parser) parseSetTransaction(session bool) (*SetTransactionStatement, error) {
+	modes, err := p.parseTransactionModes()
+	if err != nil {
+		return nil, err
+	}
+	if modes == (TransactionModes{}) {
+		return nil, fmt.Errorf("expected transaction mode, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	return &SetTransactionStatement{Session: session, Modes: modes}, nil
+}
+
+// parseReset parses RESET {name | TIME ZONE | ALL}.
+func (p *Parser) parseReset() (*ResetStatement, error) {
+	p.pos++ // Skip RESET
+	name, err := p.parseSettingName()
+	if err != nil {
+		return nil, err
+	}
+	return &ResetStatement{Name: name}, nil
+}
+
+// parseShow parses SHOW {name | TIME ZONE | TRANSACTION ISOLATION LEVEL | ALL}.
+func (p *Parser) parseShow() (*ShowStatement, error) {
+	p.pos++ // Skip SHOW
+	if p.consumeKeywords("TRANSACTION", "ISOLATION", "LEVEL") {
+		return &ShowStatement{Name: "TRANSACTION ISOLATION LEVEL"}, nil
+	}
+	name, err := p.parseSettingName()
+	if err != nil {
+		return nil, err
+	}
+	return &ShowStatement{Name: name}, nil
+}
+
+// parseSettingName parses the configuration parameter named by RESET and SHOW,
+// returning "ALL" and "TIME ZONE" for those special forms.
+func (p *Parser) parseSettingName() (string, error) {
+	switch {
+	case p.consume(tokens.TokenAll):
+		return "ALL", nil
+	case p.consumeKeywords("TIME", "ZONE"):
+		return "TIME ZONE", nil
+	default:
+		return p.parseQualifiedName("configuration parameter")
+	}
+}
+
+// parseUse parses MySQL's USE database.
+func (p *Parser) parseUse() (*UseStatement, error) {
+	p.pos++ // Skip USE
+	database, err := p.parseIdentifier("database name")
+	if err != nil {
+		return nil, err
+	}
+	return &UseStatement{Database: database}, nil
+}
@@ -0,0 +1,219 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseCaseExpression parses CASE [operand] WHEN ... THEN ... [ELSE ...] END.
+func (p *Parser) parseCaseExpression() (Expression, error) {
+	pos := p.next().Pos // Skip CASE
+	expr := &CaseExpression{}
+	if p.peek().Type != tokens.TokenWhen {
+		operand, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CASE operand: %w", err)
+		}
+		expr.Operand = operand
+	}
+	for p.consume(tokens.TokenWhen) {
+		whenPos := p.posBefore(1)
+		condition, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing WHEN condition: %w", err)
+		}
+		if _, err := p.expect(tokens.TokenThen, "THEN"); err != nil {
+			return nil, err
+		}
+		result, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing THEN result: %w", err)
+		}
+		expr.Whens = append(expr.Whens, finishNode(p, whenPos, &WhenClause{Condition: condition, Result: result}))
+	}
+	if len(expr.Whens) == 0 {
+		return nil, fmt.Errorf("expected WHEN in CASE expression, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if p.consume(tokens.TokenElse) {
+		result, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ELSE result: %w", err)
+		}
+		expr.Else = result
+	}
+	if _, err := p.expect(tokens.TokenEnd, "END of CASE expression"); err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, expr), nil
+}
+
+// parseCastExpression parses CAST(expression AS type).
+func (p *Parser) parseCastExpression() (Expression, error) {
+	pos := p.next().Pos // Skip CAST
+	if _, err := p.expect(tokens.TokenLeftParen, "( after CAST"); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CAST operand: %w", err)
+	}
+	if _, err := p.expect(tokens.TokenAs, "AS in CAST"); err != nil {
+		return nil, err
+	}
+	dataType, err := p.parseDataType()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after CAST"); err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, &CastExpression{Expression: expr, Type: dataType}), nil
+}
+
+// parseSpecialFunction parses the functions whose arguments use keywords
+// instead of commas: EXISTS, EXTRACT, POSITION, SUBSTRING and TRIM. The current
+// token is a name followed by an opening parenthesis. It reports false, without
+// consuming anything, when the call should be parsed as an ordinary function call,
+// as with substring(x, 1, 2).
+func (p *Parser) parseSpecialFunction() (Expression, bool, error) {
+	start := p.pos
+	name := strings.ToUpper(p.peek().Literal)
+	switch name {
+	case "EXISTS", "EXTRACT", "POSITION", "SUBSTRING", "TRIM":
+	default:
+		return nil, false, nil
+	}
+	p.pos += 2 // Skip the name and the opening parenthesis
+
+	var expr Expression
+	var err error
+	switch name {
+	case "EXISTS":
+		if !p.peekQuery() {
+			p.pos = start
+			return nil, false, nil
+		}
+		var query QueryExpression
+		query, err = p.parseQuery()
+		expr = &ExistsExpression{Query: query}
+	case "EXTRACT":
+		expr, err = p.parseExtractArguments()
+	case "POSITION":
+		expr, err = p.parsePositionArguments()
+	case "SUBSTRING":
+		expr, err = p.parseSubstringArguments()
+	case "TRIM":
+		expr, err = p.parseTrimArguments()
+	}
+	if err != nil {
+		return nil, true, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+	if expr == nil {
+		p.pos = start
+		return nil, false, nil
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after "+name+" arguments"); err != nil {
+		return nil, true, err
+	}
+	return finishNode(p, p.tokens[start].Pos, expr), true, nil
+
+}
+
+// parseExtractArguments parses field FROM source.
+func (p *Parser) parseExtractArguments() (Expression, error) {
+	field := p.next()
+	if field.Type != tokens.TokenIdentifier && field.Type != tokens.TokenStringLiteral {
+		return nil, fmt.Errorf("expected field name, found %q at position %d", field.Literal, p.pos-1)
+	}
+	if _, err := p.expect(tokens.TokenFrom, "FROM"); err != nil {
+		return nil, err
+	}
+	source, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	return &ExtractExpression{Field: field.RawValue(), Source: source}, nil
+}
+
+// parsePositionArguments parses substring IN source.
+func (p *Parser) parsePositionArguments() (Expression, error) {
+	substring, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenIn, "IN"); err != nil {
+		return nil, err
+	}
+	source, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	return &PositionExpression{Substring: substring, Source: source}, nil
+}
+
+// parseSubstringArguments parses source [FROM start] [FOR length]. It returns
+// nil when the arguments are comma-separated instead.
+func (p *Parser) parseSubstringArguments() (Expression, error) {
+	source, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().Type != tokens.TokenFrom && p.peek().Type != tokens.TokenFor {
+		return nil, nil
+	}
+	expr := &SubstringExpression{Source: source}
+	if p.consume(tokens.TokenFrom) {
+		expr.From, err = p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consume(tokens.TokenFor) {
+		expr.For, err = p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
+
+// parseTrimArguments parses [LEADING | TRAILING | BOTH] [characters] [FROM] source.
+// It returns nil for the plain trim(source) and trim(source, characters) forms.
+func (p *Parser) parseTrimArguments() (Expression, error) {
+	expr := &TrimExpression{}
+	switch {
+	case p.consumeWord("BOTH"):
+		expr.Side = TrimBoth
+	case p.consumeWord("LEADING"):
+		expr.Side = TrimLeading
+	case p.consumeWord("TRAILING"):
+		expr.Side = TrimTrailing
+	}
+	if p.consume(tokens.TokenFrom) {
+		source, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		expr.Source = source
+		return expr, nil
+	}
+	first, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(tokens.TokenFrom) {
+		expr.Characters = first
+		expr.Source, err = p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	if expr.Side == TrimDefault {
+		return nil, nil
+	}
+	expr.Source = first
+	return expr, nil
+}
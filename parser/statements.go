@@ -8,69 +8,312 @@ import (
 	"github.com/sanemat/go-sql-parser/tokens"
 )
 
-// parseStatement is the entry point for parsing a single SQL statement.
-func (p *Parser) parseStatement() (Node, error) {
+// parseStatement is the entry point for parsing a single SQL statement. The
+// statement's span is set here, since some statements are dispatched on after
+// their leading keywords have been consumed.
+func (p *Parser) parseStatement() (Statement, error) {
+	pos := p.peek().Pos
+	stmt, err := p.dispatchStatement()
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, stmt), nil
+}
+
+// dispatchStatement parses a single SQL statement according to its first token.
+func (p *Parser) dispatchStatement() (Statement, error) {
 	if len(p.tokens) == 0 {
 		return nil, fmt.Errorf("no tokens to parse")
 	}
 	// Check the first significant token to determine the statement type
 	switch {
-	case p.peek().Type == tokens.TokenSelect:
-		return p.parseSelect()
-	case strings.ToUpper(p.peek().Literal) == "INSERT":
-		// return p.parseInsert() // Assuming you have a parseInsert method
-		return nil, fmt.Errorf("parseInsert is not implemented yet")
+	case p.peek().Type == tokens.TokenWith:
+		return p.parseStatementWith()
+	case p.peek().Type == tokens.TokenSelect || p.peek().Type == tokens.TokenLeftParen:
+		return p.parseQuery()
+	case p.peek().Type == tokens.TokenInsert:
+		return p.parseInsert(nil)
+	case p.peek().Type == tokens.TokenUpdate:
+		return p.parseUpdate(nil)
+	case p.peek().Type == tokens.TokenDelete:
+		return p.parseDelete(nil)
+	case p.peekWord("MERGE"):
+		return p.parseMerge(nil)
+	case p.peek().Type == tokens.TokenTruncate:
+		return p.parseTruncate()
+	case p.peek().Type == tokens.TokenCreate:
+		return p.parseCreate()
+	case p.peek().Type == tokens.TokenAlter:
+		return p.parseAlter()
+	case p.peek().Type == tokens.TokenDrop:
+		return p.parseDrop()
+	case p.peekWord("REFRESH"):
+		return p.parseRefreshMaterializedView()
+	case p.peekWord("BEGIN") || p.peekWord("START"):
+		return p.parseBegin()
+	case p.peekWord("COMMIT") || p.peek().Type == tokens.TokenEnd:
+		return p.parseCommit()
+	case p.peekWord("ROLLBACK") || p.peekWord("ABORT"):
+		return p.parseRollback()
+	case p.peekWord("SAVEPOINT"):
+		return p.parseSavepoint()
+	case p.peekWord("RELEASE"):
+		return p.parseReleaseSavepoint()
+	case p.peek().Type == tokens.TokenSet:
+		return p.parseSet()
+	case p.peekWord("RESET"):
+		return p.parseReset()
+	case p.peekWord("SHOW"):
+		return p.parseShow()
+	case p.peekWord("USE"):
+		return p.parseUse()
+	case p.peekWord("GRANT"):
+		return p.parseGrant(false)
+	case p.peekWord("REVOKE"):
+		return p.parseRevoke(false)
+	case p.peekWord("EXPLAIN"):
+		return p.parseExplain()
+	case p.peekWord("PREPARE"):
+		return p.parsePrepare()
+	case p.peekWord("EXECUTE"):
+		return p.parseExecute()
+	case p.peekWord("DEALLOCATE"):
+		return p.parseDeallocate()
+	case p.peekWord("ANALYZE"):
+		return p.parseAnalyze()
+	case p.peekWord("VACUUM"):
+		return p.parseVacuum()
+	case p.peekWord("COPY"):
+		return p.parseCopy()
+	case p.peekWord("LOAD"):
+		return p.parseLoadData()
 	default:
 		return nil, fmt.Errorf("unsupported statement type: %v, literal: %s, at position %d", p.peek().Type, p.peek().Literal, p.pos)
 	}
 }
 
-// parseSelect parses a Query rule from the grammar
+// parseStatementWith parses a WITH clause and the statement it is attached to.
+func (p *Parser) parseStatementWith() (Statement, error) {
+	with, err := p.parseWith()
+	if err != nil {
+		return nil, err
+	}
+	switch p.peek().Type {
+	case tokens.TokenSelect, tokens.TokenLeftParen:
+		return p.parseQueryBody(with)
+	case tokens.TokenInsert:
+		return p.parseInsert(with)
+	case tokens.TokenUpdate:
+		return p.parseUpdate(with)
+	case tokens.TokenDelete:
+		return p.parseDelete(with)
+	}
+	if p.peekWord("MERGE") {
+		return p.parseMerge(with)
+	}
+	return nil, fmt.Errorf("unsupported statement after WITH clause: %s, at position %d", p.peek().Literal, p.pos)
+}
+
+// setOperationPrecedence maps set operators to their precedence; INTERSECT binds tighter than UNION and EXCEPT.
+var setOperationPrecedence = map[tokens.TokenType]int{
+	tokens.TokenUnion:     1,
+	tokens.TokenExcept:    1,
+	tokens.TokenIntersect: 2,
+}
+
+// parseQuery parses a SELECT, possibly combined with other queries by set operators,
+// with the optional leading WITH clause and the trailing ORDER BY and row-limiting
+// clauses that apply to the whole query.
+func (p *Parser) parseQuery() (QueryExpression, error) {
+	var with *WithClause
+	if p.peek().Type == tokens.TokenWith {
+		var err error
+		with, err = p.parseWith()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p.parseQueryBody(with)
+}
+
+// parseQueryBody parses the part of a query following its WITH clause, which may be nil.
+func (p *Parser) parseQueryBody(with *WithClause) (QueryExpression, error) {
+	query, err := p.parseSetOperation(1)
+	if err != nil {
+		return nil, err
+	}
+	var orderBy []*OrderByItem
+	if p.consume(tokens.TokenOrder) {
+		orderBy, err = p.parseOrderBy()
+		if err != nil {
+			return nil, err
+		}
+	}
+	limit, err := p.parseLimit()
+	if err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case *SelectStatement:
+		if with != nil && q.With != nil {
+			return nil, fmt.Errorf("multiple WITH clauses at position %d", p.pos)
+		}
+		if with != nil {
+			q.With = with
+		}
+		if orderBy != nil && q.OrderBy != nil {
+			return nil, fmt.Errorf("multiple ORDER BY clauses at position %d", p.pos)
+		}
+		if limit != nil && q.Limit != nil {
+			return nil, fmt.Errorf("%s cannot be combined with %s at position %d", q.Limit.Style, limit.Style, p.pos)
+		}
+		if orderBy != nil {
+			q.OrderBy = orderBy
+		}
+		if limit != nil {
+			q.Limit = limit
+		}
+	case *SetOperation:
+		if with != nil && q.With != nil || orderBy != nil && q.OrderBy != nil || limit != nil && q.Limit != nil {
+			return nil, fmt.Errorf("multiple WITH, ORDER BY or LIMIT clauses at position %d", p.pos)
+		}
+		if with != nil {
+			q.With = with
+		}
+		if orderBy != nil {
+			q.OrderBy = orderBy
+		}
+		if limit != nil {
+			q.Limit = limit
+		}
+	}
+	pos := query.Pos()
+	if with != nil {
+		pos = with.Pos()
+	}
+	return finishNode(p, pos, query), nil
+}
+
+// parseSetOperation parses queries joined by set operators that bind at least as tightly as minPrecedence.
+func (p *Parser) parseSetOperation(minPrecedence int) (QueryExpression, error) {
+	pos := p.peek().Pos
+	left, err := p.parseQueryTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		operator := p.peek().Type
+		precedence, ok := setOperationPrecedence[operator]
+		if !ok || precedence < minPrecedence {
+			return left, nil
+		}
+		p.pos++ // Skip the operator
+		operation := &SetOperation{Left: left, Operator: operator}
+		switch {
+		case p.consume(tokens.TokenAll):
+			operation.All = true
+		case p.consume(tokens.TokenDistinct):
+			operation.Distinct = true
+		}
+		right, err := p.parseSetOperation(precedence + 1)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing right operand of %s: %w", operatorToString(operator), err)
+		}
+		operation.Right = right
+		left = finishNode(p, pos, operation)
+	}
+}
+
+// parseQueryTerm parses a single SELECT or a parenthesized query used as a set operand.
+func (p *Parser) parseQueryTerm() (QueryExpression, error) {
+	switch p.peek().Type {
+	case tokens.TokenSelect:
+		return p.parseSelect()
+	case tokens.TokenLeftParen:
+		p.pos++ // Skip the opening parenthesis
+		query, err := p.parseQuery()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return query, nil
+	default:
+		return nil, fmt.Errorf("expected SELECT or (, found %q at position %d", p.peek().Literal, p.pos)
+	}
+}
+
+// parseSelect parses a single SELECT up to, but not including, its ORDER BY clause.
+// ORDER BY and LIMIT are parsed by parseQuery since they may apply to a set operation.
 func (p *Parser) parseSelect() (*SelectStatement, error) {
-	// For simplicity, this function assumes the tokens match the expected pattern.
-	// In practice, you would check token types and handle errors.
-	p.pos++ // Skip the SELECT token
-	expressions, err := p.parseExpressions()
+	pos := p.next().Pos // Skip the SELECT token
+	stmt := &SelectStatement{}
+	switch {
+	case p.consume(tokens.TokenDistinct):
+		stmt.Distinct = true
+	case p.consume(tokens.TokenAll):
+	}
+	if p.peekWord("TOP") && (p.peekAhead(1).Type == tokens.TokenNumericLiteral || p.peekAhead(1).Type == tokens.TokenLeftParen) {
+		limit, err := p.parseTop()
+		if err != nil {
+			return &SelectStatement{}, err
+		}
+		stmt.Limit = limit
+	}
+	expressions, err := p.parseSelectItems()
 	if err != nil {
 		return &SelectStatement{}, err
 	}
+	stmt.Expressions = expressions
 
-	var tableNamePtr *string
-	if p.peek().Type == tokens.TokenFrom {
-		p.pos++ // Skip the FROM token
-		tableName, err := p.parseSelectTableName()
+	if p.consume(tokens.TokenFrom) {
+		from, err := p.parseTableExpressions()
 		if err != nil {
+			return &SelectStatement{}, fmt.Errorf("error parsing FROM clause: %w", err)
+		}
+		stmt.From = from
+	}
+	if p.consume(tokens.TokenWhere) {
+		where, err := p.parseExpression()
+		if err != nil {
+			return &SelectStatement{}, fmt.Errorf("error parsing WHERE clause: %w", err)
+		}
+		stmt.Where = where
+	}
+	if p.consume(tokens.TokenGroup) {
+		if _, err := p.expect(tokens.TokenBy, "BY after GROUP"); err != nil {
 			return &SelectStatement{}, err
 		}
-		tableNamePtr = &tableName
+		groupBy, err := p.parseExpressions()
+		if err != nil {
+			return &SelectStatement{}, fmt.Errorf("error parsing GROUP BY clause: %w", err)
+		}
+		stmt.GroupBy = groupBy
 	}
-	// Skip until the end of statement or start of the next statement
-	for !(p.peek().Type == tokens.TokenSemicolon || p.peek().Type == tokens.TokenEOF) {
-		p.pos++
+	if p.consume(tokens.TokenHaving) {
+		having, err := p.parseExpression()
+		if err != nil {
+			return &SelectStatement{}, fmt.Errorf("error parsing HAVING clause: %w", err)
+		}
+		stmt.Having = having
 	}
-	// Optionally parse WHERE clause...
-	return &SelectStatement{
-		Expressions: expressions,
-		Table:       tableNamePtr,
-	}, nil
+	if p.consume(tokens.TokenWindow) {
+		windows, err := p.parseWindows()
+		if err != nil {
+			return &SelectStatement{}, fmt.Errorf("error parsing WINDOW clause: %w", err)
+		}
+		stmt.Windows = windows
+	}
+	return finishNode(p, pos, stmt), nil
 }
 
 func (p *Parser) parseExpressions() ([]Expression, error) {
 	var expressions []Expression
 
 	for {
-		token := p.next()
-
-		var expr Expression
-		var err error
-		switch {
-		case token.Type == tokens.TokenIdentifier:
-			expr = &ColumnExpression{Name: token.Literal}
-		case isLiteral(token.Type):
-			expr, err = p.parseLiteral(token)
-		default:
-			err = fmt.Errorf("unexpected token in expression: %v", token.Literal)
-		}
+		expr, err := p.parseExpression()
 		if err != nil {
 			return nil, err
 		}
@@ -85,18 +328,220 @@ func (p *Parser) parseExpressions() ([]Expression, error) {
 	return expressions, nil
 }
 
+// parseSelectItems parses a select list or RETURNING list, whose expressions may be given aliases.
+func (p *Parser) parseSelectItems() ([]Expression, error) {
+	var items []Expression
+	for {
+		pos := p.peek().Pos
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case p.consume(tokens.TokenAs):
+			alias, err := p.parseIdentifier("alias after AS")
+			if err != nil {
+				return nil, err
+			}
+			expr = finishNode(p, pos, &AliasedExpression{Expression: expr, Alias: alias})
+		case p.peek().Type == tokens.TokenIdentifier:
+			expr = finishNode(p, pos, &AliasedExpression{Expression: expr, Alias: p.next().RawValue()})
+		}
+		items = append(items, expr)
+
+		if !p.consume(tokens.TokenComma) {
+			return items, nil
+		}
+	}
+}
+
+// parseOrderBy parses the sort keys of an ORDER BY clause. The ORDER token has already been consumed.
+func (p *Parser) parseOrderBy() ([]*OrderByItem, error) {
+	if _, err := p.expect(tokens.TokenBy, "BY after ORDER"); err != nil {
+		return nil, err
+	}
+	var items []*OrderByItem
+	for {
+		pos := p.peek().Pos
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ORDER BY clause: %w", err)
+		}
+		item := &OrderByItem{Expression: expr}
+		// A trailing COLLATE is parsed as part of the expression but belongs to the sort key.
+		if collate, ok := expr.(*CollateExpression); ok {
+			item.Expression = collate.Expression
+			item.Collation = &collate.Collation
+		}
+		item.Direction, item.Nulls, err = p.parseSortOptions()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, finishNode(p, pos, item))
+
+		if !p.consume(tokens.TokenComma) {
+			return items, nil
+		}
+	}
+}
+
+// parseSortOptions parses the optional ASC or DESC and NULLS FIRST or NULLS LAST of a sort key.
+func (p *Parser) parseSortOptions() (SortDirection, NullsOrder, error) {
+	direction := SortDefault
+	switch {
+	case p.consume(tokens.TokenAsc):
+		direction = SortAsc
+	case p.consume(tokens.TokenDesc):
+		direction = SortDesc
+	}
+	nulls := NullsDefault
+	if p.consumeWord("NULLS") {
+		switch {
+		case p.consumeWord("FIRST"):
+			nulls = NullsFirst
+		case p.consumeWord("LAST"):
+			nulls = NullsLast
+		default:
+			return direction, nulls, fmt.Errorf("expected FIRST or LAST after NULLS, found %q at position %d", p.peek().Literal, p.pos)
+		}
+	}
+	return direction, nulls, nil
+}
+
+// parseLimit parses the row-limiting clauses that may follow ORDER BY:
+// LIMIT n [OFFSET m], MySQL's LIMIT m, n, and the standard
+// [OFFSET m {ROW|ROWS}] [FETCH {FIRST|NEXT} [n [PERCENT]] {ROW|ROWS} {ONLY|WITH TIES}].
+// It returns nil when none of them is present.
+func (p *Parser) parseLimit() (*Limit, error) {
+	pos := p.peek().Pos
+	var limit *Limit
+	if p.consume(tokens.TokenLimit) {
+		limit = &Limit{Style: LimitStyleLimit}
+		if !p.consume(tokens.TokenAll) {
+			count, err := p.parseExpression()
+			if err != nil {
+				return nil, fmt.Errorf("error parsing LIMIT clause: %w", err)
+			}
+			limit.Count = count
+		}
+		if p.consume(tokens.TokenComma) {
+			// MySQL LIMIT offset, count
+			count, err := p.parseExpression()
+			if err != nil {
+				return nil, fmt.Errorf("error parsing LIMIT clause: %w", err)
+			}
+			limit.Style = LimitStyleComma
+			limit.Offset, limit.Count = limit.Count, count
+			return finishNode(p, pos, limit), nil
+		}
+	}
+	if p.consume(tokens.TokenOffset) {
+		offset, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing OFFSET clause: %w", err)
+		}
+		if limit == nil {
+			limit = &Limit{Style: LimitStyleLimit}
+		}
+		limit.Offset = offset
+		if p.consumeWord("ROW") || p.consumeWord("ROWS") {
+			limit.Style = LimitStyleFetch
+		}
+		// PostgreSQL also accepts OFFSET before LIMIT.
+		if limit.Count == nil && p.consume(tokens.TokenLimit) {
+			if !p.consume(tokens.TokenAll) {
+				count, err := p.parseExpression()
+				if err != nil {
+					return nil, fmt.Errorf("error parsing LIMIT clause: %w", err)
+				}
+				limit.Count = count
+			}
+		}
+	}
+	if p.consume(tokens.TokenFetch) {
+		if limit == nil {
+			limit = &Limit{}
+		} else if limit.Count != nil {
+			return nil, fmt.Errorf("FETCH cannot be combined with LIMIT at position %d", p.pos)
+		}
+		limit.Style = LimitStyleFetch
+		if err := p.parseFetch(limit); err != nil {
+			return nil, err
+		}
+	}
+	if limit == nil {
+		return nil, nil
+	}
+	return finishNode(p, pos, limit), nil
+}
+
+// parseFetch parses the remainder of a FETCH clause into limit. The FETCH token has already been consumed.
+func (p *Parser) parseFetch(limit *Limit) error {
+	if !p.consumeWord("FIRST") && !p.consumeWord("NEXT") {
+		return fmt.Errorf("expected FIRST or NEXT after FETCH, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	// The row count is optional and defaults to one row.
+	if !p.peekWord("ROW") && !p.peekWord("ROWS") {
+		count, err := p.parseExpression()
+		if err != nil {
+			return fmt.Errorf("error parsing FETCH clause: %w", err)
+		}
+		limit.Count = count
+		limit.Percent = p.consumeWord("PERCENT")
+	}
+	if !p.consumeWord("ROW") && !p.consumeWord("ROWS") {
+		return fmt.Errorf("expected ROW or ROWS in FETCH clause, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	switch {
+	case p.consumeWord("ONLY"):
+	case p.consume(tokens.TokenWith):
+		if err := p.expectWord("TIES"); err != nil {
+			return err
+		}
+		limit.WithTies = true
+	default:
+		return fmt.Errorf("expected ONLY or WITH TIES in FETCH clause, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	return nil
+}
+
+// parseTop parses the T-SQL TOP n [PERCENT] [WITH TIES] clause that follows SELECT.
+func (p *Parser) parseTop() (*Limit, error) {
+	pos := p.next().Pos // Skip the TOP word
+	limit := &Limit{Style: LimitStyleTop}
+	// The count is either a number or a parenthesized expression.
+	count, err := p.parsePrimaryExpression()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing TOP clause: %w", err)
+	}
+	limit.Count = count
+	limit.Percent = p.consumeWord("PERCENT")
+	if p.peek().Type == tokens.TokenWith && strings.EqualFold(p.peekAhead(1).Literal, "TIES") {
+		p.pos += 2 // Skip WITH TIES
+		limit.WithTies = true
+	}
+	return finishNode(p, pos, limit), nil
+}
+
 func (p *Parser) parseLiteral(token tokens.Token) (Expression, error) {
 	switch token.Type {
 	case tokens.TokenNumericLiteral:
+		if hex, ok := strings.CutPrefix(strings.ToLower(token.Literal), "0x"); ok {
+			value, err := strconv.ParseUint(hex, 16, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing numeric literal: %s, err: %w", token.Literal, err)
+			}
+			return finishNode(p, token.Pos, &NumericLiteral{Value: float64(value), Text: token.Literal}), nil
+		}
 		value, err := strconv.ParseFloat(token.Literal, 64)
 		if err != nil {
 			return nil,
 				fmt.Errorf("error parsing numeric literal: %s, err: %w",
 					token.Literal, err)
 		}
-		return &NumericLiteral{Value: value}, nil
+		return finishNode(p, token.Pos, &NumericLiteral{Value: value, Text: token.Literal}), nil
 	case tokens.TokenStringLiteral:
-		return &StringLiteral{Value: token.RawValue()}, nil
+		return finishNode(p, token.Pos, &StringLiteral{Prefix: stringPrefix(token), Value: token.RawValue()}), nil
 	case tokens.TokenBooleanLiteral:
 		value, err := strconv.ParseBool(strings.ToLower(token.Literal))
 		if err != nil {
@@ -104,20 +549,10 @@ func (p *Parser) parseLiteral(token tokens.Token) (Expression, error) {
 				fmt.Errorf("error parsing boolean literal:str %s, err: %w",
 					token.Literal, err)
 		}
-		return &BooleanLiteral{Value: value}, nil
+		return finishNode(p, token.Pos, &BooleanLiteral{Value: value}), nil
 	case tokens.TokenNull:
-		return &NullValue{}, nil
+		return finishNode(p, token.Pos, &NullValue{}), nil
 	default:
 		return nil, fmt.Errorf("unexpected literal type: %v", token.Type)
 	}
 }
-
-func (p *Parser) parseSelectTableName() (string, error) {
-	// Ensure the current token is an identifier (e.g., table name).
-	if p.tokens[p.pos].Type != tokens.TokenIdentifier {
-		return "", fmt.Errorf("expected table name, found %s", p.tokens[p.pos].Literal)
-	}
-	tableName := p.tokens[p.pos].Literal
-	p.pos++ // Move past the table name.
-	return tableName, nil
-}
@@ -0,0 +1,184 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// aliasStopWords are the words the lexer reads as identifiers that are never taken as an
+// alias written without AS: the words that start a join, and the reserved words of
+// PostgreSQL that are not keyword tokens, such as ONLY and LATERAL.
+var aliasStopWords = []string{
+	"JOIN", "INNER", "LEFT", "RIGHT", "FULL", "CROSS", "NATURAL", "OUTER",
+	"ANALYSE", "ANALYZE", "ANY", "ARRAY", "ASYMMETRIC", "BOTH", "COLUMN", "CURRENT_CATALOG",
+	"CURRENT_DATE", "CURRENT_ROLE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER",
+	"DEFERRABLE", "DO", "GRANT", "INITIALLY", "LATERAL", "LEADING", "LOCALTIME", "LOCALTIMESTAMP",
+	"ONLY", "PLACING", "SESSION_USER", "SOME", "SYMMETRIC", "TABLESAMPLE", "TRAILING", "USER", "VARIADIC",
+}
+
+// parseTableExpressions parses a comma-separated list of table expressions such as a FROM list.
+func (p *Parser) parseTableExpressions() ([]TableExpression, error) {
+	var tables []TableExpression
+	for {
+		table, err := p.parseTableExpression()
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+
+		if !p.consume(tokens.TokenComma) {
+			return tables, nil
+		}
+	}
+}
+
+// parseTableExpression parses a table reference followed by any number of joins.
+func (p *Parser) parseTableExpression() (TableExpression, error) {
+	pos := p.peek().Pos
+	left, err := p.parseTablePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		join := &Join{Left: left, Natural: p.consumeWord("NATURAL")}
+		switch {
+		case p.consumeWord("CROSS"):
+			join.Type = JoinCross
+		case p.consumeWord("INNER"):
+			join.Type = JoinInner
+		case p.consumeWord("LEFT"):
+			join.Type = JoinLeft
+			p.consumeWord("OUTER")
+		case p.consumeWord("RIGHT"):
+			join.Type = JoinRight
+			p.consumeWord("OUTER")
+		case p.consumeWord("FULL"):
+			join.Type = JoinFull
+			p.consumeWord("OUTER")
+		case p.peekWord("JOIN"):
+			join.Type = JoinInner
+		default:
+			if join.Natural {
+				return nil, fmt.Errorf("expected JOIN after NATURAL, found %q at position %d", p.peek().Literal, p.pos)
+			}
+			return left, nil
+		}
+		if err := p.expectWord("JOIN"); err != nil {
+			return nil, err
+		}
+		join.Right, err = p.parseTablePrimary()
+		if err != nil {
+			return nil, err
+		}
+		if join.Type != JoinCross && !join.Natural {
+			switch {
+			case p.consume(tokens.TokenOn):
+				join.On, err = p.parseExpression()
+				if err != nil {
+					return nil, fmt.Errorf("error parsing join condition: %w", err)
+				}
+			case p.consume(tokens.TokenUsing):
+				join.Using, err = p.parseIdentifierList()
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, fmt.Errorf("expected ON or USING after %s, found %q at position %d", join.Type, p.peek().Literal, p.pos)
+			}
+		}
+		left = finishNode(p, pos, join)
+	}
+}
+
+// parseTablePrimary parses a table name, a derived table or a parenthesized join, with an optional alias.
+// A table name may be written as ONLY name or name *.
+func (p *Parser) parseTablePrimary() (TableExpression, error) {
+	pos := p.peek().Pos
+	if p.consumeOnly() {
+		return p.parseTableReference(pos, true)
+	}
+	if p.consume(tokens.TokenLeftParen) {
+		if !p.peekQuery() {
+			table, err := p.parseTableExpression()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokens.TokenRightParen, ") after joined tables"); err != nil {
+				return nil, err
+			}
+			return table, nil
+		}
+		query, err := p.parseQuery()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after subquery"); err != nil {
+			return nil, err
+		}
+		alias, err := p.parseTableAlias()
+		if err != nil {
+			return nil, err
+		}
+		return finishNode(p, pos, &SubqueryTable{Query: query, Alias: alias}), nil
+	}
+	return p.parseTableReference(pos, false)
+}
+
+// parseTableReference parses a table name starting at pos followed by an optional alias.
+func (p *Parser) parseTableReference(pos int, only bool) (*TableName, error) {
+	table, err := p.parseTableName(only)
+	if err != nil {
+		return nil, err
+	}
+	table.Alias, err = p.parseTableAlias()
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, table), nil
+}
+
+// consumeOnly advances past ONLY before a table name and reports whether it did.
+func (p *Parser) consumeOnly() bool {
+	if !p.peekWord("ONLY") || p.peekAhead(1).Type != tokens.TokenIdentifier {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// parseTableName parses a possibly qualified table name, followed by an optional * unless only
+// is set because ONLY preceded it.
+func (p *Parser) parseTableName(only bool) (*TableName, error) {
+	name, err := p.parseQualifiedName("table name")
+	if err != nil {
+		return nil, err
+	}
+	table := &TableName{Name: name, Only: only}
+	if token := p.peek(); !only && token.Type == tokens.TokenSymbol && token.Literal == "*" {
+		p.pos++ // Skip *
+		table.Descendants = true
+	}
+	return table, nil
+}
+
+// parseTableAlias parses an optional [AS] alias after a table reference, returning nil if there is none.
+func (p *Parser) parseTableAlias() (*string, error) {
+	if p.consume(tokens.TokenAs) {
+		alias, err := p.parseIdentifier("table alias after AS")
+		if err != nil {
+			return nil, err
+		}
+		return &alias, nil
+	}
+	if p.peek().Type != tokens.TokenIdentifier {
+		return nil, nil
+	}
+	for _, word := range aliasStopWords {
+		if p.peekWord(word) {
+			return nil, nil
+		}
+	}
+	alias := p.next().RawValue()
+	return &alias, nil
+}
@@ -0,0 +1,138 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseBegin parses BEGIN [WORK | TRANSACTION] [modes] and START TRANSACTION [modes].
+func (p *Parser) parseBegin() (*BeginStatement, error) {
+	if p.consumeWord("START") {
+		if err := p.expectWord("TRANSACTION"); err != nil {
+			return nil, err
+		}
+	} else {
+		p.pos++ // Skip BEGIN
+		p.consumeTransactionNoise()
+	}
+	modes, err := p.parseTransactionModes()
+	if err != nil {
+		return nil, err
+	}
+	return &BeginStatement{Modes: modes}, nil
+}
+
+// consumeTransactionNoise skips the optional WORK or TRANSACTION word that may follow BEGIN, COMMIT and ROLLBACK.
+func (p *Parser) consumeTransactionNoise() {
+	if !p.consumeWord("WORK") {
+		p.consumeWord("TRANSACTION")
+	}
+}
+
+// parseTransactionModes parses a possibly empty, optionally comma-separated list of
+// ISOLATION LEVEL level, READ {ONLY | WRITE} and [NOT] DEFERRABLE.
+func (p *Parser) parseTransactionModes() (TransactionModes, error) {
+	var modes TransactionModes
+	for {
+		switch {
+		case p.consumeWord("ISOLATION"):
+			if err := p.expectWord("LEVEL"); err != nil {
+				return modes, err
+			}
+			level, err := p.parseIsolationLevel()
+			if err != nil {
+				return modes, err
+			}
+			modes.IsolationLevel = level
+		case p.consumeKeywords("READ", "ONLY"):
+			modes.ReadOnly = addr(true)
+		case p.consumeKeywords("READ", "WRITE"):
+			modes.ReadOnly = addr(false)
+		case p.consumeWord("DEFERRABLE"):
+			modes.Deferrable = addr(true)
+		case p.consumeKeywords("NOT", "DEFERRABLE"):
+			modes.Deferrable = addr(false)
+		default:
+			return modes, nil
+		}
+		p.consume(tokens.TokenComma)
+	}
+}
+
+// parseIsolationLevel parses SERIALIZABLE, REPEATABLE READ, READ COMMITTED or READ UNCOMMITTED.
+func (p *Parser) parseIsolationLevel() (string, error) {
+	for _, level := range []string{"SERIALIZABLE", "REPEATABLE READ", "READ COMMITTED", "READ UNCOMMITTED"} {
+		if p.consumeKeywords(strings.Fields(level)...) {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("expected isolation level, found %q at position %d", p.peek().Literal, p.pos)
+}
+
+// parseCommit parses COMMIT or END [WORK | TRANSACTION] [AND [NO] CHAIN].
+func (p *Parser) parseCommit() (*CommitStatement, error) {
+	p.pos++ // Skip COMMIT or END
+	p.consumeTransactionNoise()
+	chain, err := p.parseChain()
+	if err != nil {
+		return nil, err
+	}
+	return &CommitStatement{Chain: chain}, nil
+}
+
+// parseRollback parses ROLLBACK or ABORT [WORK | TRANSACTION] [AND [NO] CHAIN | TO [SAVEPOINT] name].
+func (p *Parser) parseRollback() (*RollbackStatement, error) {
+	p.pos++ // Skip ROLLBACK or ABORT
+	p.consumeTransactionNoise()
+	stmt := &RollbackStatement{}
+	if p.consume(tokens.TokenTo) {
+		p.consumeWord("SAVEPOINT")
+		name, err := p.parseIdentifier("savepoint name")
+		if err != nil {
+			return nil, err
+		}
+		stmt.Savepoint = &name
+		return stmt, nil
+	}
+	var err error
+	stmt.Chain, err = p.parseChain()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// parseChain parses the optional AND [NO] CHAIN of COMMIT and ROLLBACK and reports whether AND CHAIN was given.
+func (p *Parser) parseChain() (bool, error) {
+	if !p.consume(tokens.TokenAnd) {
+		return false, nil
+	}
+	noChain := p.consumeWord("NO")
+	if err := p.expectWord("CHAIN"); err != nil {
+		return false, err
+	}
+	return !noChain, nil
+}
+
+// parseSavepoint parses SAVEPOINT name.
+func (p *Parser) parseSavepoint() (*SavepointStatement, error) {
+	p.pos++ // Skip SAVEPOINT
+	name, err := p.parseIdentifier("savepoint name")
+	if err != nil {
+		return nil, err
+	}
+	return &SavepointStatement{Name: name}, nil
+}
+
+// parseReleaseSavepoint parses RELEASE [SAVEPOINT] name.
+func (p *Parser) parseReleaseSavepoint() (*ReleaseSavepointStatement, error) {
+	p.pos++ // Skip RELEASE
+	p.consumeWord("SAVEPOINT")
+	name, err := p.parseIdentifier("savepoint name")
+	if err != nil {
+		return nil, err
+	}
+	return &ReleaseSavepointStatement{Name: name}, nil
+}
@@ -7,87 +7,3993 @@ import (
 	"github.com/sanemat/go-sql-parser/tokens"
 )
 
-// Node represents a node in the Abstract Syntax Tree (AST).
-type Node interface{}
+// Node represents a node in the Abstract Syntax Tree (AST). Every node can
+// report the part of the input it was parsed from and the nodes nested in it.
+type Node interface {
+	// Pos returns the byte offset of the first character of the node.
+	Pos() int
+	// End returns the byte offset immediately after the last character of the node.
+	End() int
+	// Children returns the nodes directly nested in this one, in source order.
+	Children() []Node
+	String() string
+}
+
+// Statement is a node that can appear at the top level of a script.
+type Statement interface {
+	Node
+	statementNode()
+}
+
+// Expression interface for expressions in the AST.
+type Expression interface {
+	Node
+	expressionNode()
+}
+
+// QueryExpression is a query that produces rows: a SelectStatement or a SetOperation.
+type QueryExpression interface {
+	Statement
+	queryExpression()
+}
+
+// SelectStatement represents a parsed SELECT statement.
+type SelectStatement struct {
+	span
+
+	With        *WithClause
+	Distinct    bool
+	Expressions []Expression
+	From        []TableExpression
+	Where       Expression
+	GroupBy     []Expression
+	Having      Expression
+	Windows     []*NamedWindow
+	OrderBy     []*OrderByItem
+	Limit       *Limit
+}
+
+func (s *SelectStatement) queryExpression() {}
+
+func (s *SelectStatement) String() string {
+	expressions := make([]string, len(s.Expressions))
+	for i, expr := range s.Expressions {
+		expressions[i] = expr.String()
+	}
+	var clauses strings.Builder
+	if s.Distinct {
+		clauses.WriteString(", Distinct")
+	}
+	if s.Where != nil {
+		fmt.Fprintf(&clauses, ", Where: %s", s.Where.String())
+	}
+	if len(s.GroupBy) > 0 {
+		fmt.Fprintf(&clauses, ", GroupBy: [%s]", joinExpressions(s.GroupBy))
+	}
+	if s.Having != nil {
+		fmt.Fprintf(&clauses, ", Having: %s", s.Having.String())
+	}
+	if len(s.Windows) > 0 {
+		windows := make([]string, len(s.Windows))
+		for i, window := range s.Windows {
+			windows[i] = window.String()
+		}
+		fmt.Fprintf(&clauses, ", Windows: [%s]", strings.Join(windows, ", "))
+	}
+	clauses.WriteString(orderByAndLimitString(s.OrderBy, s.Limit))
+	return fmt.Sprintf(
+		"SelectStatement(%sExpressions: [%s], From: [%s]%s)",
+		withString(s.With), strings.Join(expressions, ", "), joinTableExpressions(s.From), clauses.String())
+}
+
+func (s *SelectStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, s.With)
+	// TOP is written before the select list, the other limits after ORDER BY.
+	top := s.Limit != nil && s.Limit.Style == LimitStyleTop
+	if top {
+		nodes = appendNodes(nodes, s.Limit)
+	}
+	nodes = appendNodes(nodes, s.Expressions...)
+	nodes = appendNodes(nodes, s.From...)
+	nodes = appendNodes(nodes, s.Where)
+	nodes = appendNodes(nodes, s.GroupBy...)
+	nodes = appendNodes(nodes, s.Having)
+	nodes = appendNodes(nodes, s.Windows...)
+	nodes = appendNodes(nodes, s.OrderBy...)
+	if !top {
+		nodes = appendNodes(nodes, s.Limit)
+	}
+	return nodes
+}
+
+// SetOperation represents queries combined with UNION, INTERSECT or EXCEPT.
+// OrderBy and Limit apply to the combined result, not to the right operand.
+type SetOperation struct {
+	span
+
+	With     *WithClause
+	Left     QueryExpression
+	Operator tokens.TokenType // TokenUnion, TokenIntersect or TokenExcept
+	All      bool
+	Distinct bool // DISTINCT written explicitly
+	Right    QueryExpression
+	OrderBy  []*OrderByItem
+	Limit    *Limit
+}
+
+func (s *SetOperation) queryExpression() {}
+
+func (s *SetOperation) String() string {
+	operator := operatorToString(s.Operator)
+	switch {
+	case s.All:
+		operator += " ALL"
+	case s.Distinct:
+		operator += " DISTINCT"
+	}
+	return fmt.Sprintf("SetOperation(%s%s %s %s%s)",
+		withString(s.With), s.Left.String(), operator, s.Right.String(), orderByAndLimitString(s.OrderBy, s.Limit))
+}
+
+func (s *SetOperation) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, s.With)
+	nodes = appendNodes(nodes, s.Left, s.Right)
+	nodes = appendNodes(nodes, s.OrderBy...)
+	nodes = appendNodes(nodes, s.Limit)
+	return nodes
+}
+
+// OverridingKind is the OVERRIDING clause of an INSERT into identity columns.
+type OverridingKind int
+
+const (
+	OverridingNone OverridingKind = iota
+	OverridingSystemValue
+	OverridingUserValue
+)
+
+// InsertStatement represents a parsed INSERT statement. Exactly one of Values,
+// Query and DefaultValues describes the inserted rows.
+type InsertStatement struct {
+	span
+
+	With          *WithClause
+	Table         *TableName
+	Columns       []string
+	Overriding    OverridingKind
+	Values        [][]Expression
+	Query         QueryExpression
+	DefaultValues bool
+	OnConflict    *OnConflict
+	// OnDuplicateKeyUpdate holds the assignments of MySQL's ON DUPLICATE KEY UPDATE.
+	OnDuplicateKeyUpdate []*Assignment
+	Returning            []Expression
+}
+
+func (i *InsertStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "InsertStatement(%sTable: %s", withString(i.With), i.Table.String())
+	if len(i.Columns) > 0 {
+		fmt.Fprintf(&b, ", Columns: [%s]", strings.Join(i.Columns, ", "))
+	}
+	switch i.Overriding {
+	case OverridingSystemValue:
+		b.WriteString(", OVERRIDING SYSTEM VALUE")
+	case OverridingUserValue:
+		b.WriteString(", OVERRIDING USER VALUE")
+	}
+	switch {
+	case i.DefaultValues:
+		b.WriteString(", DEFAULT VALUES")
+	case i.Query != nil:
+		fmt.Fprintf(&b, ", Query: %s", i.Query.String())
+	default:
+		rows := make([]string, len(i.Values))
+		for j, row := range i.Values {
+			rows[j] = fmt.Sprintf("[%s]", joinExpressions(row))
+		}
+		fmt.Fprintf(&b, ", Values: [%s]", strings.Join(rows, ", "))
+	}
+	if i.OnConflict != nil {
+		fmt.Fprintf(&b, ", %s", i.OnConflict.String())
+	}
+	if len(i.OnDuplicateKeyUpdate) > 0 {
+		fmt.Fprintf(&b, ", OnDuplicateKeyUpdate: [%s]", joinAssignments(i.OnDuplicateKeyUpdate))
+	}
+	b.WriteString(returningString(i.Returning))
+	b.WriteString(")")
+	return b.String()
+}
+
+func (i *InsertStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, i.With)
+	nodes = appendNodes(nodes, i.Table)
+	for _, row := range i.Values {
+		nodes = appendNodes(nodes, row...)
+	}
+	nodes = appendNodes(nodes, i.Query)
+	nodes = appendNodes(nodes, i.OnConflict)
+	nodes = appendNodes(nodes, i.OnDuplicateKeyUpdate...)
+	nodes = appendNodes(nodes, i.Returning...)
+	return nodes
+}
+
+// ConflictAction is the action taken by ON CONFLICT.
+type ConflictAction int
+
+const (
+	ConflictDoNothing ConflictAction = iota
+	ConflictDoUpdate
+)
+
+// OnConflict represents the ON CONFLICT clause of an INSERT. The conflict target
+// is either Target, the index columns or expressions with an optional
+// TargetWhere predicate, or Constraint, the name given by ON CONSTRAINT.
+// Both are empty when no target was written.
+type OnConflict struct {
+	span
+
+	Target      []Expression
+	TargetWhere Expression
+	Constraint  *string
+	Action      ConflictAction
+	Assignments []*Assignment
+	Where       Expression
+}
+
+func (o *OnConflict) String() string {
+	var b strings.Builder
+	b.WriteString("OnConflict(")
+	switch {
+	case o.Constraint != nil:
+		fmt.Fprintf(&b, "ON CONSTRAINT %s ", *o.Constraint)
+	case len(o.Target) > 0:
+		fmt.Fprintf(&b, "[%s] ", joinExpressions(o.Target))
+		if o.TargetWhere != nil {
+			fmt.Fprintf(&b, "WHERE %s ", o.TargetWhere.String())
+		}
+	}
+	if o.Action == ConflictDoNothing {
+		b.WriteString("DO NOTHING)")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "DO UPDATE SET [%s]", joinAssignments(o.Assignments))
+	if o.Where != nil {
+		fmt.Fprintf(&b, " WHERE %s", o.Where.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (o *OnConflict) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, o.Target...)
+	nodes = appendNodes(nodes, o.TargetWhere)
+	nodes = appendNodes(nodes, o.Assignments...)
+	nodes = appendNodes(nodes, o.Where)
+	return nodes
+}
+
+// Assignment represents column = value in a SET list. A tuple assignment
+// (a, b) = (1, 2) sets Columns instead of Column, and its Value is a
+// RowExpression or a SubqueryExpression.
+type Assignment struct {
+	span
+
+	Column  string
+	Columns []string
+	Value   Expression
+}
+
+func (a *Assignment) String() string {
+	if len(a.Columns) > 0 {
+		return fmt.Sprintf("Assignment((%s) = %s)", strings.Join(a.Columns, ", "), a.Value.String())
+	}
+	return fmt.Sprintf("Assignment(%s = %s)", a.Column, a.Value.String())
+}
+
+func (a *Assignment) Children() []Node {
+	return appendNodes(nil, a.Value)
+}
+
+// UpdateStatement represents a parsed UPDATE statement. Tables holds the target
+// table, or for MySQL's multi-table UPDATE every table and join being updated.
+// From is PostgreSQL's FROM list; OrderBy and Limit are MySQL extensions.
+type UpdateStatement struct {
+	span
+
+	With        *WithClause
+	Tables      []TableExpression
+	Assignments []*Assignment
+	From        []TableExpression
+	Where       Expression
+	OrderBy     []*OrderByItem
+	Limit       *Limit
+	Returning   []Expression
+}
+
+func (u *UpdateStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "UpdateStatement(%sTables: [%s], Set: [%s]",
+		withString(u.With), joinTableExpressions(u.Tables), joinAssignments(u.Assignments))
+	if len(u.From) > 0 {
+		fmt.Fprintf(&b, ", From: [%s]", joinTableExpressions(u.From))
+	}
+	if u.Where != nil {
+		fmt.Fprintf(&b, ", Where: %s", u.Where.String())
+	}
+	b.WriteString(orderByAndLimitString(u.OrderBy, u.Limit))
+	b.WriteString(returningString(u.Returning))
+	b.WriteString(")")
+	return b.String()
+}
+
+func (u *UpdateStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, u.With)
+	nodes = appendNodes(nodes, u.Tables...)
+	nodes = appendNodes(nodes, u.Assignments...)
+	nodes = appendNodes(nodes, u.From...)
+	nodes = appendNodes(nodes, u.Where)
+	nodes = appendNodes(nodes, u.OrderBy...)
+	nodes = appendNodes(nodes, u.Limit)
+	nodes = appendNodes(nodes, u.Returning...)
+	return nodes
+}
+
+// DeleteStatement represents a parsed DELETE statement. Tables holds the tables
+// rows are deleted from. MySQL's DELETE t1, t2 FROM ... form puts the joined
+// tables in From, and PostgreSQL's and MySQL's USING lists go in Using.
+// CurrentOf is the cursor of a WHERE CURRENT OF clause, which replaces Where.
+type DeleteStatement struct {
+	span
+
+	With      *WithClause
+	Tables    []TableExpression
+	From      []TableExpression
+	Using     []TableExpression
+	Where     Expression
+	CurrentOf *string
+	OrderBy   []*OrderByItem
+	Limit     *Limit
+	Returning []Expression
+}
+
+func (d *DeleteStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "DeleteStatement(%sTables: [%s]", withString(d.With), joinTableExpressions(d.Tables))
+	if len(d.From) > 0 {
+		fmt.Fprintf(&b, ", From: [%s]", joinTableExpressions(d.From))
+	}
+	if len(d.Using) > 0 {
+		fmt.Fprintf(&b, ", Using: [%s]", joinTableExpressions(d.Using))
+	}
+	switch {
+	case d.CurrentOf != nil:
+		fmt.Fprintf(&b, ", Where: CURRENT OF %s", *d.CurrentOf)
+	case d.Where != nil:
+		fmt.Fprintf(&b, ", Where: %s", d.Where.String())
+	}
+	b.WriteString(orderByAndLimitString(d.OrderBy, d.Limit))
+	b.WriteString(returningString(d.Returning))
+	b.WriteString(")")
+	return b.String()
+}
+
+func (d *DeleteStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, d.With)
+	nodes = appendNodes(nodes, d.Tables...)
+	nodes = appendNodes(nodes, d.From...)
+	nodes = appendNodes(nodes, d.Using...)
+	nodes = appendNodes(nodes, d.Where)
+	nodes = appendNodes(nodes, d.OrderBy...)
+	nodes = appendNodes(nodes, d.Limit)
+	nodes = appendNodes(nodes, d.Returning...)
+	return nodes
+}
+
+// MergeStatement represents a parsed MERGE statement, which applies the
+// first matching WHEN clause to each row of Target joined with Source on On.
+type MergeStatement struct {
+	span
+
+	With      *WithClause
+	Target    *TableName
+	Source    TableExpression
+	On        Expression
+	Clauses   []*MergeWhenClause
+	Returning []Expression
+}
+
+func (m *MergeStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "MergeStatement(%sTarget: %s, Using: %s, On: %s",
+		withString(m.With), m.Target.String(), m.Source.String(), m.On.String())
+	for _, clause := range m.Clauses {
+		fmt.Fprintf(&b, ", %s", clause.String())
+	}
+	b.WriteString(returningString(m.Returning))
+	b.WriteString(")")
+	return b.String()
+}
+
+func (m *MergeStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, m.With)
+	nodes = appendNodes(nodes, m.Target)
+	nodes = appendNodes(nodes, m.Source)
+	nodes = appendNodes(nodes, m.On)
+	nodes = appendNodes(nodes, m.Clauses...)
+	nodes = appendNodes(nodes, m.Returning...)
+	return nodes
+}
+
+// MergeMatch is the kind of row a WHEN clause of MERGE applies to.
+type MergeMatch int
+
+const (
+	MergeMatched            MergeMatch = iota // WHEN MATCHED
+	MergeNotMatched                           // WHEN NOT MATCHED [BY TARGET]: source rows without a target row
+	MergeNotMatchedBySource                   // WHEN NOT MATCHED BY SOURCE: target rows without a source row
+)
+
+func (m MergeMatch) String() string {
+	switch m {
+	case MergeMatched:
+		return "MATCHED"
+	case MergeNotMatched:
+		return "NOT MATCHED"
+	case MergeNotMatchedBySource:
+		return "NOT MATCHED BY SOURCE"
+	default:
+		return "unknown_match"
+	}
+}
+
+// MergeAction is the action of a WHEN clause of MERGE.
+type MergeAction int
+
+const (
+	MergeDoNothing MergeAction = iota
+	MergeUpdate
+	MergeDelete
+	MergeInsert
+)
+
+func (a MergeAction) String() string {
+	switch a {
+	case MergeDoNothing:
+		return "DO NOTHING"
+	case MergeUpdate:
+		return "UPDATE"
+	case MergeDelete:
+		return "DELETE"
+	case MergeInsert:
+		return "INSERT"
+	default:
+		return "unknown_action"
+	}
+}
+
+// MergeWhenClause represents WHEN [NOT] MATCHED [BY SOURCE | TARGET] [AND condition] THEN action.
+// Assignments holds the SET list of UPDATE; Columns and Values the column list and
+// VALUES row of INSERT, where DefaultValues is set for INSERT DEFAULT VALUES.
+type MergeWhenClause struct {
+	span
+
+	Match         MergeMatch
+	Condition     Expression
+	Action        MergeAction
+	Assignments   []*Assignment
+	Columns       []string
+	Values        []Expression
+	DefaultValues bool
+}
+
+func (w *MergeWhenClause) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "When(%s", w.Match)
+	if w.Condition != nil {
+		fmt.Fprintf(&b, " AND %s", w.Condition.String())
+	}
+	fmt.Fprintf(&b, " THEN %s", w.Action)
+	switch w.Action {
+	case MergeUpdate:
+		fmt.Fprintf(&b, " SET [%s]", joinAssignments(w.Assignments))
+	case MergeInsert:
+		if len(w.Columns) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(w.Columns, ", "))
+		}
+		if w.DefaultValues {
+			b.WriteString(" DEFAULT VALUES")
+		} else {
+			fmt.Fprintf(&b, " VALUES (%s)", joinExpressions(w.Values))
+		}
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (w *MergeWhenClause) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, w.Condition)
+	nodes = appendNodes(nodes, w.Assignments...)
+	nodes = appendNodes(nodes, w.Values...)
+	return nodes
+}
+
+// IdentityOption is the RESTART IDENTITY or CONTINUE IDENTITY option of TRUNCATE.
+type IdentityOption int
+
+const (
+	IdentityDefault IdentityOption = iota
+	IdentityRestart
+	IdentityContinue
+)
+
+// DropBehavior is the CASCADE or RESTRICT option of statements that remove objects or data.
+type DropBehavior int
+
+const (
+	DropBehaviorDefault DropBehavior = iota
+	DropCascade
+	DropRestrict
+)
+
+func (d DropBehavior) String() string {
+	switch d {
+	case DropBehaviorDefault:
+		return ""
+	case DropCascade:
+		return "CASCADE"
+	case DropRestrict:
+		return "RESTRICT"
+	default:
+		return "unknown_drop_behavior"
+	}
+}
+
+// TruncateStatement represents a parsed TRUNCATE statement. Each of Tables is a *TableName without an alias.
+type TruncateStatement struct {
+	span
+
+	Tables   []TableExpression
+	Identity IdentityOption
+	Behavior DropBehavior
+}
+
+func (t *TruncateStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "TruncateStatement(Tables: [%s]", joinTableExpressions(t.Tables))
+	switch t.Identity {
+	case IdentityRestart:
+		b.WriteString(", RESTART IDENTITY")
+	case IdentityContinue:
+		b.WriteString(", CONTINUE IDENTITY")
+	}
+	if t.Behavior != DropBehaviorDefault {
+		fmt.Fprintf(&b, ", %s", t.Behavior)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (t *TruncateStatement) Children() []Node {
+	return appendNodes(nil, t.Tables...)
+}
+
+// CreateTableStatement represents a parsed CREATE TABLE statement. Elements
+// holds the column definitions, table constraints and LIKE clauses in the
+// order they were written. For CREATE TABLE ... AS, Query holds the query and
+// Elements may name the result columns without types.
+type CreateTableStatement struct {
+	span
+
+	Temporary   bool
+	Unlogged    bool
+	IfNotExists bool
+	Name        string
+	PartitionOf string // the parent table of CREATE TABLE name PARTITION OF parent
+	Elements    []TableElement
+	Bound       *PartitionBound  // the bound of a partition, set with PartitionOf
+	Options     []*UtilityOption // MySQL table options such as ENGINE = InnoDB
+	Inherits    []string
+	PartitionBy *PartitionSpec
+	Query       QueryExpression
+	WithNoData  bool
+}
+
+func (c *CreateTableStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CreateTableStatement(Name: %s", c.Name)
+	if c.Temporary {
+		b.WriteString(", TEMPORARY")
+	}
+	if c.Unlogged {
+		b.WriteString(", UNLOGGED")
+	}
+	if c.IfNotExists {
+		b.WriteString(", IF NOT EXISTS")
+	}
+	if c.PartitionOf != "" {
+		fmt.Fprintf(&b, ", PartitionOf: %s", c.PartitionOf)
+	}
+	if len(c.Elements) > 0 {
+		fmt.Fprintf(&b, ", Elements: [%s]", joinTableElements(c.Elements))
+	}
+	if c.Bound != nil {
+		fmt.Fprintf(&b, ", %s", c.Bound.String())
+	}
+	if len(c.Options) > 0 {
+		fmt.Fprintf(&b, ", Options: [%s]", joinUtilityOptions(c.Options))
+	}
+	if len(c.Inherits) > 0 {
+		fmt.Fprintf(&b, ", Inherits: [%s]", strings.Join(c.Inherits, ", "))
+	}
+	if c.PartitionBy != nil {
+		fmt.Fprintf(&b, ", %s", c.PartitionBy.String())
+	}
+	if c.Query != nil {
+		fmt.Fprintf(&b, ", Query: %s", c.Query.String())
+	}
+	if c.WithNoData {
+		b.WriteString(", WITH NO DATA")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateTableStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Elements...)
+	nodes = appendNodes(nodes, c.Bound)
+	nodes = appendNodes(nodes, c.Options...)
+	nodes = appendNodes(nodes, c.PartitionBy)
+	nodes = appendNodes(nodes, c.Query)
+	return nodes
+}
+
+// TableElement is an item of the parenthesized list of CREATE TABLE: a
+// ColumnDefinition, a TableConstraint, a TableLikeClause or an IndexDefinition.
+type TableElement interface {
+	Node
+	tableElement()
+}
+
+// ColumnDefinition represents a column of CREATE TABLE or ALTER TABLE ADD COLUMN.
+// Type is nil for the bare column names of CREATE TABLE ... AS.
+type ColumnDefinition struct {
+	span
+
+	Name        string
+	Type        *DataType
+	Collation   *string
+	Constraints []*ColumnConstraint
+}
+
+func (c *ColumnDefinition) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ColumnDefinition(%s", c.Name)
+	if c.Type != nil {
+		fmt.Fprintf(&b, " %s", c.Type.String())
+	}
+	if c.Collation != nil {
+		fmt.Fprintf(&b, " COLLATE %s", *c.Collation)
+	}
+	for _, constraint := range c.Constraints {
+		fmt.Fprintf(&b, " %s", constraint.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *ColumnDefinition) tableElement() {}
+
+func (c *ColumnDefinition) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Type)
+	nodes = appendNodes(nodes, c.Constraints...)
+	return nodes
+}
+
+// ColumnConstraintKind is the kind of a column constraint.
+type ColumnConstraintKind int
+
+const (
+	ConstraintNotNull ColumnConstraintKind = iota
+	ConstraintNull
+	ConstraintDefault
+	ConstraintCheck
+	ConstraintPrimaryKey
+	ConstraintUnique
+	ConstraintReferences
+	ConstraintGenerated     // GENERATED ALWAYS AS (expr) STORED
+	ConstraintIdentity      // GENERATED {ALWAYS | BY DEFAULT} AS IDENTITY
+	ConstraintAutoIncrement // MySQL AUTO_INCREMENT
+)
+
+func (k ColumnConstraintKind) String() string {
+	switch k {
+	case ConstraintNotNull:
+		return "NOT NULL"
+	case ConstraintNull:
+		return "NULL"
+	case ConstraintDefault:
+		return "DEFAULT"
+	case ConstraintCheck:
+		return "CHECK"
+	case ConstraintPrimaryKey:
+		return "PRIMARY KEY"
+	case ConstraintUnique:
+		return "UNIQUE"
+	case ConstraintReferences:
+		return "REFERENCES"
+	case ConstraintGenerated:
+		return "GENERATED"
+	case ConstraintIdentity:
+		return "IDENTITY"
+	case ConstraintAutoIncrement:
+		return "AUTO_INCREMENT"
+	default:
+		return "unknown_constraint"
+	}
+}
+
+// GeneratedKind records whether a generated column is GENERATED ALWAYS or GENERATED BY DEFAULT.
+type GeneratedKind int
+
+const (
+	GeneratedAlways GeneratedKind = iota
+	GeneratedByDefault
+)
+
+// ColumnConstraint represents a constraint or attribute of a column definition.
+// Expression holds the value of DEFAULT, the condition of CHECK and the
+// expression of a stored generated column. Deferrable and InitiallyDeferred
+// are nil unless [NOT] DEFERRABLE or INITIALLY {DEFERRED | IMMEDIATE} was written.
+type ColumnConstraint struct {
+	span
+
+	Name              *string
+	Kind              ColumnConstraintKind
+	NullsNotDistinct  bool // UNIQUE NULLS NOT DISTINCT
+	Expression        Expression
+	References        *ForeignKeyReference
+	Generated         GeneratedKind
+	IdentityOptions   []*SequenceOption
+	Deferrable        *bool
+	InitiallyDeferred *bool
+}
+
+func (c *ColumnConstraint) String() string {
+	var b strings.Builder
+	b.WriteString("ColumnConstraint(")
+	if c.Name != nil {
+		fmt.Fprintf(&b, "CONSTRAINT %s ", *c.Name)
+	}
+	switch c.Kind {
+	case ConstraintDefault:
+		fmt.Fprintf(&b, "DEFAULT %s", c.Expression.String())
+	case ConstraintCheck:
+		fmt.Fprintf(&b, "CHECK %s", c.Expression.String())
+	case ConstraintReferences:
+		fmt.Fprintf(&b, "REFERENCES %s", c.References.String())
+	case ConstraintGenerated:
+		fmt.Fprintf(&b, "GENERATED ALWAYS AS %s STORED", c.Expression.String())
+	case ConstraintIdentity:
+		if c.Generated == GeneratedByDefault {
+			b.WriteString("GENERATED BY DEFAULT AS IDENTITY")
+		} else {
+			b.WriteString("GENERATED ALWAYS AS IDENTITY")
+		}
+		if len(c.IdentityOptions) > 0 {
+			fmt.Fprintf(&b, " [%s]", joinSequenceOptions(c.IdentityOptions))
+		}
+	default:
+		b.WriteString(c.Kind.String())
+	}
+	if c.NullsNotDistinct {
+		b.WriteString(" NULLS NOT DISTINCT")
+	}
+	b.WriteString(deferrableString(c.Deferrable, c.InitiallyDeferred))
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *ColumnConstraint) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Expression)
+	nodes = appendNodes(nodes, c.References)
+	nodes = appendNodes(nodes, c.IdentityOptions...)
+	return nodes
+}
+
+// SequenceOption represents an option of a sequence or identity column, such as
+// START WITH 1 or NO CYCLE. Name is the upper-cased option without its noise
+// words, for example "START", "INCREMENT", "NO MAXVALUE", "OWNED BY" or "CYCLE".
+// Value is nil for options that take no value, and Type is set only for AS type.
+type SequenceOption struct {
+	span
+
+	Name  string
+	Value Expression
+	Type  *DataType
+}
+
+func (s *SequenceOption) String() string {
+	switch {
+	case s.Type != nil:
+		return fmt.Sprintf("SequenceOption(%s %s)", s.Name, s.Type.String())
+	case s.Value != nil:
+		return fmt.Sprintf("SequenceOption(%s %s)", s.Name, s.Value.String())
+	default:
+		return fmt.Sprintf("SequenceOption(%s)", s.Name)
+	}
+}
+
+func (s *SequenceOption) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, s.Type)
+	nodes = appendNodes(nodes, s.Value)
+	return nodes
+}
+
+// ReferentialAction is the action of an ON DELETE or ON UPDATE clause of a foreign key.
+type ReferentialAction int
+
+const (
+	ActionDefault ReferentialAction = iota // no action written
+	ActionNoAction
+	ActionRestrict
+	ActionCascade
+	ActionSetNull
+	ActionSetDefault
+)
+
+func (a ReferentialAction) String() string {
+	switch a {
+	case ActionDefault:
+		return ""
+	case ActionNoAction:
+		return "NO ACTION"
+	case ActionRestrict:
+		return "RESTRICT"
+	case ActionCascade:
+		return "CASCADE"
+	case ActionSetNull:
+		return "SET NULL"
+	case ActionSetDefault:
+		return "SET DEFAULT"
+	default:
+		return "unknown_action"
+	}
+}
+
+// ForeignKeyReference represents the REFERENCES part of a foreign key.
+type ForeignKeyReference struct {
+	span
+
+	Table    string
+	Columns  []string
+	Match    string // FULL, PARTIAL or SIMPLE, or "" if not written
+	OnDelete ReferentialAction
+	OnUpdate ReferentialAction
+}
+
+func (f *ForeignKeyReference) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ForeignKeyReference(%s", f.Table)
+	if len(f.Columns) > 0 {
+		fmt.Fprintf(&b, " [%s]", strings.Join(f.Columns, ", "))
+	}
+	if f.Match != "" {
+		fmt.Fprintf(&b, " MATCH %s", f.Match)
+	}
+	if f.OnDelete != ActionDefault {
+		fmt.Fprintf(&b, " ON DELETE %s", f.OnDelete)
+	}
+	if f.OnUpdate != ActionDefault {
+		fmt.Fprintf(&b, " ON UPDATE %s", f.OnUpdate)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (f *ForeignKeyReference) Children() []Node {
+	return nil
+}
+
+// TableConstraintKind is the kind of a table constraint.
+type TableConstraintKind int
+
+const (
+	TableConstraintPrimaryKey TableConstraintKind = iota
+	TableConstraintUnique
+	TableConstraintForeignKey
+	TableConstraintCheck
+	TableConstraintExclude
+)
+
+func (k TableConstraintKind) String() string {
+	switch k {
+	case TableConstraintPrimaryKey:
+		return "PRIMARY KEY"
+	case TableConstraintUnique:
+		return "UNIQUE"
+	case TableConstraintForeignKey:
+		return "FOREIGN KEY"
+	case TableConstraintCheck:
+		return "CHECK"
+	case TableConstraintExclude:
+		return "EXCLUDE"
+	default:
+		return "unknown_constraint"
+	}
+}
+
+// TableConstraint represents a table-level constraint. Columns holds the key
+// columns of PRIMARY KEY, UNIQUE and FOREIGN KEY; Check the condition of CHECK;
+// and IndexMethod, Exclusions and Where the parts of EXCLUDE. Deferrable and
+// InitiallyDeferred are as for ColumnConstraint.
+type TableConstraint struct {
+	span
+
+	Name              *string
+	Kind              TableConstraintKind
+	NullsNotDistinct  bool // UNIQUE NULLS NOT DISTINCT
+	Columns           []string
+	Check             Expression
+	References        *ForeignKeyReference
+	IndexMethod       *string
+	Exclusions        []*ExclusionElement
+	Where             Expression
+	Deferrable        *bool
+	InitiallyDeferred *bool
+}
+
+func (t *TableConstraint) String() string {
+	var b strings.Builder
+	b.WriteString("TableConstraint(")
+	if t.Name != nil {
+		fmt.Fprintf(&b, "CONSTRAINT %s ", *t.Name)
+	}
+	b.WriteString(t.Kind.String())
+	if t.NullsNotDistinct {
+		b.WriteString(" NULLS NOT DISTINCT")
+	}
+	switch t.Kind {
+	case TableConstraintCheck:
+		fmt.Fprintf(&b, " %s", t.Check.String())
+	case TableConstraintExclude:
+		if t.IndexMethod != nil {
+			fmt.Fprintf(&b, " USING %s", *t.IndexMethod)
+		}
+		exclusions := make([]string, len(t.Exclusions))
+		for i, exclusion := range t.Exclusions {
+			exclusions[i] = exclusion.String()
+		}
+		fmt.Fprintf(&b, " [%s]", strings.Join(exclusions, ", "))
+		if t.Where != nil {
+			fmt.Fprintf(&b, " WHERE %s", t.Where.String())
+		}
+	default:
+		fmt.Fprintf(&b, " [%s]", strings.Join(t.Columns, ", "))
+	}
+	if t.References != nil {
+		fmt.Fprintf(&b, " REFERENCES %s", t.References.String())
+	}
+	b.WriteString(deferrableString(t.Deferrable, t.InitiallyDeferred))
+	b.WriteString(")")
+	return b.String()
+}
+
+func (t *TableConstraint) tableElement() {}
+
+func (t *TableConstraint) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, t.Exclusions...)
+	nodes = appendNodes(nodes, t.Check)
+	nodes = appendNodes(nodes, t.References)
+	nodes = appendNodes(nodes, t.Where)
+	return nodes
+}
+
+// ExclusionElement represents expression WITH operator in an EXCLUDE constraint.
+type ExclusionElement struct {
+	span
+
+	Expression Expression
+	Operator   string
+}
+
+func (e *ExclusionElement) String() string {
+	return fmt.Sprintf("ExclusionElement(%s WITH %s)", e.Expression.String(), e.Operator)
+}
+
+func (e *ExclusionElement) Children() []Node {
+	return appendNodes(nil, e.Expression)
+}
+
+// TableLikeClause represents LIKE source_table [INCLUDING | EXCLUDING option ...] in CREATE TABLE.
+// Options holds each option upper-cased, such as "INCLUDING DEFAULTS".
+type TableLikeClause struct {
+	span
+
+	Table   string
+	Options []string
+}
+
+func (t *TableLikeClause) String() string {
+	if len(t.Options) == 0 {
+		return fmt.Sprintf("Like(%s)", t.Table)
+	}
+	return fmt.Sprintf("Like(%s %s)", t.Table, strings.Join(t.Options, " "))
+}
+
+func (t *TableLikeClause) tableElement() {}
+
+func (t *TableLikeClause) Children() []Node {
+	return nil
+}
+
+// IndexDefinition represents a MySQL {INDEX | KEY} [name] [USING method] (columns)
+// element of CREATE TABLE or action of ALTER TABLE ADD, which creates a plain index
+// on the table.
+type IndexDefinition struct {
+	span
+
+	Name    *string
+	Method  *string
+	Columns []string
+}
+
+func (i *IndexDefinition) String() string {
+	var b strings.Builder
+	b.WriteString("IndexDefinition(")
+	if i.Name != nil {
+		fmt.Fprintf(&b, "%s ", *i.Name)
+	}
+	if i.Method != nil {
+		fmt.Fprintf(&b, "USING %s ", *i.Method)
+	}
+	fmt.Fprintf(&b, "[%s])", strings.Join(i.Columns, ", "))
+	return b.String()
+}
+
+func (i *IndexDefinition) tableElement() {}
+
+func (i *IndexDefinition) Children() []Node {
+	return nil
+}
+
+// PartitionSpec represents PARTITION BY {RANGE | LIST | HASH} (key, ...).
+type PartitionSpec struct {
+	span
+
+	Strategy string
+	Keys     []Expression
+}
+
+func (p *PartitionSpec) String() string {
+	return fmt.Sprintf("PartitionBy(%s [%s])", p.Strategy, joinExpressions(p.Keys))
+}
+
+func (p *PartitionSpec) Children() []Node {
+	return appendNodes(nil, p.Keys...)
+}
+
+// AlterTableStatement represents a parsed ALTER TABLE statement with one or more actions.
+type AlterTableStatement struct {
+	span
+
+	IfExists bool
+	Only     bool
+	Name     string
+	Actions  []*AlterTableAction
+}
+
+func (a *AlterTableStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "AlterTableStatement(Name: %s", a.Name)
+	if a.IfExists {
+		b.WriteString(", IF EXISTS")
+	}
+	if a.Only {
+		b.WriteString(", ONLY")
+	}
+	actions := make([]string, len(a.Actions))
+	for i, action := range a.Actions {
+		actions[i] = action.String()
+	}
+	fmt.Fprintf(&b, ", Actions: [%s])", strings.Join(actions, ", "))
+	return b.String()
+}
+
+func (a *AlterTableStatement) Children() []Node {
+	return appendNodes(nil, a.Actions...)
+}
+
+// AlterTableActionKind is the kind of an ALTER TABLE action.
+type AlterTableActionKind int
+
+const (
+	AlterAddColumn          AlterTableActionKind = iota // ADD [COLUMN] definition
+	AlterDropColumn                                     // DROP [COLUMN] name
+	AlterRenameColumn                                   // RENAME [COLUMN] name TO new_name
+	AlterColumnType                                     // ALTER [COLUMN] name [SET DATA] TYPE type [USING expr]
+	AlterColumnSetDefault                               // ALTER [COLUMN] name SET DEFAULT expr
+	AlterColumnDropDefault                              // ALTER [COLUMN] name DROP DEFAULT
+	AlterColumnSetNotNull                               // ALTER [COLUMN] name SET NOT NULL
+	AlterColumnDropNotNull                              // ALTER [COLUMN] name DROP NOT NULL
+	AlterAddConstraint                                  // ADD table_constraint [NOT VALID]
+	AlterDropConstraint                                 // DROP CONSTRAINT name
+	AlterValidateConstraint                             // VALIDATE CONSTRAINT name
+	AlterRenameConstraint                               // RENAME CONSTRAINT name TO new_name
+	AlterRenameTable                                    // RENAME TO new_name
+	AlterSetSchema                                      // SET SCHEMA schema
+	AlterOwnerTo                                        // OWNER TO role
+	AlterAttachPartition                                // ATTACH PARTITION name {FOR VALUES ... | DEFAULT}
+	AlterDetachPartition                                // DETACH PARTITION name
+	AlterModifyColumn                                   // MySQL MODIFY [COLUMN] definition
+	AlterChangeColumn                                   // MySQL CHANGE [COLUMN] name definition
+	AlterAddIndex                                       // MySQL ADD {INDEX | KEY} [name] (columns)
+)
+
+// AlterTableAction represents one action of an ALTER TABLE statement. Which
+// fields are set depends on Kind: Column for the actions that take a column
+// definition, Name for the column, constraint or partition acted on, NewName
+// for renames and the target of SET SCHEMA and OWNER TO, and Index for ADD INDEX.
+type AlterTableAction struct {
+	span
+
+	Kind        AlterTableActionKind
+	Name        string
+	NewName     string
+	Column      *ColumnDefinition
+	Type        *DataType
+	Using       Expression
+	Default     Expression
+	Constraint  *TableConstraint
+	Index       *IndexDefinition
+	NotValid    bool
+	IfExists    bool
+	IfNotExists bool
+	Behavior    DropBehavior
+	Bound       *PartitionBound
+	First       bool    // MySQL FIRST column position
+	After       *string // MySQL AFTER column position
+	Concurrent  bool    // DETACH PARTITION ... CONCURRENTLY
+	Finalize    bool    // DETACH PARTITION ... FINALIZE
+}
+
+func (a *AlterTableAction) String() string {
+	var b strings.Builder
+	b.WriteString("AlterTableAction(")
+	switch a.Kind {
+	case AlterAddColumn:
+		b.WriteString("ADD COLUMN ")
+		if a.IfNotExists {
+			b.WriteString("IF NOT EXISTS ")
+		}
+		b.WriteString(a.Column.String())
+	case AlterDropColumn:
+		b.WriteString("DROP COLUMN ")
+		if a.IfExists {
+			b.WriteString("IF EXISTS ")
+		}
+		b.WriteString(a.Name)
+	case AlterRenameColumn:
+		fmt.Fprintf(&b, "RENAME COLUMN %s TO %s", a.Name, a.NewName)
+	case AlterColumnType:
+		fmt.Fprintf(&b, "ALTER COLUMN %s TYPE %s", a.Name, a.Type.String())
+		if a.Using != nil {
+			fmt.Fprintf(&b, " USING %s", a.Using.String())
+		}
+	case AlterColumnSetDefault:
+		fmt.Fprintf(&b, "ALTER COLUMN %s SET DEFAULT %s", a.Name, a.Default.String())
+	case AlterColumnDropDefault:
+		fmt.Fprintf(&b, "ALTER COLUMN %s DROP DEFAULT", a.Name)
+	case AlterColumnSetNotNull:
+		fmt.Fprintf(&b, "ALTER COLUMN %s SET NOT NULL", a.Name)
+	case AlterColumnDropNotNull:
+		fmt.Fprintf(&b, "ALTER COLUMN %s DROP NOT NULL", a.Name)
+	case AlterAddConstraint:
+		fmt.Fprintf(&b, "ADD %s", a.Constraint.String())
+		if a.NotValid {
+			b.WriteString(" NOT VALID")
+		}
+	case AlterDropConstraint:
+		b.WriteString("DROP CONSTRAINT ")
+		if a.IfExists {
+			b.WriteString("IF EXISTS ")
+		}
+		b.WriteString(a.Name)
+	case AlterValidateConstraint:
+		fmt.Fprintf(&b, "VALIDATE CONSTRAINT %s", a.Name)
+	case AlterRenameConstraint:
+		fmt.Fprintf(&b, "RENAME CONSTRAINT %s TO %s", a.Name, a.NewName)
+	case AlterRenameTable:
+		fmt.Fprintf(&b, "RENAME TO %s", a.NewName)
+	case AlterSetSchema:
+		fmt.Fprintf(&b, "SET SCHEMA %s", a.NewName)
+	case AlterOwnerTo:
+		fmt.Fprintf(&b, "OWNER TO %s", a.NewName)
+	case AlterAttachPartition:
+		fmt.Fprintf(&b, "ATTACH PARTITION %s %s", a.Name, a.Bound.String())
+	case AlterDetachPartition:
+		fmt.Fprintf(&b, "DETACH PARTITION %s", a.Name)
+		if a.Concurrent {
+			b.WriteString(" CONCURRENTLY")
+		}
+		if a.Finalize {
+			b.WriteString(" FINALIZE")
+		}
+	case AlterModifyColumn:
+		fmt.Fprintf(&b, "MODIFY COLUMN %s", a.Column.String())
+	case AlterChangeColumn:
+		fmt.Fprintf(&b, "CHANGE COLUMN %s %s", a.Name, a.Column.String())
+	case AlterAddIndex:
+		fmt.Fprintf(&b, "ADD %s", a.Index.String())
+	default:
+		b.WriteString("unknown_action")
+	}
+	if a.Behavior != DropBehaviorDefault {
+		fmt.Fprintf(&b, " %s", a.Behavior)
+	}
+	switch {
+	case a.First:
+		b.WriteString(" FIRST")
+	case a.After != nil:
+		fmt.Fprintf(&b, " AFTER %s", *a.After)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (a *AlterTableAction) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, a.Column)
+	nodes = appendNodes(nodes, a.Type)
+	nodes = appendNodes(nodes, a.Using, a.Default)
+	nodes = appendNodes(nodes, a.Constraint)
+	nodes = appendNodes(nodes, a.Index)
+	nodes = appendNodes(nodes, a.Bound)
+	return nodes
+}
+
+// PartitionBound represents the bound of a partition: DEFAULT, or FOR VALUES
+// followed by FROM (...) TO (...), IN (...) or WITH (MODULUS m, REMAINDER r).
+type PartitionBound struct {
+	span
+
+	Default   bool
+	From      []Expression
+	To        []Expression
+	In        []Expression
+	Modulus   Expression
+	Remainder Expression
+}
+
+func (p *PartitionBound) String() string {
+	switch {
+	case p.Default:
+		return "PartitionBound(DEFAULT)"
+	case p.In != nil:
+		return fmt.Sprintf("PartitionBound(IN [%s])", joinExpressions(p.In))
+	case p.Modulus != nil:
+		return fmt.Sprintf("PartitionBound(MODULUS %s, REMAINDER %s)", p.Modulus.String(), p.Remainder.String())
+	default:
+		return fmt.Sprintf("PartitionBound(FROM [%s] TO [%s])", joinExpressions(p.From), joinExpressions(p.To))
+	}
+}
+
+func (p *PartitionBound) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, p.In...)
+	nodes = appendNodes(nodes, p.From...)
+	nodes = appendNodes(nodes, p.To...)
+	nodes = appendNodes(nodes, p.Modulus, p.Remainder)
+	return nodes
+}
+
+// CreateIndexStatement represents a parsed CREATE INDEX statement. Name is nil
+// when the index name is left to the database, and Where is the predicate of a
+// partial index.
+type CreateIndexStatement struct {
+	span
+
+	Unique       bool
+	Concurrently bool
+	IfNotExists  bool
+	Name         *string
+	Only         bool
+	Table        string
+	Method       *string
+	Elements     []*IndexElement
+	Include      []string
+	Where        Expression
+}
+
+func (c *CreateIndexStatement) String() string {
+	var b strings.Builder
+	b.WriteString("CreateIndexStatement(")
+	if c.Unique {
+		b.WriteString("UNIQUE, ")
+	}
+	if c.Concurrently {
+		b.WriteString("CONCURRENTLY, ")
+	}
+	if c.IfNotExists {
+		b.WriteString("IF NOT EXISTS, ")
+	}
+	if c.Name != nil {
+		fmt.Fprintf(&b, "Name: %s, ", *c.Name)
+	}
+	b.WriteString("Table: ")
+	if c.Only {
+		b.WriteString("ONLY ")
+	}
+	b.WriteString(c.Table)
+	if c.Method != nil {
+		fmt.Fprintf(&b, ", Using: %s", *c.Method)
+	}
+	elements := make([]string, len(c.Elements))
+	for i, element := range c.Elements {
+		elements[i] = element.String()
+	}
+	fmt.Fprintf(&b, ", Elements: [%s]", strings.Join(elements, ", "))
+	if len(c.Include) > 0 {
+		fmt.Fprintf(&b, ", Include: [%s]", strings.Join(c.Include, ", "))
+	}
+	if c.Where != nil {
+		fmt.Fprintf(&b, ", Where: %s", c.Where.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateIndexStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Elements...)
+	nodes = appendNodes(nodes, c.Where)
+	return nodes
+}
+
+// IndexElement represents a column or expression of an index with its collation,
+// operator class and sort options.
+type IndexElement struct {
+	span
+
+	Expression Expression
+	Collation  *string
+	OpClass    *string
+	Direction  SortDirection
+	Nulls      NullsOrder
+}
+
+func (i *IndexElement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "IndexElement(%s", i.Expression.String())
+	if i.Collation != nil {
+		fmt.Fprintf(&b, " COLLATE %s", *i.Collation)
+	}
+	if i.OpClass != nil {
+		fmt.Fprintf(&b, " %s", *i.OpClass)
+	}
+	switch i.Direction {
+	case SortAsc:
+		b.WriteString(" ASC")
+	case SortDesc:
+		b.WriteString(" DESC")
+	}
+	switch i.Nulls {
+	case NullsFirst:
+		b.WriteString(" NULLS FIRST")
+	case NullsLast:
+		b.WriteString(" NULLS LAST")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (i *IndexElement) Children() []Node {
+	return appendNodes(nil, i.Expression)
+}
+
+// CreateViewStatement represents CREATE [OR REPLACE] [TEMPORARY] [MATERIALIZED] VIEW.
+// CheckOption is "CASCADED" or "LOCAL" for WITH CHECK OPTION, or "" if absent;
+// WithNoData applies to materialized views only.
+type CreateViewStatement struct {
+	span
+
+	OrReplace    bool
+	Temporary    bool
+	Materialized bool
+	IfNotExists  bool
+	Name         string
+	Columns      []string
+	Query        QueryExpression
+	CheckOption  string
+	WithNoData   bool
+}
+
+func (c *CreateViewStatement) String() string {
+	var b strings.Builder
+	b.WriteString("CreateViewStatement(")
+	if c.OrReplace {
+		b.WriteString("OR REPLACE, ")
+	}
+	if c.Temporary {
+		b.WriteString("TEMPORARY, ")
+	}
+	if c.Materialized {
+		b.WriteString("MATERIALIZED, ")
+	}
+	if c.IfNotExists {
+		b.WriteString("IF NOT EXISTS, ")
+	}
+	fmt.Fprintf(&b, "Name: %s", c.Name)
+	if len(c.Columns) > 0 {
+		fmt.Fprintf(&b, ", Columns: [%s]", strings.Join(c.Columns, ", "))
+	}
+	fmt.Fprintf(&b, ", Query: %s", c.Query.String())
+	if c.CheckOption != "" {
+		fmt.Fprintf(&b, ", WITH %s CHECK OPTION", c.CheckOption)
+	}
+	if c.WithNoData {
+		b.WriteString(", WITH NO DATA")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateViewStatement) Children() []Node {
+	return appendNodes(nil, c.Query)
+}
+
+// RefreshMaterializedViewStatement represents REFRESH MATERIALIZED VIEW [CONCURRENTLY] name [WITH [NO] DATA].
+type RefreshMaterializedViewStatement struct {
+	span
+
+	Concurrently bool
+	Name         string
+	WithNoData   bool
+}
+
+func (r *RefreshMaterializedViewStatement) String() string {
+	var b strings.Builder
+	b.WriteString("RefreshMaterializedViewStatement(")
+	if r.Concurrently {
+		b.WriteString("CONCURRENTLY, ")
+	}
+	fmt.Fprintf(&b, "Name: %s", r.Name)
+	if r.WithNoData {
+		b.WriteString(", WITH NO DATA")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (r *RefreshMaterializedViewStatement) Children() []Node {
+	return nil
+}
+
+// CreateSchemaStatement represents CREATE SCHEMA [IF NOT EXISTS] [name] [AUTHORIZATION role].
+// Name is empty when only AUTHORIZATION is given.
+type CreateSchemaStatement struct {
+	span
+
+	IfNotExists   bool
+	Name          string
+	Authorization *string
+}
+
+func (c *CreateSchemaStatement) String() string {
+	var b strings.Builder
+	b.WriteString("CreateSchemaStatement(")
+	if c.IfNotExists {
+		b.WriteString("IF NOT EXISTS, ")
+	}
+	fmt.Fprintf(&b, "Name: %s", c.Name)
+	if c.Authorization != nil {
+		fmt.Fprintf(&b, ", Authorization: %s", *c.Authorization)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateSchemaStatement) Children() []Node {
+	return nil
+}
+
+// CreateSequenceStatement represents CREATE [TEMPORARY] SEQUENCE [IF NOT EXISTS] name [options].
+type CreateSequenceStatement struct {
+	span
+
+	Temporary   bool
+	IfNotExists bool
+	Name        string
+	Options     []*SequenceOption
+}
+
+func (c *CreateSequenceStatement) String() string {
+	var b strings.Builder
+	b.WriteString("CreateSequenceStatement(")
+	if c.Temporary {
+		b.WriteString("TEMPORARY, ")
+	}
+	if c.IfNotExists {
+		b.WriteString("IF NOT EXISTS, ")
+	}
+	fmt.Fprintf(&b, "Name: %s", c.Name)
+	if len(c.Options) > 0 {
+		fmt.Fprintf(&b, ", Options: [%s]", joinSequenceOptions(c.Options))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateSequenceStatement) Children() []Node {
+	return appendNodes(nil, c.Options...)
+}
+
+// AlterSequenceStatement represents ALTER SEQUENCE [IF EXISTS] name options.
+type AlterSequenceStatement struct {
+	span
+
+	IfExists bool
+	Name     string
+	Options  []*SequenceOption
+}
+
+func (a *AlterSequenceStatement) String() string {
+	var b strings.Builder
+	b.WriteString("AlterSequenceStatement(")
+	if a.IfExists {
+		b.WriteString("IF EXISTS, ")
+	}
+	fmt.Fprintf(&b, "Name: %s, Options: [%s])", a.Name, joinSequenceOptions(a.Options))
+	return b.String()
+}
+
+func (a *AlterSequenceStatement) Children() []Node {
+	return appendNodes(nil, a.Options...)
+}
+
+// DropStatement represents DROP of any kind of object. ObjectKind is the
+// upper-cased kind as written, such as "TABLE" or "MATERIALIZED VIEW".
+// Signatures holds the parameter list written after each name of a dropped
+// function or procedure, or nil if none was written. On is the table of a
+// dropped trigger or policy. Hosts holds the hosts of the MySQL accounts of
+// DROP USER, parallel to Names, or nil if no account names one.
+type DropStatement struct {
+	span
+
+	ObjectKind   string
+	Concurrently bool
+	IfExists     bool
+	Names        []string
+	Hosts        []*string
+	Signatures   [][]*FunctionParameter
+	On           *string
+	Behavior     DropBehavior
+}
+
+func (d *DropStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "DropStatement(%s", d.ObjectKind)
+	if d.Concurrently {
+		b.WriteString(", CONCURRENTLY")
+	}
+	if d.IfExists {
+		b.WriteString(", IF EXISTS")
+	}
+	if d.Hosts != nil {
+		fmt.Fprintf(&b, ", Names: [%s]", joinGrantees(d.Names, d.Hosts))
+	} else {
+		fmt.Fprintf(&b, ", Names: [%s]", joinObjectNames(d.Names, d.Signatures))
+	}
+	if d.On != nil {
+		fmt.Fprintf(&b, ", On: %s", *d.On)
+	}
+	if d.Behavior != DropBehaviorDefault {
+		fmt.Fprintf(&b, ", %s", d.Behavior)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (d *DropStatement) Children() []Node {
+	var nodes []Node
+	for _, signature := range d.Signatures {
+		nodes = appendNodes(nodes, signature...)
+	}
+	return nodes
+}
+
+// CreateFunctionStatement represents CREATE [OR REPLACE] FUNCTION or PROCEDURE.
+// Returns is nil for procedures and for functions declared RETURNS TABLE, whose
+// result columns are in ReturnsTable. Body is the unquoted text of the AS
+// string, usually dollar-quoted, and Return the expression of a RETURN body.
+// Attributes holds the remaining options upper-cased as written, such as
+// "STRICT", "SECURITY DEFINER" or "PARALLEL SAFE".
+type CreateFunctionStatement struct {
+	span
+
+	OrReplace    bool
+	Procedure    bool
+	Name         string
+	Parameters   []*FunctionParameter
+	Returns      *DataType
+	ReturnsSetOf bool
+	ReturnsTable []*ColumnDefinition
+	Language     string
+	Volatility   string
+	Attributes   []string
+	Body         *string
+	LinkSymbol   *string
+	Return       Expression
+}
+
+func (c *CreateFunctionStatement) String() string {
+	var b strings.Builder
+	if c.Procedure {
+		b.WriteString("CreateProcedureStatement(")
+	} else {
+		b.WriteString("CreateFunctionStatement(")
+	}
+	if c.OrReplace {
+		b.WriteString("OR REPLACE, ")
+	}
+	fmt.Fprintf(&b, "Name: %s, Parameters: [%s]", c.Name, joinFunctionParameters(c.Parameters))
+	switch {
+	case c.Returns != nil && c.ReturnsSetOf:
+		fmt.Fprintf(&b, ", Returns: SETOF %s", c.Returns.String())
+	case c.Returns != nil:
+		fmt.Fprintf(&b, ", Returns: %s", c.Returns.String())
+	case len(c.ReturnsTable) > 0:
+		columns := make([]string, len(c.ReturnsTable))
+		for i, column := range c.ReturnsTable {
+			columns[i] = column.String()
+		}
+		fmt.Fprintf(&b, ", Returns: TABLE [%s]", strings.Join(columns, ", "))
+	}
+	if c.Language != "" {
+		fmt.Fprintf(&b, ", Language: %s", c.Language)
+	}
+	if c.Volatility != "" {
+		fmt.Fprintf(&b, ", %s", c.Volatility)
+	}
+	for _, attribute := range c.Attributes {
+		fmt.Fprintf(&b, ", %s", attribute)
+	}
+	if c.Body != nil {
+		fmt.Fprintf(&b, ", Body: %q", *c.Body)
+	}
+	if c.LinkSymbol != nil {
+		fmt.Fprintf(&b, ", LinkSymbol: %q", *c.LinkSymbol)
+	}
+	if c.Return != nil {
+		fmt.Fprintf(&b, ", Return: %s", c.Return.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateFunctionStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Parameters...)
+	nodes = appendNodes(nodes, c.Returns)
+	nodes = appendNodes(nodes, c.ReturnsTable...)
+	nodes = appendNodes(nodes, c.Return)
+	return nodes
+}
+
+// ParameterMode is the mode of a function parameter.
+type ParameterMode int
+
+const (
+	ParameterModeDefault ParameterMode = iota // no mode written, which means IN
+	ParameterModeIn
+	ParameterModeOut
+	ParameterModeInOut
+	ParameterModeVariadic
+)
+
+func (m ParameterMode) String() string {
+	switch m {
+	case ParameterModeDefault:
+		return ""
+	case ParameterModeIn:
+		return "IN"
+	case ParameterModeOut:
+		return "OUT"
+	case ParameterModeInOut:
+		return "INOUT"
+	case ParameterModeVariadic:
+		return "VARIADIC"
+	default:
+		return "unknown_mode"
+	}
+}
+
+// FunctionParameter represents a parameter of a function or procedure signature.
+type FunctionParameter struct {
+	span
+
+	Mode    ParameterMode
+	Name    *string
+	Type    *DataType
+	Default Expression
+}
+
+func (f *FunctionParameter) String() string {
+	var b strings.Builder
+	b.WriteString("FunctionParameter(")
+	if f.Mode != ParameterModeDefault {
+		fmt.Fprintf(&b, "%s ", f.Mode)
+	}
+	if f.Name != nil {
+		fmt.Fprintf(&b, "%s ", *f.Name)
+	}
+	b.WriteString(f.Type.String())
+	if f.Default != nil {
+		fmt.Fprintf(&b, " DEFAULT %s", f.Default.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (f *FunctionParameter) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, f.Type)
+	nodes = appendNodes(nodes, f.Default)
+	return nodes
+}
+
+// CreateTriggerStatement represents CREATE [OR REPLACE] [CONSTRAINT] TRIGGER.
+// Timing is "BEFORE", "AFTER" or "INSTEAD OF". From and the deferrability
+// apply to constraint triggers; OldTable and NewTable are the transition
+// relations named by REFERENCING.
+type CreateTriggerStatement struct {
+	span
+
+	OrReplace         bool
+	Constraint        bool
+	Name              string
+	Timing            string
+	Events            []*TriggerEvent
+	Table             string
+	From              string
+	Deferrable        *bool
+	InitiallyDeferred *bool
+	OldTable          string
+	NewTable          string
+	ForEachRow        bool
+	When              Expression
+	Function          string
+	Arguments         []Expression
+}
+
+func (c *CreateTriggerStatement) String() string {
+	var b strings.Builder
+	b.WriteString("CreateTriggerStatement(")
+	if c.OrReplace {
+		b.WriteString("OR REPLACE, ")
+	}
+	if c.Constraint {
+		b.WriteString("CONSTRAINT, ")
+	}
+	events := make([]string, len(c.Events))
+	for i, event := range c.Events {
+		events[i] = event.String()
+	}
+	fmt.Fprintf(&b, "Name: %s, %s [%s], Table: %s", c.Name, c.Timing, strings.Join(events, ", "), c.Table)
+	if c.From != "" {
+		fmt.Fprintf(&b, ", From: %s", c.From)
+	}
+	b.WriteString(deferrableString(c.Deferrable, c.InitiallyDeferred))
+	if c.OldTable != "" {
+		fmt.Fprintf(&b, ", OLD TABLE %s", c.OldTable)
+	}
+	if c.NewTable != "" {
+		fmt.Fprintf(&b, ", NEW TABLE %s", c.NewTable)
+	}
+	if c.ForEachRow {
+		b.WriteString(", FOR EACH ROW")
+	} else {
+		b.WriteString(", FOR EACH STATEMENT")
+	}
+	if c.When != nil {
+		fmt.Fprintf(&b, ", When: %s", c.When.String())
+	}
+	fmt.Fprintf(&b, ", Execute: %s(%s))", c.Function, joinExpressions(c.Arguments))
+	return b.String()
+}
+
+func (c *CreateTriggerStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Events...)
+	nodes = appendNodes(nodes, c.When)
+	nodes = appendNodes(nodes, c.Arguments...)
+	return nodes
+}
+
+// TriggerEvent represents an event that fires a trigger: INSERT, UPDATE [OF columns], DELETE or TRUNCATE.
+type TriggerEvent struct {
+	span
+
+	Kind    string
+	Columns []string
+}
+
+func (t *TriggerEvent) String() string {
+	if len(t.Columns) > 0 {
+		return fmt.Sprintf("TriggerEvent(%s OF [%s])", t.Kind, strings.Join(t.Columns, ", "))
+	}
+	return fmt.Sprintf("TriggerEvent(%s)", t.Kind)
+}
+
+func (t *TriggerEvent) Children() []Node {
+	return nil
+}
+
+// TypeKind is the kind of type defined by CREATE TYPE.
+type TypeKind int
+
+const (
+	TypeKindShell     TypeKind = iota // CREATE TYPE name, a placeholder to be defined later
+	TypeKindEnum                      // AS ENUM (labels)
+	TypeKindComposite                 // AS (attributes)
+)
+
+// CreateTypeStatement represents CREATE TYPE. Labels holds the labels of an
+// enum type and Attributes the attributes of a composite type.
+type CreateTypeStatement struct {
+	span
+
+	Name       string
+	Kind       TypeKind
+	Labels     []string
+	Attributes []*ColumnDefinition
+}
+
+func (c *CreateTypeStatement) String() string {
+	switch c.Kind {
+	case TypeKindEnum:
+		return fmt.Sprintf("CreateTypeStatement(Name: %s, ENUM %q)", c.Name, c.Labels)
+	case TypeKindComposite:
+		attributes := make([]string, len(c.Attributes))
+		for i, attribute := range c.Attributes {
+			attributes[i] = attribute.String()
+		}
+		return fmt.Sprintf("CreateTypeStatement(Name: %s, Attributes: [%s])", c.Name, strings.Join(attributes, ", "))
+	default:
+		return fmt.Sprintf("CreateTypeStatement(Name: %s)", c.Name)
+	}
+}
+
+func (c *CreateTypeStatement) Children() []Node {
+	return appendNodes(nil, c.Attributes...)
+}
+
+// AlterTypeStatement represents ALTER TYPE name ADD VALUE [IF NOT EXISTS]
+// 'label' [BEFORE | AFTER 'label'], which adds a label to an enum type.
+type AlterTypeStatement struct {
+	span
+
+	Name        string
+	IfNotExists bool
+	Value       string
+	Before      *string
+	After       *string
+}
+
+func (a *AlterTypeStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "AlterTypeStatement(Name: %s, ADD VALUE ", a.Name)
+	if a.IfNotExists {
+		b.WriteString("IF NOT EXISTS ")
+	}
+	fmt.Fprintf(&b, "%q", a.Value)
+	if a.Before != nil {
+		fmt.Fprintf(&b, " BEFORE %q", *a.Before)
+	}
+	if a.After != nil {
+		fmt.Fprintf(&b, " AFTER %q", *a.After)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (a *AlterTypeStatement) Children() []Node {
+	return nil
+}
+
+// CreateDomainStatement represents CREATE DOMAIN name [AS] type with its
+// collation, default and constraints; DEFAULT is stored as a constraint as in
+// column definitions.
+type CreateDomainStatement struct {
+	span
+
+	Name        string
+	Type        *DataType
+	Collation   *string
+	Constraints []*ColumnConstraint
+}
+
+func (c *CreateDomainStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CreateDomainStatement(Name: %s, Type: %s", c.Name, c.Type.String())
+	if c.Collation != nil {
+		fmt.Fprintf(&b, ", COLLATE %s", *c.Collation)
+	}
+	for _, constraint := range c.Constraints {
+		fmt.Fprintf(&b, ", %s", constraint.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateDomainStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Type)
+	nodes = appendNodes(nodes, c.Constraints...)
+	return nodes
+}
+
+// CreateExtensionStatement represents CREATE EXTENSION [IF NOT EXISTS] name
+// [WITH] [SCHEMA schema] [VERSION version] [CASCADE].
+type CreateExtensionStatement struct {
+	span
+
+	IfNotExists bool
+	Name        string
+	Schema      *string
+	Version     *string
+	Cascade     bool
+}
+
+func (c *CreateExtensionStatement) String() string {
+	var b strings.Builder
+	b.WriteString("CreateExtensionStatement(")
+	if c.IfNotExists {
+		b.WriteString("IF NOT EXISTS, ")
+	}
+	fmt.Fprintf(&b, "Name: %s", c.Name)
+	if c.Schema != nil {
+		fmt.Fprintf(&b, ", Schema: %s", *c.Schema)
+	}
+	if c.Version != nil {
+		fmt.Fprintf(&b, ", Version: %s", *c.Version)
+	}
+	if c.Cascade {
+		b.WriteString(", CASCADE")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CreateExtensionStatement) Children() []Node {
+	return nil
+}
+
+// TransactionModes holds the characteristics given to BEGIN, START TRANSACTION
+// and SET TRANSACTION. IsolationLevel is "SERIALIZABLE", "REPEATABLE READ",
+// "READ COMMITTED" or "READ UNCOMMITTED", and empty when not given; ReadOnly
+// and Deferrable are nil when not given.
+type TransactionModes struct {
+	IsolationLevel string
+	ReadOnly       *bool
+	Deferrable     *bool
+}
+
+func (m TransactionModes) String() string {
+	var modes []string
+	if m.IsolationLevel != "" {
+		modes = append(modes, "ISOLATION LEVEL "+m.IsolationLevel)
+	}
+	if m.ReadOnly != nil {
+		if *m.ReadOnly {
+			modes = append(modes, "READ ONLY")
+		} else {
+			modes = append(modes, "READ WRITE")
+		}
+	}
+	if m.Deferrable != nil {
+		if *m.Deferrable {
+			modes = append(modes, "DEFERRABLE")
+		} else {
+			modes = append(modes, "NOT DEFERRABLE")
+		}
+	}
+	return strings.Join(modes, ", ")
+}
+
+// BeginStatement represents BEGIN [WORK | TRANSACTION] or START TRANSACTION with optional transaction modes.
+type BeginStatement struct {
+	span
+
+	Modes TransactionModes
+}
+
+func (b *BeginStatement) String() string {
+	return fmt.Sprintf("BeginStatement(%s)", b.Modes)
+}
+
+func (b *BeginStatement) Children() []Node {
+	return nil
+}
+
+// CommitStatement represents COMMIT or END [WORK | TRANSACTION] [AND [NO] CHAIN].
+type CommitStatement struct {
+	span
+
+	Chain bool
+}
+
+func (c *CommitStatement) String() string {
+	if c.Chain {
+		return "CommitStatement(AND CHAIN)"
+	}
+	return "CommitStatement()"
+}
+
+func (c *CommitStatement) Children() []Node {
+	return nil
+}
+
+// RollbackStatement represents ROLLBACK or ABORT [WORK | TRANSACTION], either
+// [AND [NO] CHAIN] or TO [SAVEPOINT] name. Savepoint is nil when the whole
+// transaction is rolled back.
+type RollbackStatement struct {
+	span
+
+	Savepoint *string
+	Chain     bool
+}
+
+func (r *RollbackStatement) String() string {
+	switch {
+	case r.Savepoint != nil:
+		return fmt.Sprintf("RollbackStatement(To: %s)", *r.Savepoint)
+	case r.Chain:
+		return "RollbackStatement(AND CHAIN)"
+	default:
+		return "RollbackStatement()"
+	}
+}
+
+func (r *RollbackStatement) Children() []Node {
+	return nil
+}
+
+// SavepointStatement represents SAVEPOINT name.
+type SavepointStatement struct {
+	span
+
+	Name string
+}
+
+func (s *SavepointStatement) String() string {
+	return fmt.Sprintf("SavepointStatement(%s)", s.Name)
+}
+
+func (s *SavepointStatement) Children() []Node {
+	return nil
+}
+
+// ReleaseSavepointStatement represents RELEASE [SAVEPOINT] name.
+type ReleaseSavepointStatement struct {
+	span
+
+	Name string
+}
+
+func (r *ReleaseSavepointStatement) String() string {
+	return fmt.Sprintf("ReleaseSavepointStatement(%s)", r.Name)
+}
+
+func (r *ReleaseSavepointStatement) Children() []Node {
+	return nil
+}
+
+// SetScope is the scope of a SET statement.
+type SetScope int
+
+const (
+	SetScopeDefault SetScope = iota // no scope written, which means SESSION
+	SetScopeSession
+	SetScopeLocal
+	SetScopeGlobal // MySQL SET GLOBAL
+)
+
+func (s SetScope) String() string {
+	switch s {
+	case SetScopeDefault:
+		return ""
+	case SetScopeSession:
+		return "SESSION"
+	case SetScopeLocal:
+		return "LOCAL"
+	case SetScopeGlobal:
+		return "GLOBAL"
+	default:
+		return "unknown_scope"
+	}
+}
+
+// SetStatement represents SET [SESSION | LOCAL | GLOBAL] name {TO | =} {value [, ...] | DEFAULT}
+// and SET TIME ZONE and MySQL's SET NAMES, whose Names are "TIME ZONE" and "NAMES".
+// For MySQL's SET @name = value, Name keeps the at sign. Bare words such as public or
+// on are stored as ColumnExpressions, and Default is set for SET name TO DEFAULT.
+type SetStatement struct {
+	span
+
+	Scope   SetScope
+	Name    string
+	Values  []Expression
+	Default bool
+}
+
+func (s *SetStatement) String() string {
+	var b strings.Builder
+	b.WriteString("SetStatement(")
+	if s.Scope != SetScopeDefault {
+		fmt.Fprintf(&b, "%s, ", s.Scope)
+	}
+	fmt.Fprintf(&b, "Name: %s", s.Name)
+	if s.Default {
+		b.WriteString(", DEFAULT")
+	} else {
+		fmt.Fprintf(&b, ", Values: [%s]", joinExpressions(s.Values))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (s *SetStatement) Children() []Node {
+	return appendNodes(nil, s.Values...)
+}
+
+// SetTransactionStatement represents SET TRANSACTION modes, or
+// SET SESSION CHARACTERISTICS AS TRANSACTION modes when Session is set.
+type SetTransactionStatement struct {
+	span
+
+	Session bool
+	Modes   TransactionModes
+}
+
+func (s *SetTransactionStatement) String() string {
+	if s.Session {
+		return fmt.Sprintf("SetTransactionStatement(SESSION, %s)", s.Modes)
+	}
+	return fmt.Sprintf("SetTransactionStatement(%s)", s.Modes)
+}
+
+func (s *SetTransactionStatement) Children() []Node {
+	return nil
+}
+
+// ResetStatement represents RESET name; Name is "ALL" for RESET ALL and "TIME ZONE" for RESET TIME ZONE.
+type ResetStatement struct {
+	span
+
+	Name string
+}
+
+func (r *ResetStatement) String() string {
+	return fmt.Sprintf("ResetStatement(%s)", r.Name)
+}
+
+func (r *ResetStatement) Children() []Node {
+	return nil
+}
+
+// ShowStatement represents SHOW name; Name is "ALL", "TIME ZONE" or
+// "TRANSACTION ISOLATION LEVEL" for those forms.
+type ShowStatement struct {
+	span
+
+	Name string
+}
+
+func (s *ShowStatement) String() string {
+	return fmt.Sprintf("ShowStatement(%s)", s.Name)
+}
+
+func (s *ShowStatement) Children() []Node {
+	return nil
+}
+
+// UseStatement represents MySQL's USE database.
+type UseStatement struct {
+	span
+
+	Database string
+}
+
+func (u *UseStatement) String() string {
+	return fmt.Sprintf("UseStatement(%s)", u.Database)
+}
+
+func (u *UseStatement) Children() []Node {
+	return nil
+}
+
+// Privilege represents a privilege of GRANT or REVOKE, optionally limited to
+// columns. Name is upper-cased, such as "SELECT" or "TEMPORARY", and "ALL"
+// for ALL [PRIVILEGES].
+type Privilege struct {
+	span
+
+	Name    string
+	Columns []string
+}
+
+func (p *Privilege) String() string {
+	if len(p.Columns) > 0 {
+		return fmt.Sprintf("Privilege(%s, Columns: [%s])", p.Name, strings.Join(p.Columns, ", "))
+	}
+	return fmt.Sprintf("Privilege(%s)", p.Name)
+}
+
+func (p *Privilege) Children() []Node {
+	return nil
+}
+
+// GrantStatement represents GRANT privileges ON objects TO grantees, or
+// GRANT roles TO grantees when Roles is set. ObjectKind is the upper-cased
+// kind as written, such as "SEQUENCE" or "ALL TABLES IN SCHEMA", and empty
+// when omitted, which means TABLE. Signatures are parallel to Objects as in
+// DropStatement. Hosts is parallel to Grantees and holds the host of MySQL
+// accounts written as 'user'@'host'; it is nil when no grantee names one.
+// WithGrantOption is also set by WITH ADMIN OPTION of role grants.
+type GrantStatement struct {
+	span
+
+	Privileges      []*Privilege
+	Roles           []string
+	ObjectKind      string
+	Objects         []string
+	Signatures      [][]*FunctionParameter
+	Grantees        []string
+	Hosts           []*string
+	WithGrantOption bool
+	GrantedBy       *string
+}
+
+func (g *GrantStatement) String() string {
+	var b strings.Builder
+	b.WriteString("GrantStatement(")
+	b.WriteString(privilegeTargetString(g.Privileges, g.Roles, g.ObjectKind, g.Objects, g.Signatures))
+	fmt.Fprintf(&b, ", To: [%s]", joinGrantees(g.Grantees, g.Hosts))
+	if g.WithGrantOption {
+		b.WriteString(", WITH GRANT OPTION")
+	}
+	if g.GrantedBy != nil {
+		fmt.Fprintf(&b, ", GrantedBy: %s", *g.GrantedBy)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (g *GrantStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, g.Privileges...)
+	for _, signature := range g.Signatures {
+		nodes = appendNodes(nodes, signature...)
+	}
+	return nodes
+}
+
+// RevokeStatement represents REVOKE privileges ON objects FROM grantees, or
+// REVOKE roles FROM grantees when Roles is set; the fields are as in
+// GrantStatement. GrantOptionFor is set by GRANT OPTION FOR, or ADMIN OPTION
+// FOR of role revokes, which revoke only the option.
+type RevokeStatement struct {
+	span
+
+	GrantOptionFor bool
+	Privileges     []*Privilege
+	Roles          []string
+	ObjectKind     string
+	Objects        []string
+	Signatures     [][]*FunctionParameter
+	Grantees       []string
+	Hosts          []*string
+	GrantedBy      *string
+	Behavior       DropBehavior
+}
+
+func (r *RevokeStatement) String() string {
+	var b strings.Builder
+	b.WriteString("RevokeStatement(")
+	if r.GrantOptionFor {
+		b.WriteString("GRANT OPTION FOR, ")
+	}
+	b.WriteString(privilegeTargetString(r.Privileges, r.Roles, r.ObjectKind, r.Objects, r.Signatures))
+	fmt.Fprintf(&b, ", From: [%s]", joinGrantees(r.Grantees, r.Hosts))
+	if r.GrantedBy != nil {
+		fmt.Fprintf(&b, ", GrantedBy: %s", *r.GrantedBy)
+	}
+	if r.Behavior != DropBehaviorDefault {
+		fmt.Fprintf(&b, ", %s", r.Behavior)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (r *RevokeStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, r.Privileges...)
+	for _, signature := range r.Signatures {
+		nodes = appendNodes(nodes, signature...)
+	}
+	return nodes
+}
+
+// AlterDefaultPrivilegesStatement represents ALTER DEFAULT PRIVILEGES, which
+// applies Action, a *GrantStatement or *RevokeStatement whose ObjectKind is
+// a plural kind such as "TABLES" and which names no objects, to the objects
+// the Roles create later in the Schemas.
+type AlterDefaultPrivilegesStatement struct {
+	span
+
+	Roles   []string
+	Schemas []string
+	Action  Statement
+}
+
+func (a *AlterDefaultPrivilegesStatement) String() string {
+	var b strings.Builder
+	b.WriteString("AlterDefaultPrivilegesStatement(")
+	if len(a.Roles) > 0 {
+		fmt.Fprintf(&b, "Roles: [%s], ", strings.Join(a.Roles, ", "))
+	}
+	if len(a.Schemas) > 0 {
+		fmt.Fprintf(&b, "Schemas: [%s], ", strings.Join(a.Schemas, ", "))
+	}
+	fmt.Fprintf(&b, "%s)", a.Action.String())
+	return b.String()
+}
+
+func (a *AlterDefaultPrivilegesStatement) Children() []Node {
+	return appendNodes(nil, a.Action)
+}
+
+// RoleOption represents an option of CREATE or ALTER ROLE. Name is upper-cased,
+// such as "LOGIN", "NOSUPERUSER", "CONNECTION LIMIT", "PASSWORD", "VALID UNTIL",
+// "IN ROLE" or MySQL's "IDENTIFIED BY". Value holds the argument of options that take one, and Roles
+// the role names of IN ROLE, ROLE and ADMIN.
+type RoleOption struct {
+	span
+
+	Name  string
+	Value Expression
+	Roles []string
+}
+
+func (o *RoleOption) String() string {
+	switch {
+	case o.Value != nil:
+		return fmt.Sprintf("RoleOption(%s %s)", o.Name, o.Value.String())
+	case len(o.Roles) > 0:
+		return fmt.Sprintf("RoleOption(%s [%s])", o.Name, strings.Join(o.Roles, ", "))
+	default:
+		return fmt.Sprintf("RoleOption(%s)", o.Name)
+	}
+}
+
+func (o *RoleOption) Children() []Node {
+	return appendNodes(nil, o.Value)
+}
+
+// CreateRoleStatement represents CREATE ROLE, or CREATE USER when User is set, with its options.
+// Host is the host of a MySQL account 'user'@'host', or nil if none is written.
+type CreateRoleStatement struct {
+	span
+
+	User    bool
+	Name    string
+	Host    *string
+	Options []*RoleOption
+}
+
+func (c *CreateRoleStatement) String() string {
+	kind := "Role"
+	if c.User {
+		kind = "User"
+	}
+	return fmt.Sprintf("Create%sStatement(Name: %s, Options: [%s])", kind, accountName(c.Name, c.Host), joinRoleOptions(c.Options))
+}
+
+func (c *CreateRoleStatement) Children() []Node {
+	return appendNodes(nil, c.Options...)
+}
+
+// AlterRoleStatement represents ALTER ROLE, or ALTER USER when User is set,
+// which either changes options, renames the role to NewName, or sets or
+// resets a configuration parameter for the role, in Database if one is given.
+// Host is as for CreateRoleStatement.
+type AlterRoleStatement struct {
+	span
+
+	User     bool
+	Name     string
+	Host     *string
+	Options  []*RoleOption
+	NewName  *string
+	Database *string
+	Set      *SetStatement
+	Reset    *ResetStatement
+}
+
+func (a *AlterRoleStatement) String() string {
+	kind := "Role"
+	if a.User {
+		kind = "User"
+	}
+	name := accountName(a.Name, a.Host)
+	switch {
+	case a.NewName != nil:
+		return fmt.Sprintf("Alter%sStatement(Name: %s, RenameTo: %s)", kind, name, *a.NewName)
+	case a.Set != nil || a.Reset != nil:
+		var b strings.Builder
+		fmt.Fprintf(&b, "Alter%sStatement(Name: %s, ", kind, name)
+		if a.Database != nil {
+			fmt.Fprintf(&b, "InDatabase: %s, ", *a.Database)
+		}
+		if a.Set != nil {
+			b.WriteString(a.Set.String())
+		} else {
+			b.WriteString(a.Reset.String())
+		}
+		b.WriteString(")")
+		return b.String()
+	}
+	return fmt.Sprintf("Alter%sStatement(Name: %s, Options: [%s])", kind, name, joinRoleOptions(a.Options))
+}
+
+func (a *AlterRoleStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, a.Options...)
+	nodes = appendNodes(nodes, a.Set)
+	nodes = appendNodes(nodes, a.Reset)
+	return nodes
+}
+
+// UtilityOption represents an option of EXPLAIN, ANALYZE, VACUUM or COPY,
+// either written in the parenthesized list or as one of the bare words before
+// the statement or table, or a MySQL table option of CREATE TABLE. Name is
+// upper-cased and Value is the argument as written, or nil if there is none.
+// The COPY options FORCE_QUOTE, FORCE_NOT_NULL and FORCE_NULL take a column
+// list or * instead.
+type UtilityOption struct {
+	span
+
+	Name       string
+	Value      *string
+	Quoted     bool // Value was written as a string literal
+	Columns    []string
+	AllColumns bool // the argument is *
+}
+
+func (u *UtilityOption) String() string {
+	if u.Columns != nil {
+		return fmt.Sprintf("UtilityOption(%s (%s))", u.Name, strings.Join(u.Columns, ", "))
+	}
+	if u.AllColumns {
+		return fmt.Sprintf("UtilityOption(%s *)", u.Name)
+	}
+	if u.Value != nil && u.Quoted {
+		return fmt.Sprintf("UtilityOption(%s %s)", u.Name, quoteString(*u.Value))
+	}
+	if u.Value != nil {
+		return fmt.Sprintf("UtilityOption(%s %s)", u.Name, *u.Value)
+	}
+	return fmt.Sprintf("UtilityOption(%s)", u.Name)
+}
+
+func (u *UtilityOption) Children() []Node {
+	return nil
+}
+
+// ExplainStatement represents EXPLAIN [ANALYZE] [VERBOSE] statement or EXPLAIN (options) statement.
+type ExplainStatement struct {
+	span
+
+	Options   []*UtilityOption
+	Statement Statement
+}
+
+func (e *ExplainStatement) String() string {
+	return fmt.Sprintf("ExplainStatement(Options: [%s], %v)", joinUtilityOptions(e.Options), e.Statement)
+}
+
+func (e *ExplainStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, e.Options...)
+	nodes = appendNodes(nodes, e.Statement)
+	return nodes
+}
+
+// PrepareStatement represents PREPARE name [(type, ...)] AS statement.
+type PrepareStatement struct {
+	span
+
+	Name      string
+	Types     []*DataType
+	Statement Statement
+}
+
+func (p *PrepareStatement) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "PrepareStatement(Name: %s", p.Name)
+	if len(p.Types) > 0 {
+		types := make([]string, len(p.Types))
+		for i, typ := range p.Types {
+			types[i] = typ.String()
+		}
+		fmt.Fprintf(&b, ", Types: [%s]", strings.Join(types, ", "))
+	}
+	fmt.Fprintf(&b, ", %v)", p.Statement)
+	return b.String()
+}
+
+func (p *PrepareStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, p.Types...)
+	nodes = appendNodes(nodes, p.Statement)
+	return nodes
+}
+
+// ExecuteStatement represents EXECUTE name [(argument, ...)].
+type ExecuteStatement struct {
+	span
+
+	Name      string
+	Arguments []Expression
+}
+
+func (e *ExecuteStatement) String() string {
+	return fmt.Sprintf("ExecuteStatement(Name: %s, Arguments: [%s])", e.Name, joinExpressions(e.Arguments))
+}
+
+func (e *ExecuteStatement) Children() []Node {
+	return appendNodes(nil, e.Arguments...)
+}
+
+// DeallocateStatement represents DEALLOCATE [PREPARE] name; Name is "ALL" for DEALLOCATE ALL.
+type DeallocateStatement struct {
+	span
+
+	Name string
+}
+
+func (d *DeallocateStatement) String() string {
+	return fmt.Sprintf("DeallocateStatement(%s)", d.Name)
+}
+
+func (d *DeallocateStatement) Children() []Node {
+	return nil
+}
+
+// VacuumRelation represents a table processed by ANALYZE or VACUUM, optionally limited to columns.
+type VacuumRelation struct {
+	span
+
+	Table   string
+	Columns []string
+}
+
+func (v *VacuumRelation) String() string {
+	if len(v.Columns) > 0 {
+		return fmt.Sprintf("VacuumRelation(%s [%s])", v.Table, strings.Join(v.Columns, ", "))
+	}
+	return fmt.Sprintf("VacuumRelation(%s)", v.Table)
+}
+
+func (v *VacuumRelation) Children() []Node {
+	return nil
+}
+
+// AnalyzeStatement represents ANALYZE [(options) | VERBOSE] [table [(column, ...)], ...].
+// Tables is empty when the whole database is analyzed.
+type AnalyzeStatement struct {
+	span
+
+	Options []*UtilityOption
+	Tables  []*VacuumRelation
+}
+
+func (a *AnalyzeStatement) String() string {
+	return fmt.Sprintf("AnalyzeStatement(Options: [%s], Tables: [%s])", joinUtilityOptions(a.Options), joinVacuumRelations(a.Tables))
+}
+
+func (a *AnalyzeStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, a.Options...)
+	nodes = appendNodes(nodes, a.Tables...)
+	return nodes
+}
+
+// VacuumStatement represents VACUUM [(options) | FULL FREEZE VERBOSE ANALYZE] [table [(column, ...)], ...].
+// Tables is empty when the whole database is vacuumed.
+type VacuumStatement struct {
+	span
+
+	Options []*UtilityOption
+	Tables  []*VacuumRelation
+}
+
+func (v *VacuumStatement) String() string {
+	return fmt.Sprintf("VacuumStatement(Options: [%s], Tables: [%s])", joinUtilityOptions(v.Options), joinVacuumRelations(v.Tables))
+}
+
+func (v *VacuumStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, v.Options...)
+	nodes = appendNodes(nodes, v.Tables...)
+	return nodes
+}
+
+// CopyStatement represents PostgreSQL's COPY. It copies Table, optionally
+// limited to Columns, or the result of Query, from or to File, or to the
+// standard input or output when File is nil. Program is set when File is a
+// command run by COPY ... PROGRAM. Options holds both the parenthesized
+// options and legacy words such as CSV, HEADER or DELIMITER ','. Data holds
+// the rows that follow COPY ... FROM STDIN in a script such as a pg_dump
+// file, or nil when there are none.
+type CopyStatement struct {
+	span
+
+	Table   string
+	Columns []string
+	Query   QueryExpression
+	To      bool
+	File    *string
+	Program bool
+	Options []*UtilityOption
+	Where   Expression
+	Data    *string
+}
+
+func (c *CopyStatement) String() string {
+	var b strings.Builder
+	b.WriteString("CopyStatement(")
+	if c.Query != nil {
+		b.WriteString(c.Query.String())
+	} else {
+		b.WriteString(c.Table)
+		if len(c.Columns) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(c.Columns, ", "))
+		}
+	}
+	direction, stdio := "FROM", "STDIN"
+	if c.To {
+		direction, stdio = "TO", "STDOUT"
+	}
+	switch {
+	case c.File != nil && c.Program:
+		fmt.Fprintf(&b, ", %s PROGRAM %q", direction, *c.File)
+	case c.File != nil:
+		fmt.Fprintf(&b, ", %s %q", direction, *c.File)
+	default:
+		fmt.Fprintf(&b, ", %s %s", direction, stdio)
+	}
+	if len(c.Options) > 0 {
+		fmt.Fprintf(&b, ", Options: [%s]", joinUtilityOptions(c.Options))
+	}
+	if c.Where != nil {
+		fmt.Fprintf(&b, ", Where: %s", c.Where.String())
+	}
+	if c.Data != nil {
+		fmt.Fprintf(&b, ", Data: %q", *c.Data)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CopyStatement) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Query)
+	nodes = appendNodes(nodes, c.Options...)
+	nodes = appendNodes(nodes, c.Where)
+	return nodes
+}
+
+// LoadDataStatement represents MySQL's LOAD DATA [LOCAL] INFILE. Duplicates is
+// "REPLACE", "IGNORE" or empty. The field and line options are nil when not
+// given; IgnoreLines is the number of leading lines skipped. Columns and
+// Assignments are the trailing column list and SET clause.
+type LoadDataStatement struct {
+	span
+
+	Local              bool
+	File               string
+	Duplicates         string
+	Table              string
+	CharacterSet       *string
+	FieldsTerminatedBy *string
+	FieldsEnclosedBy   *string
+	OptionallyEnclosed bool
+	FieldsEscapedBy    *string
+	LinesStartingBy    *string
+	LinesTerminatedBy  *string
+	IgnoreLines        int
+	Columns            []string // a user variable is written with its @, as in @b
+	Assignments        []*Assignment
+}
+
+func (l *LoadDataStatement) String() string {
+	var b strings.Builder
+	b.WriteString("LoadDataStatement(")
+	if l.Local {
+		b.WriteString("LOCAL, ")
+	}
+	fmt.Fprintf(&b, "File: %q", l.File)
+	if l.Duplicates != "" {
+		fmt.Fprintf(&b, ", %s", l.Duplicates)
+	}
+	fmt.Fprintf(&b, ", Table: %s", l.Table)
+	if l.CharacterSet != nil {
+		fmt.Fprintf(&b, ", CharacterSet: %s", *l.CharacterSet)
+	}
+	if l.FieldsTerminatedBy != nil {
+		fmt.Fprintf(&b, ", FieldsTerminatedBy: %q", *l.FieldsTerminatedBy)
+	}
+	if l.FieldsEnclosedBy != nil {
+		if l.OptionallyEnclosed {
+			fmt.Fprintf(&b, ", FieldsOptionallyEnclosedBy: %q", *l.FieldsEnclosedBy)
+		} else {
+			fmt.Fprintf(&b, ", FieldsEnclosedBy: %q", *l.FieldsEnclosedBy)
+		}
+	}
+	if l.FieldsEscapedBy != nil {
+		fmt.Fprintf(&b, ", FieldsEscapedBy: %q", *l.FieldsEscapedBy)
+	}
+	if l.LinesStartingBy != nil {
+		fmt.Fprintf(&b, ", LinesStartingBy: %q", *l.LinesStartingBy)
+	}
+	if l.LinesTerminatedBy != nil {
+		fmt.Fprintf(&b, ", LinesTerminatedBy: %q", *l.LinesTerminatedBy)
+	}
+	if l.IgnoreLines > 0 {
+		fmt.Fprintf(&b, ", IgnoreLines: %d", l.IgnoreLines)
+	}
+	if len(l.Columns) > 0 {
+		fmt.Fprintf(&b, ", Columns: [%s]", strings.Join(l.Columns, ", "))
+	}
+	if len(l.Assignments) > 0 {
+		fmt.Fprintf(&b, ", Set: [%s]", joinAssignments(l.Assignments))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (l *LoadDataStatement) Children() []Node {
+	return appendNodes(nil, l.Assignments...)
+}
+
+// TableExpression is an item of a FROM-style table list: a TableName,
+// a SubqueryTable or a Join.
+type TableExpression interface {
+	Node
+	tableExpression()
+}
+
+// TableName represents a reference to a possibly schema-qualified table with an optional alias.
+// Only is set for ONLY name, which leaves out tables inheriting from it, and Descendants for
+// name *, which includes them as a plain name does.
+type TableName struct {
+	span
+
+	Only        bool
+	Name        string
+	Descendants bool
+	Alias       *string
+}
+
+func (t *TableName) tableExpression() {}
+
+func (t *TableName) String() string {
+	name := t.Name
+	switch {
+	case t.Only:
+		name = "ONLY " + name
+	case t.Descendants:
+		name += " *"
+	}
+	if t.Alias != nil {
+		return fmt.Sprintf("TableName(%s AS %s)", name, *t.Alias)
+	}
+	return fmt.Sprintf("TableName(%s)", name)
+}
+
+func (t *TableName) Children() []Node {
+	return nil
+}
+
+// SubqueryTable represents a parenthesized query used as a table, also known as a derived table.
+type SubqueryTable struct {
+	span
+
+	Query QueryExpression
+	Alias *string
+}
+
+func (s *SubqueryTable) tableExpression() {}
+
+func (s *SubqueryTable) String() string {
+	if s.Alias != nil {
+		return fmt.Sprintf("SubqueryTable(%s AS %s)", s.Query.String(), *s.Alias)
+	}
+	return fmt.Sprintf("SubqueryTable(%s)", s.Query.String())
+}
+
+func (s *SubqueryTable) Children() []Node {
+	return appendNodes(nil, s.Query)
+}
+
+// JoinType is the kind of a join between two table expressions.
+type JoinType int
+
+const (
+	JoinInner JoinType = iota
+	JoinLeft
+	JoinRight
+	JoinFull
+	JoinCross
+)
+
+func (j JoinType) String() string {
+	switch j {
+	case JoinInner:
+		return "INNER JOIN"
+	case JoinLeft:
+		return "LEFT JOIN"
+	case JoinRight:
+		return "RIGHT JOIN"
+	case JoinFull:
+		return "FULL JOIN"
+	case JoinCross:
+		return "CROSS JOIN"
+	default:
+		return "unknown_join"
+	}
+}
+
+// Join represents two table expressions joined with an ON condition, a USING
+// column list, or neither for CROSS and NATURAL joins.
+type Join struct {
+	span
+
+	Left    TableExpression
+	Type    JoinType
+	Natural bool
+	Right   TableExpression
+	On      Expression
+	Using   []string
+}
+
+func (j *Join) tableExpression() {}
+
+func (j *Join) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Join(%s ", j.Left.String())
+	if j.Natural {
+		b.WriteString("NATURAL ")
+	}
+	fmt.Fprintf(&b, "%s %s", j.Type, j.Right.String())
+	switch {
+	case j.On != nil:
+		fmt.Fprintf(&b, " ON %s", j.On.String())
+	case len(j.Using) > 0:
+		fmt.Fprintf(&b, " USING [%s]", strings.Join(j.Using, ", "))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (j *Join) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, j.Left, j.Right)
+	nodes = appendNodes(nodes, j.On)
+	return nodes
+}
+
+// WithClause represents the common table expressions introduced by WITH [RECURSIVE].
+type WithClause struct {
+	span
+
+	Recursive bool
+	CTEs      []*CommonTableExpression
+}
+
+func (w *WithClause) String() string {
+	ctes := make([]string, len(w.CTEs))
+	for i, cte := range w.CTEs {
+		ctes[i] = cte.String()
+	}
+	recursive := ""
+	if w.Recursive {
+		recursive = "RECURSIVE "
+	}
+	return fmt.Sprintf("WithClause(%s[%s])", recursive, strings.Join(ctes, ", "))
+}
+
+func (w *WithClause) Children() []Node {
+	return appendNodes(nil, w.CTEs...)
+}
+
+// Materialization is the MATERIALIZED hint of a common table expression.
+type Materialization int
+
+const (
+	MaterializedDefault Materialization = iota // no hint written
+	Materialized
+	NotMaterialized
+)
+
+// CommonTableExpression represents a single named query of a WITH clause.
+type CommonTableExpression struct {
+	span
+
+	Name         string
+	Columns      []string
+	Materialized Materialization
+	Query        QueryExpression
+	Search       *SearchClause
+	Cycle        *CycleClause
+}
+
+func (c *CommonTableExpression) String() string {
+	var b strings.Builder
+	b.WriteString("CommonTableExpression(")
+	b.WriteString(c.Name)
+	if len(c.Columns) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(c.Columns, ", "))
+	}
+	switch c.Materialized {
+	case Materialized:
+		b.WriteString(" MATERIALIZED")
+	case NotMaterialized:
+		b.WriteString(" NOT MATERIALIZED")
+	}
+	fmt.Fprintf(&b, " AS %s", c.Query.String())
+	if c.Search != nil {
+		fmt.Fprintf(&b, " %s", c.Search.String())
+	}
+	if c.Cycle != nil {
+		fmt.Fprintf(&b, " %s", c.Cycle.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CommonTableExpression) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Query)
+	nodes = appendNodes(nodes, c.Search)
+	nodes = appendNodes(nodes, c.Cycle)
+	return nodes
+}
+
+// SearchClause represents the SEARCH clause of a recursive common table expression.
+type SearchClause struct {
+	span
+
+	DepthFirst bool
+	Columns    []string
+	SetColumn  string
+}
+
+func (s *SearchClause) String() string {
+	order := "BREADTH"
+	if s.DepthFirst {
+		order = "DEPTH"
+	}
+	return fmt.Sprintf("SearchClause(%s FIRST BY %s SET %s)", order, strings.Join(s.Columns, ", "), s.SetColumn)
+}
+
+func (s *SearchClause) Children() []Node {
+	return nil
+}
+
+// CycleClause represents the CYCLE clause of a recursive common table expression.
+// MarkValue and DefaultValue are nil unless TO ... DEFAULT ... was written.
+type CycleClause struct {
+	span
+
+	Columns      []string
+	SetColumn    string
+	MarkValue    Expression
+	DefaultValue Expression
+	PathColumn   string
+}
+
+func (c *CycleClause) String() string {
+	values := ""
+	if c.MarkValue != nil && c.DefaultValue != nil {
+		values = fmt.Sprintf(" TO %s DEFAULT %s", c.MarkValue.String(), c.DefaultValue.String())
+	}
+	return fmt.Sprintf("CycleClause(%s SET %s%s USING %s)", strings.Join(c.Columns, ", "), c.SetColumn, values, c.PathColumn)
+}
+
+func (c *CycleClause) Children() []Node {
+	return appendNodes(nil, c.MarkValue, c.DefaultValue)
+}
+
+// SortDirection is the direction of an ORDER BY item.
+type SortDirection int
+
+const (
+	SortDefault SortDirection = iota // no ASC or DESC written
+	SortAsc
+	SortDesc
+)
+
+// NullsOrder is the placement of NULLs requested by an ORDER BY item.
+type NullsOrder int
+
+const (
+	NullsDefault NullsOrder = iota // no NULLS FIRST or NULLS LAST written
+	NullsFirst
+	NullsLast
+)
+
+// OrderByItem represents a single sort key of an ORDER BY clause.
+type OrderByItem struct {
+	span
+
+	Expression Expression
+	Collation  *string
+	Direction  SortDirection
+	Nulls      NullsOrder
+}
+
+func (o *OrderByItem) String() string {
+	var b strings.Builder
+	b.WriteString("OrderByItem(")
+	b.WriteString(o.Expression.String())
+	if o.Collation != nil {
+		fmt.Fprintf(&b, " COLLATE %s", *o.Collation)
+	}
+	switch o.Direction {
+	case SortAsc:
+		b.WriteString(" ASC")
+	case SortDesc:
+		b.WriteString(" DESC")
+	}
+	switch o.Nulls {
+	case NullsFirst:
+		b.WriteString(" NULLS FIRST")
+	case NullsLast:
+		b.WriteString(" NULLS LAST")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (o *OrderByItem) Children() []Node {
+	return appendNodes(nil, o.Expression)
+}
+
+// LimitStyle records which dialect's syntax a row-limiting clause was written in.
+type LimitStyle int
+
+const (
+	LimitStyleLimit LimitStyle = iota // LIMIT n [OFFSET m]
+	LimitStyleComma                   // MySQL LIMIT m, n
+	LimitStyleFetch                   // [OFFSET m ROWS] FETCH FIRST n ROWS ONLY
+	LimitStyleTop                     // T-SQL SELECT TOP n [PERCENT]
+)
+
+func (s LimitStyle) String() string {
+	switch s {
+	case LimitStyleLimit:
+		return "LIMIT"
+	case LimitStyleComma:
+		return "LIMIT m, n"
+	case LimitStyleFetch:
+		return "FETCH"
+	case LimitStyleTop:
+		return "TOP"
+	default:
+		return "unknown_limit_style"
+	}
+}
+
+// Limit represents the row-limiting clause of a query, whichever syntax was used.
+// Count is nil when no row cap applies, as in LIMIT ALL or a bare OFFSET.
+type Limit struct {
+	span
+
+	Style    LimitStyle
+	Count    Expression
+	Offset   Expression
+	Percent  bool
+	WithTies bool
+}
+
+func (l *Limit) String() string {
+	count, offset := "nil", "nil"
+	if l.Count != nil {
+		count = l.Count.String()
+	}
+	if l.Offset != nil {
+		offset = l.Offset.String()
+	}
+	var options strings.Builder
+	if l.Percent {
+		options.WriteString(", Percent")
+	}
+	if l.WithTies {
+		options.WriteString(", WithTies")
+	}
+	return fmt.Sprintf("Limit(%s, Count: %s, Offset: %s%s)", l.Style, count, offset, options.String())
+}
+
+func (l *Limit) Children() []Node {
+	// LIMIT offset, count and OFFSET ... FETCH write the offset first.
+	if l.Count != nil && l.Offset != nil && l.Offset.Pos() < l.Count.Pos() {
+		return appendNodes(nil, l.Offset, l.Count)
+	}
+	return appendNodes(nil, l.Count, l.Offset)
+}
+
+// AliasedExpression represents an item of a select or RETURNING list renamed with [AS] alias.
+type AliasedExpression struct {
+	span
+
+	Expression Expression
+	Alias      string
+}
+
+func (a *AliasedExpression) String() string {
+	return fmt.Sprintf("AliasedExpression(%s AS %s)", a.Expression.String(), a.Alias)
+}
+
+func (a *AliasedExpression) Children() []Node {
+	return appendNodes(nil, a.Expression)
+}
+
+type ColumnExpression struct {
+	span
+
+	Table *string
+	Name  string
+}
+
+func (c *ColumnExpression) String() string {
+	if c.Table != nil {
+		return fmt.Sprintf("ColumnExpression(%s.%s)", *c.Table, c.Name)
+	}
+	return fmt.Sprintf("ColumnExpression(%s)", c.Name)
+}
+
+func (c *ColumnExpression) Children() []Node {
+	return nil
+}
+
+type BinaryExpression struct {
+	span
+
+	Left     Expression
+	Operator tokens.TokenType // use only operators
+	Right    Expression
+}
+
+func (s *BinaryExpression) String() string {
+	return fmt.Sprintf("BinaryExpression(%s %s %s)",
+		s.Left.String(), operatorToString(s.Operator), s.Right.String())
+}
+
+func (s *BinaryExpression) Children() []Node {
+	return appendNodes(nil, s.Left, s.Right)
+}
+
+// FunctionCall represents a call of a scalar, aggregate or window function.
+// OrderBy holds an ORDER BY written inside the argument list, as in string_agg(x, ',' ORDER BY y).
+type FunctionCall struct {
+	span
+
+	Name      string
+	Distinct  bool
+	Arguments []Expression
+	OrderBy   []*OrderByItem
+	Filter    Expression
+	Over      *WindowSpec
+}
+
+func (f *FunctionCall) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "FunctionCall(%s(", f.Name)
+	if f.Distinct {
+		b.WriteString("DISTINCT ")
+	}
+	b.WriteString(joinExpressions(f.Arguments))
+	if len(f.OrderBy) > 0 {
+		fmt.Fprintf(&b, " ORDER BY %s", joinOrderByItems(f.OrderBy))
+	}
+	b.WriteString(")")
+	if f.Filter != nil {
+		fmt.Fprintf(&b, " FILTER %s", f.Filter.String())
+	}
+	if f.Over != nil {
+		fmt.Fprintf(&b, " OVER %s", f.Over.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (f *FunctionCall) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, f.Arguments...)
+	nodes = appendNodes(nodes, f.OrderBy...)
+	nodes = appendNodes(nodes, f.Filter)
+	nodes = appendNodes(nodes, f.Over)
+	return nodes
+}
 
-// Expression interface for expressions in the AST.
-type Expression interface {
-	String() string
+// WindowSpec represents the window a window function is computed over.
+// Name refers to a window defined in the WINDOW clause, either alone as in
+// OVER w or as the base that the remaining fields extend.
+type WindowSpec struct {
+	span
+
+	Name        *string
+	PartitionBy []Expression
+	OrderBy     []*OrderByItem
+	Frame       *WindowFrame
 }
 
-// Condition represents a condition in a WHERE clause.
-type Condition struct {
-	Column   string
-	Operator string
-	Value    string
+func (w *WindowSpec) String() string {
+	var parts []string
+	if w.Name != nil {
+		parts = append(parts, *w.Name)
+	}
+	if len(w.PartitionBy) > 0 {
+		parts = append(parts, fmt.Sprintf("PARTITION BY %s", joinExpressions(w.PartitionBy)))
+	}
+	if len(w.OrderBy) > 0 {
+		parts = append(parts, fmt.Sprintf("ORDER BY %s", joinOrderByItems(w.OrderBy)))
+	}
+	if w.Frame != nil {
+		parts = append(parts, w.Frame.String())
+	}
+	return fmt.Sprintf("WindowSpec(%s)", strings.Join(parts, " "))
 }
 
-// SelectStatement represents a parsed SELECT statement.
-type SelectStatement struct {
-	Expressions []Expression
-	Table       *string
-	Where       *Condition
+func (w *WindowSpec) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, w.PartitionBy...)
+	nodes = appendNodes(nodes, w.OrderBy...)
+	nodes = appendNodes(nodes, w.Frame)
+	return nodes
 }
 
-func (s *SelectStatement) String() string {
-	expressions := make([]string, len(s.Expressions))
-	for i, expr := range s.Expressions {
-		expressions[i] = expr.String()
+// NamedWindow represents a window defined in the WINDOW clause of a SELECT.
+type NamedWindow struct {
+	span
+
+	Name string
+	Spec *WindowSpec
+}
+
+func (n *NamedWindow) String() string {
+	return fmt.Sprintf("NamedWindow(%s AS %s)", n.Name, n.Spec.String())
+}
+
+func (n *NamedWindow) Children() []Node {
+	return appendNodes(nil, n.Spec)
+}
+
+// FrameUnits is the unit a window frame is measured in.
+type FrameUnits int
+
+const (
+	FrameRows FrameUnits = iota
+	FrameRange
+	FrameGroups
+)
+
+func (f FrameUnits) String() string {
+	switch f {
+	case FrameRows:
+		return "ROWS"
+	case FrameRange:
+		return "RANGE"
+	case FrameGroups:
+		return "GROUPS"
+	default:
+		return "unknown_frame_units"
+	}
+}
+
+// FrameBoundType is the kind of a window frame boundary.
+type FrameBoundType int
+
+const (
+	UnboundedPreceding FrameBoundType = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+// FrameBound represents one boundary of a window frame. Offset is set only for
+// Preceding and Following.
+type FrameBound struct {
+	span
+
+	Type   FrameBoundType
+	Offset Expression
+}
+
+func (f *FrameBound) String() string {
+	switch f.Type {
+	case UnboundedPreceding:
+		return "UNBOUNDED PRECEDING"
+	case Preceding:
+		return fmt.Sprintf("%s PRECEDING", f.Offset.String())
+	case CurrentRow:
+		return "CURRENT ROW"
+	case Following:
+		return fmt.Sprintf("%s FOLLOWING", f.Offset.String())
+	case UnboundedFollowing:
+		return "UNBOUNDED FOLLOWING"
+	default:
+		return "unknown_frame_bound"
+	}
+}
+
+func (f *FrameBound) Children() []Node {
+	return appendNodes(nil, f.Offset)
+}
+
+// FrameExclusion is the EXCLUDE option of a window frame.
+type FrameExclusion int
+
+const (
+	ExcludeDefault FrameExclusion = iota // no EXCLUDE written
+	ExcludeCurrentRow
+	ExcludeGroup
+	ExcludeTies
+	ExcludeNoOthers
+)
+
+// WindowFrame represents the frame clause of a window. EndBound is nil unless the
+// frame was written as BETWEEN start AND end.
+type WindowFrame struct {
+	span
+
+	Units      FrameUnits
+	StartBound *FrameBound
+	EndBound   *FrameBound
+	Exclude    FrameExclusion
+}
+
+func (w *WindowFrame) String() string {
+	frame := fmt.Sprintf("%s %s", w.Units, w.StartBound.String())
+	if w.EndBound != nil {
+		frame = fmt.Sprintf("%s BETWEEN %s AND %s", w.Units, w.StartBound.String(), w.EndBound.String())
 	}
-	tableName := "nil"
-	if s.Table != nil {
-		tableName = *s.Table
+	switch w.Exclude {
+	case ExcludeCurrentRow:
+		frame += " EXCLUDE CURRENT ROW"
+	case ExcludeGroup:
+		frame += " EXCLUDE GROUP"
+	case ExcludeTies:
+		frame += " EXCLUDE TIES"
+	case ExcludeNoOthers:
+		frame += " EXCLUDE NO OTHERS"
 	}
-	return fmt.Sprintf(
-		"SelectStatement(Expressions: [%s], Table: %s)",
-		strings.Join(expressions, ", "), tableName)
+	return frame
 }
 
-type ColumnExpression struct {
+func (w *WindowFrame) Children() []Node {
+	return appendNodes(nil, w.StartBound, w.EndBound)
+}
+
+// DataType represents a SQL data type, as used by CAST, typed literals and column definitions.
+// Name is the type name as written, with the words of multi-word types such as
+// DOUBLE PRECISION separated by single spaces and schema-qualified names joined by dots.
+type DataType struct {
+	span
+
 	Name string
+	// Modifiers are the parenthesized arguments of types like VARCHAR(255),
+	// NUMERIC(10, 2), TIMESTAMP(3) and INTERVAL DAY TO SECOND(3).
+	Modifiers []Expression
+	// TypeArguments are the element types of generic types like ARRAY<INT> or MAP<STRING, INT>.
+	TypeArguments []*DataType
+	// Fields are the members of STRUCT<...> types.
+	Fields []*StructField
+	// IntervalFields restricts an INTERVAL, as in "YEAR TO MONTH" or "SECOND".
+	IntervalFields string
+	TimeZone       TimeZoneOption
+	// ArrayBounds has one entry per array dimension, as in INT[] or INT[3][3].
+	// An entry is nil when the dimension has no declared size.
+	ArrayBounds []Expression
 }
 
-func (c *ColumnExpression) String() string {
-	return fmt.Sprintf("ColumnExpression(%s)", c.Name)
+func (d *DataType) String() string {
+	var b strings.Builder
+	b.WriteString("DataType(")
+	b.WriteString(d.Name)
+	if len(d.TypeArguments) > 0 {
+		arguments := make([]string, len(d.TypeArguments))
+		for i, argument := range d.TypeArguments {
+			arguments[i] = argument.String()
+		}
+		fmt.Fprintf(&b, "<%s>", strings.Join(arguments, ", "))
+	}
+	if len(d.Fields) > 0 {
+		fields := make([]string, len(d.Fields))
+		for i, field := range d.Fields {
+			fields[i] = field.String()
+		}
+		fmt.Fprintf(&b, "<%s>", strings.Join(fields, ", "))
+	}
+	if d.IntervalFields != "" {
+		fmt.Fprintf(&b, " %s", d.IntervalFields)
+	}
+	if len(d.Modifiers) > 0 {
+		fmt.Fprintf(&b, "(%s)", joinExpressions(d.Modifiers))
+	}
+	switch d.TimeZone {
+	case WithTimeZone:
+		b.WriteString(" WITH TIME ZONE")
+	case WithoutTimeZone:
+		b.WriteString(" WITHOUT TIME ZONE")
+	}
+	for _, bound := range d.ArrayBounds {
+		if bound == nil {
+			b.WriteString("[]")
+		} else {
+			fmt.Fprintf(&b, "[%s]", bound.String())
+		}
+	}
+	b.WriteString(")")
+	return b.String()
 }
 
-type BinaryExpression struct {
-	Left     Expression
+func (d *DataType) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, d.TypeArguments...)
+	nodes = appendNodes(nodes, d.Fields...)
+	nodes = appendNodes(nodes, d.Modifiers...)
+	nodes = appendNodes(nodes, d.ArrayBounds...)
+	return nodes
+}
+
+// TimeZoneOption is the WITH or WITHOUT TIME ZONE option of TIME and TIMESTAMP types.
+type TimeZoneOption int
+
+const (
+	TimeZoneDefault TimeZoneOption = iota // no option written
+	WithTimeZone
+	WithoutTimeZone
+)
+
+// StructField represents a member of a STRUCT type. Name is empty for anonymous members.
+type StructField struct {
+	span
+
+	Name string
+	Type *DataType
+}
+
+func (s *StructField) String() string {
+	if s.Name == "" {
+		return s.Type.String()
+	}
+	return fmt.Sprintf("%s %s", s.Name, s.Type.String())
+}
+
+func (s *StructField) Children() []Node {
+	return appendNodes(nil, s.Type)
+}
+
+// TypedLiteral represents a string literal preceded by its type, as in DATE '2024-01-01' or INTERVAL '1' DAY.
+type TypedLiteral struct {
+	span
+
+	Type  *DataType
+	Value string
+}
+
+func (t *TypedLiteral) String() string {
+	return fmt.Sprintf("TypedLiteral(%s '%s')", t.Type.String(), t.Value)
+}
+
+func (t *TypedLiteral) Children() []Node {
+	return appendNodes(nil, t.Type)
+}
+
+// CaseExpression represents a searched CASE, or a simple CASE when Operand is set.
+type CaseExpression struct {
+	span
+
+	Operand Expression
+	Whens   []*WhenClause
+	Else    Expression
+}
+
+func (c *CaseExpression) String() string {
+	var b strings.Builder
+	b.WriteString("CaseExpression(")
+	if c.Operand != nil {
+		fmt.Fprintf(&b, "%s ", c.Operand.String())
+	}
+	whens := make([]string, len(c.Whens))
+	for i, when := range c.Whens {
+		whens[i] = when.String()
+	}
+	b.WriteString(strings.Join(whens, " "))
+	if c.Else != nil {
+		fmt.Fprintf(&b, " ELSE %s", c.Else.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c *CaseExpression) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Operand)
+	nodes = appendNodes(nodes, c.Whens...)
+	nodes = appendNodes(nodes, c.Else)
+	return nodes
+}
+
+// WhenClause represents a WHEN ... THEN ... branch of a CASE expression.
+type WhenClause struct {
+	span
+
+	Condition Expression
+	Result    Expression
+}
+
+func (w *WhenClause) String() string {
+	return fmt.Sprintf("WHEN %s THEN %s", w.Condition.String(), w.Result.String())
+}
+
+func (w *WhenClause) Children() []Node {
+	return appendNodes(nil, w.Condition, w.Result)
+}
+
+// CastExpression represents CAST(x AS type), or x::type when DoubleColon is set.
+type CastExpression struct {
+	span
+
+	Expression  Expression
+	Type        *DataType
+	DoubleColon bool
+}
+
+func (c *CastExpression) String() string {
+	return fmt.Sprintf("CastExpression(%s AS %s)", c.Expression.String(), c.Type.String())
+}
+
+func (c *CastExpression) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, c.Expression)
+	nodes = appendNodes(nodes, c.Type)
+	return nodes
+}
+
+// ExtractExpression represents EXTRACT(field FROM source).
+type ExtractExpression struct {
+	span
+
+	Field  string
+	Source Expression
+}
+
+func (e *ExtractExpression) String() string {
+	return fmt.Sprintf("ExtractExpression(%s FROM %s)", e.Field, e.Source.String())
+}
+
+func (e *ExtractExpression) Children() []Node {
+	return appendNodes(nil, e.Source)
+}
+
+// PositionExpression represents POSITION(substring IN source).
+type PositionExpression struct {
+	span
+
+	Substring Expression
+	Source    Expression
+}
+
+func (p *PositionExpression) String() string {
+	return fmt.Sprintf("PositionExpression(%s IN %s)", p.Substring.String(), p.Source.String())
+}
+
+func (p *PositionExpression) Children() []Node {
+	return appendNodes(nil, p.Substring, p.Source)
+}
+
+// SubstringExpression represents SUBSTRING(source FROM start FOR length).
+// Either of From and For may be nil.
+type SubstringExpression struct {
+	span
+
+	Source Expression
+	From   Expression
+	For    Expression
+}
+
+func (s *SubstringExpression) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SubstringExpression(%s", s.Source.String())
+	if s.From != nil {
+		fmt.Fprintf(&b, " FROM %s", s.From.String())
+	}
+	if s.For != nil {
+		fmt.Fprintf(&b, " FOR %s", s.For.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (s *SubstringExpression) Children() []Node {
+	return appendNodes(nil, s.Source, s.From, s.For)
+}
+
+// TrimSide is the LEADING, TRAILING or BOTH option of a TRIM expression.
+type TrimSide int
+
+const (
+	TrimDefault TrimSide = iota // no side written
+	TrimBoth
+	TrimLeading
+	TrimTrailing
+)
+
+// TrimExpression represents TRIM([side] [characters] FROM source).
+type TrimExpression struct {
+	span
+
+	Side       TrimSide
+	Characters Expression
+	Source     Expression
+}
+
+func (t *TrimExpression) String() string {
+	var b strings.Builder
+	b.WriteString("TrimExpression(")
+	switch t.Side {
+	case TrimBoth:
+		b.WriteString("BOTH ")
+	case TrimLeading:
+		b.WriteString("LEADING ")
+	case TrimTrailing:
+		b.WriteString("TRAILING ")
+	}
+	if t.Characters != nil {
+		fmt.Fprintf(&b, "%s ", t.Characters.String())
+	}
+	fmt.Fprintf(&b, "FROM %s)", t.Source.String())
+	return b.String()
+}
+
+func (t *TrimExpression) Children() []Node {
+	return appendNodes(nil, t.Characters, t.Source)
+}
+
+// CollateExpression represents expression COLLATE collation.
+type CollateExpression struct {
+	span
+
+	Expression Expression
+	Collation  string
+}
+
+func (c *CollateExpression) String() string {
+	return fmt.Sprintf("CollateExpression(%s COLLATE %s)", c.Expression.String(), c.Collation)
+}
+
+func (c *CollateExpression) Children() []Node {
+	return appendNodes(nil, c.Expression)
+}
+
+// AtTimeZoneExpression represents expression AT TIME ZONE zone.
+type AtTimeZoneExpression struct {
+	span
+
+	Expression Expression
+	TimeZone   Expression
+}
+
+func (a *AtTimeZoneExpression) String() string {
+	return fmt.Sprintf("AtTimeZoneExpression(%s AT TIME ZONE %s)", a.Expression.String(), a.TimeZone.String())
+}
+
+func (a *AtTimeZoneExpression) Children() []Node {
+	return appendNodes(nil, a.Expression, a.TimeZone)
+}
+
+// IsExpression represents IS [NOT] NULL, IS [NOT] TRUE/FALSE and, when
+// DistinctFrom is set, IS [NOT] DISTINCT FROM.
+type IsExpression struct {
+	span
+
+	Expression   Expression
+	Not          bool
+	DistinctFrom bool
+	Right        Expression
+}
+
+func (i *IsExpression) String() string {
+	operator := "IS"
+	if i.Not {
+		operator += " NOT"
+	}
+	if i.DistinctFrom {
+		operator += " DISTINCT FROM"
+	}
+	return fmt.Sprintf("IsExpression(%s %s %s)", i.Expression.String(), operator, i.Right.String())
+}
+
+func (i *IsExpression) Children() []Node {
+	return appendNodes(nil, i.Expression, i.Right)
+}
+
+// InExpression represents expression [NOT] IN, with either a value List or a subquery.
+type InExpression struct {
+	span
+
+	Expression Expression
+	Not        bool
+	List       []Expression
+	Query      QueryExpression
+}
+
+func (i *InExpression) String() string {
+	operator := "IN"
+	if i.Not {
+		operator = "NOT IN"
+	}
+	values := fmt.Sprintf("[%s]", joinExpressions(i.List))
+	if i.Query != nil {
+		values = i.Query.String()
+	}
+	return fmt.Sprintf("InExpression(%s %s %s)", i.Expression.String(), operator, values)
+}
+
+func (i *InExpression) Children() []Node {
+	var nodes []Node
+	nodes = appendNodes(nodes, i.Expression)
+	nodes = appendNodes(nodes, i.List...)
+	nodes = appendNodes(nodes, i.Query)
+	return nodes
+}
+
+// BetweenExpression represents expression [NOT] BETWEEN low AND high.
+type BetweenExpression struct {
+	span
+
+	Expression Expression
+	Not        bool
+	Low        Expression
+	High       Expression
+}
+
+func (b *BetweenExpression) String() string {
+	operator := "BETWEEN"
+	if b.Not {
+		operator = "NOT BETWEEN"
+	}
+	return fmt.Sprintf("BetweenExpression(%s %s %s AND %s)", b.Expression.String(), operator, b.Low.String(), b.High.String())
+}
+
+func (b *BetweenExpression) Children() []Node {
+	return appendNodes(nil, b.Expression, b.Low, b.High)
+}
+
+// LikeExpression represents expression [NOT] LIKE pattern [ESCAPE escape],
+// or ILIKE when CaseInsensitive is set.
+type LikeExpression struct {
+	span
+
+	Expression      Expression
+	Not             bool
+	CaseInsensitive bool
+	Pattern         Expression
+	Escape          Expression
+}
+
+func (l *LikeExpression) String() string {
+	operator := "LIKE"
+	if l.CaseInsensitive {
+		operator = "ILIKE"
+	}
+	if l.Not {
+		operator = "NOT " + operator
+	}
+	escape := ""
+	if l.Escape != nil {
+		escape = fmt.Sprintf(" ESCAPE %s", l.Escape.String())
+	}
+	return fmt.Sprintf("LikeExpression(%s %s %s%s)", l.Expression.String(), operator, l.Pattern.String(), escape)
+}
+
+func (l *LikeExpression) Children() []Node {
+	return appendNodes(nil, l.Expression, l.Pattern, l.Escape)
+}
+
+// SubqueryExpression represents a parenthesized query used as a value.
+type SubqueryExpression struct {
+	span
+
+	Query QueryExpression
+}
+
+func (s *SubqueryExpression) String() string {
+	return fmt.Sprintf("SubqueryExpression(%s)", s.Query.String())
+}
+
+func (s *SubqueryExpression) Children() []Node {
+	return appendNodes(nil, s.Query)
+}
+
+// RowExpression represents a parenthesized list of two or more values, such as (1, 2).
+type RowExpression struct {
+	span
+
+	Items []Expression
+}
+
+func (r *RowExpression) String() string {
+	return fmt.Sprintf("RowExpression(%s)", joinExpressions(r.Items))
+}
+
+func (r *RowExpression) Children() []Node {
+	return appendNodes(nil, r.Items...)
+}
+
+// ExistsExpression represents EXISTS (query).
+type ExistsExpression struct {
+	span
+
+	Query QueryExpression
+}
+
+func (e *ExistsExpression) String() string {
+	return fmt.Sprintf("ExistsExpression(%s)", e.Query.String())
+}
+
+func (e *ExistsExpression) Children() []Node {
+	return appendNodes(nil, e.Query)
+}
+
+type UnaryExpression struct {
+	span
+
 	Operator tokens.TokenType // use only operators
-	Right    Expression
+	Operand  Expression
 }
 
-func (s *BinaryExpression) String() string {
-	return fmt.Sprintf("BinaryExpression(%s %s %s)",
-		s.Left.String(), operatorToString(s.Operator), s.Right.String())
+func (u *UnaryExpression) String() string {
+	return fmt.Sprintf("UnaryExpression(%s %s)", operatorToString(u.Operator), u.Operand.String())
+}
+
+func (u *UnaryExpression) Children() []Node {
+	return appendNodes(nil, u.Operand)
 }
 
 type NumericLiteral struct {
+	span
+
 	Value float64
+	Text  string // the literal as written, such as 1.50 or 12345678901234567890, which Value may not hold exactly
 }
 
 func (n *NumericLiteral) String() string {
 	return fmt.Sprintf("NumericLiteral(%f)", n.Value)
 }
 
+func (n *NumericLiteral) Children() []Node {
+	return nil
+}
+
+// StringLiteral represents a string literal. Prefix is the upper-cased letter
+// written before the quote: "E" for an escape string, whose Value has its
+// escapes resolved, "X" or "B" for a hexadecimal or bit string, whose Value
+// holds the digits, or "N" for a national string.
 type StringLiteral struct {
-	Value string
+	span
+
+	Prefix string
+	Value  string
 }
 
 func (s *StringLiteral) String() string {
-	return fmt.Sprintf("StringLiteral('%s')", s.Value)
+	return fmt.Sprintf("StringLiteral(%s'%s')", s.Prefix, s.Value)
+}
+
+func (s *StringLiteral) Children() []Node {
+	return nil
+}
+
+// DefaultExpression represents the DEFAULT keyword used in place of a value.
+type DefaultExpression struct {
+	span
+}
+
+func (d *DefaultExpression) String() string {
+	return "DefaultExpression(DEFAULT)"
 }
 
-type NullValue struct{}
+func (d *DefaultExpression) Children() []Node {
+	return nil
+}
+
+type NullValue struct {
+	span
+}
 
 func (n *NullValue) String() string {
 	return "NullValue(NULL)"
 }
 
+func (n *NullValue) Children() []Node {
+	return nil
+}
+
 type BooleanLiteral struct {
+	span
+
 	Value bool
 }
 
 func (b *BooleanLiteral) String() string {
 	return fmt.Sprintf("BooleanLiteral(%t)", b.Value)
 }
+
+func (b *BooleanLiteral) Children() []Node {
+	return nil
+}
+
+// PositionalParameter represents a parameter of a prepared statement such as $1; Index is 1 for $1.
+// A MySQL ? placeholder sets Question, and Index counts the placeholders of its statement from 1.
+type PositionalParameter struct {
+	span
+
+	Index    int
+	Question bool
+}
+
+func (p *PositionalParameter) String() string {
+	if p.Question {
+		return fmt.Sprintf("PositionalParameter(?%d)", p.Index)
+	}
+	return fmt.Sprintf("PositionalParameter($%d)", p.Index)
+}
+
+func (p *PositionalParameter) Children() []Node {
+	return nil
+}
+
+// UserVariable represents a MySQL user variable such as @total; Name is total for @total.
+type UserVariable struct {
+	span
+
+	Name string
+}
+
+func (v *UserVariable) String() string {
+	return fmt.Sprintf("UserVariable(@%s)", v.Name)
+}
+
+func (v *UserVariable) Children() []Node {
+	return nil
+}
@@ -17,21 +17,21 @@ func TestNodeStringMethods(t *testing.T) {
 			name: "SelectStatement with expressions",
 			node: &SelectStatement{
 				Expressions: []Expression{
-					&NumericLiteral{Value: 123},
+					&NumericLiteral{Value: 123, Text: "123"},
 				},
 			},
-			expected: "SelectStatement(Expressions: [NumericLiteral(123.000000)], Table: nil)",
+			expected: "SelectStatement(Expressions: [NumericLiteral(123.000000)], From: [])",
 		},
 		{
 			name: "SelectStatement with expressions with table",
 			node: &SelectStatement{
 				Expressions: []Expression{
 					&ColumnExpression{Name: "column1"},
-					&NumericLiteral{Value: 123},
+					&NumericLiteral{Value: 123, Text: "123"},
 				},
-				Table: addr("table1"),
+				From: []TableExpression{&TableName{Name: "table1"}},
 			},
-			expected: "SelectStatement(Expressions: [ColumnExpression(column1), NumericLiteral(123.000000)], Table: table1)",
+			expected: "SelectStatement(Expressions: [ColumnExpression(column1), NumericLiteral(123.000000)], From: [TableName(table1)])",
 		},
 		{
 			name:     "ColumnExpression",
@@ -40,7 +40,7 @@ func TestNodeStringMethods(t *testing.T) {
 		},
 		{
 			name:     "NumericLiteral",
-			node:     &NumericLiteral{Value: 456.78},
+			node:     &NumericLiteral{Value: 456.78, Text: "456.78"},
 			expected: "NumericLiteral(456.780000)",
 		},
 		{
@@ -66,12 +66,584 @@ func TestNodeStringMethods(t *testing.T) {
 		{
 			name: "BinaryExpression",
 			node: &BinaryExpression{
-				Left:     &NumericLiteral{Value: 123},
+				Left:     &NumericLiteral{Value: 123, Text: "123"},
 				Operator: tokens.TokenGreaterThan,
-				Right:    &NumericLiteral{Value: 234},
+				Right:    &NumericLiteral{Value: 234, Text: "234"},
 			},
 			expected: "BinaryExpression(NumericLiteral(123.000000) > NumericLiteral(234.000000))",
 		},
+		{
+			name: "SelectStatement with clauses",
+			node: &SelectStatement{
+				Expressions: []Expression{&ColumnExpression{Name: "a"}},
+				From:        []TableExpression{&TableName{Name: "t"}},
+				Where: &BinaryExpression{
+					Left:     &ColumnExpression{Name: "a"},
+					Operator: tokens.TokenEqual,
+					Right:    &NumericLiteral{Value: 1, Text: "1"},
+				},
+				OrderBy: []*OrderByItem{{Expression: &ColumnExpression{Name: "a"}, Direction: SortDesc}},
+				Limit:   &Limit{Style: LimitStyleLimit, Count: &NumericLiteral{Value: 10, Text: "10"}},
+			},
+			expected: "SelectStatement(Expressions: [ColumnExpression(a)], From: [TableName(t)], Where: BinaryExpression(ColumnExpression(a) = NumericLiteral(1.000000)), OrderBy: [OrderByItem(ColumnExpression(a) DESC)], Limit: Limit(LIMIT, Count: NumericLiteral(10.000000), Offset: nil))",
+		},
+		{
+			name:     "ColumnExpression with table",
+			node:     &ColumnExpression{Table: addr("t"), Name: "column2"},
+			expected: "ColumnExpression(t.column2)",
+		},
+		{
+			name:     "UnaryExpression",
+			node:     &UnaryExpression{Operator: tokens.TokenMinus, Operand: &NumericLiteral{Value: 1, Text: "1"}},
+			expected: "UnaryExpression(- NumericLiteral(1.000000))",
+		},
+		{
+			name: "OrderByItem",
+			node: &OrderByItem{
+				Expression: &ColumnExpression{Name: "name"},
+				Collation:  addr("C"),
+				Direction:  SortAsc,
+				Nulls:      NullsLast,
+			},
+			expected: "OrderByItem(ColumnExpression(name) COLLATE C ASC NULLS LAST)",
+		},
+		{
+			name: "Limit",
+			node: &Limit{
+				Style:    LimitStyleFetch,
+				Count:    &NumericLiteral{Value: 5, Text: "5"},
+				Offset:   &NumericLiteral{Value: 10, Text: "10"},
+				Percent:  true,
+				WithTies: true,
+			},
+			expected: "Limit(FETCH, Count: NumericLiteral(5.000000), Offset: NumericLiteral(10.000000), Percent, WithTies)",
+		},
+		{
+			name: "SetOperation",
+			node: &SetOperation{
+				Left:     &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+				Operator: tokens.TokenUnion,
+				All:      true,
+				Right:    &SelectStatement{Distinct: true, Expressions: []Expression{&NumericLiteral{Value: 2, Text: "2"}}},
+				Limit:    &Limit{Style: LimitStyleLimit, Count: &NumericLiteral{Value: 1, Text: "1"}},
+			},
+			expected: "SetOperation(SelectStatement(Expressions: [NumericLiteral(1.000000)], From: []) UNION ALL SelectStatement(Expressions: [NumericLiteral(2.000000)], From: [], Distinct), Limit: Limit(LIMIT, Count: NumericLiteral(1.000000), Offset: nil))",
+		},
+		{
+			name: "WithClause",
+			node: &WithClause{
+				Recursive: true,
+				CTEs: []*CommonTableExpression{
+					{
+						Name:         "t",
+						Columns:      []string{"n"},
+						Materialized: NotMaterialized,
+						Query:        &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+						Search:       &SearchClause{Columns: []string{"n"}, SetColumn: "ord"},
+						Cycle:        &CycleClause{Columns: []string{"n"}, SetColumn: "seen", PathColumn: "path"},
+					},
+				},
+			},
+			expected: "WithClause(RECURSIVE [CommonTableExpression(t (n) NOT MATERIALIZED AS SelectStatement(Expressions: [NumericLiteral(1.000000)], From: []) SearchClause(BREADTH FIRST BY n SET ord) CycleClause(n SET seen USING path))])",
+		},
+		{
+			name: "FunctionCall with window",
+			node: &FunctionCall{
+				Name:      "sum",
+				Distinct:  true,
+				Arguments: []Expression{&ColumnExpression{Name: "x"}},
+				Filter:    &BooleanLiteral{Value: true},
+				Over: &WindowSpec{
+					Name:        addr("w"),
+					PartitionBy: []Expression{&ColumnExpression{Name: "a"}},
+					OrderBy:     []*OrderByItem{{Expression: &ColumnExpression{Name: "b"}}},
+					Frame: &WindowFrame{
+						Units:      FrameRange,
+						StartBound: &FrameBound{Type: Preceding, Offset: &NumericLiteral{Value: 1, Text: "1"}},
+						EndBound:   &FrameBound{Type: UnboundedFollowing},
+						Exclude:    ExcludeCurrentRow,
+					},
+				},
+			},
+			expected: "FunctionCall(sum(DISTINCT ColumnExpression(x)) FILTER BooleanLiteral(true) OVER WindowSpec(w PARTITION BY ColumnExpression(a) ORDER BY OrderByItem(ColumnExpression(b)) RANGE BETWEEN NumericLiteral(1.000000) PRECEDING AND UNBOUNDED FOLLOWING EXCLUDE CURRENT ROW))",
+		},
+		{
+			name: "NamedWindow",
+			node: &NamedWindow{
+				Name: "w",
+				Spec: &WindowSpec{Frame: &WindowFrame{Units: FrameRows, StartBound: &FrameBound{Type: CurrentRow}}},
+			},
+			expected: "NamedWindow(w AS WindowSpec(ROWS CURRENT ROW))",
+		},
+		{
+			name: "CaseExpression",
+			node: &CaseExpression{
+				Operand: &ColumnExpression{Name: "a"},
+				Whens:   []*WhenClause{{Condition: &NumericLiteral{Value: 1, Text: "1"}, Result: &StringLiteral{Value: "one"}}},
+				Else:    &NullValue{},
+			},
+			expected: "CaseExpression(ColumnExpression(a) WHEN NumericLiteral(1.000000) THEN StringLiteral('one') ELSE NullValue(NULL))",
+		},
+		{
+			name: "CastExpression",
+			node: &CastExpression{
+				Expression:  &ColumnExpression{Name: "a"},
+				Type:        &DataType{Name: "varchar", Modifiers: []Expression{&NumericLiteral{Value: 10, Text: "10"}}},
+				DoubleColon: true,
+			},
+			expected: "CastExpression(ColumnExpression(a) AS DataType(varchar(NumericLiteral(10.000000))))",
+		},
+		{
+			name:     "TrimExpression",
+			node:     &TrimExpression{Side: TrimTrailing, Characters: &StringLiteral{Value: " "}, Source: &ColumnExpression{Name: "a"}},
+			expected: "TrimExpression(TRAILING StringLiteral(' ') FROM ColumnExpression(a))",
+		},
+		{
+			name:     "SubstringExpression",
+			node:     &SubstringExpression{Source: &ColumnExpression{Name: "a"}, From: &NumericLiteral{Value: 2, Text: "2"}},
+			expected: "SubstringExpression(ColumnExpression(a) FROM NumericLiteral(2.000000))",
+		},
+		{
+			name:     "IsExpression",
+			node:     &IsExpression{Expression: &ColumnExpression{Name: "a"}, Not: true, DistinctFrom: true, Right: &ColumnExpression{Name: "b"}},
+			expected: "IsExpression(ColumnExpression(a) IS NOT DISTINCT FROM ColumnExpression(b))",
+		},
+		{
+			name:     "InExpression",
+			node:     &InExpression{Expression: &ColumnExpression{Name: "a"}, Not: true, List: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+			expected: "InExpression(ColumnExpression(a) NOT IN [NumericLiteral(1.000000)])",
+		},
+		{
+			name: "LikeExpression",
+			node: &LikeExpression{
+				Expression:      &ColumnExpression{Name: "a"},
+				CaseInsensitive: true,
+				Pattern:         &StringLiteral{Value: "x%"},
+			},
+			expected: "LikeExpression(ColumnExpression(a) ILIKE StringLiteral('x%'))",
+		},
+		{
+			name: "BetweenExpression",
+			node: &BetweenExpression{
+				Expression: &ColumnExpression{Name: "a"},
+				Low:        &NumericLiteral{Value: 1, Text: "1"},
+				High:       &NumericLiteral{Value: 2, Text: "2"},
+			},
+			expected: "BetweenExpression(ColumnExpression(a) BETWEEN NumericLiteral(1.000000) AND NumericLiteral(2.000000))",
+		},
+		{
+			name: "DataType",
+			node: &DataType{
+				Name:        "timestamp",
+				Modifiers:   []Expression{&NumericLiteral{Value: 3, Text: "3"}},
+				TimeZone:    WithTimeZone,
+				ArrayBounds: []Expression{nil},
+			},
+			expected: "DataType(timestamp(NumericLiteral(3.000000)) WITH TIME ZONE[])",
+		},
+		{
+			name: "DataType struct",
+			node: &DataType{
+				Name:   "STRUCT",
+				Fields: []*StructField{{Name: "a", Type: &DataType{Name: "INT64"}}, {Type: &DataType{Name: "STRING"}}},
+			},
+			expected: "DataType(STRUCT<a DataType(INT64), DataType(STRING)>)",
+		},
+		{
+			name:     "TypedLiteral",
+			node:     &TypedLiteral{Type: &DataType{Name: "INTERVAL", IntervalFields: "YEAR TO MONTH"}, Value: "1-2"},
+			expected: "TypedLiteral(DataType(INTERVAL YEAR TO MONTH) '1-2')",
+		},
+		{
+			name: "InsertStatement with values",
+			node: &InsertStatement{
+				Table:      &TableName{Name: "t"},
+				Columns:    []string{"a", "b"},
+				Overriding: OverridingUserValue,
+				Values:     [][]Expression{{&NumericLiteral{Value: 1, Text: "1"}, &DefaultExpression{}}},
+			},
+			expected: "InsertStatement(Table: TableName(t), Columns: [a, b], OVERRIDING USER VALUE, Values: [[NumericLiteral(1.000000), DefaultExpression(DEFAULT)]])",
+		},
+		{
+			name:     "InsertStatement with default values",
+			node:     &InsertStatement{Table: &TableName{Name: "t", Alias: addr("x")}, DefaultValues: true},
+			expected: "InsertStatement(Table: TableName(t AS x), DEFAULT VALUES)",
+		},
+		{
+			name: "InsertStatement with upsert and returning",
+			node: &InsertStatement{
+				Table:  &TableName{Name: "t"},
+				Values: [][]Expression{{&NumericLiteral{Value: 1, Text: "1"}}},
+				OnConflict: &OnConflict{
+					Target:      []Expression{&ColumnExpression{Name: "id"}},
+					Action:      ConflictDoUpdate,
+					Assignments: []*Assignment{{Column: "n", Value: &NumericLiteral{Value: 2, Text: "2"}}},
+					Where:       &BooleanLiteral{Value: true},
+				},
+				Returning: []Expression{&AliasedExpression{Expression: &ColumnExpression{Name: "id"}, Alias: "x"}},
+			},
+			expected: "InsertStatement(Table: TableName(t), Values: [[NumericLiteral(1.000000)]], OnConflict([ColumnExpression(id)] DO UPDATE SET [Assignment(n = NumericLiteral(2.000000))] WHERE BooleanLiteral(true)), Returning: [AliasedExpression(ColumnExpression(id) AS x)])",
+		},
+		{
+			name:     "OnConflict on constraint",
+			node:     &OnConflict{Constraint: addr("t_pkey")},
+			expected: "OnConflict(ON CONSTRAINT t_pkey DO NOTHING)",
+		},
+		{
+			name:     "InsertStatement with on duplicate key update",
+			node:     &InsertStatement{Table: &TableName{Name: "t"}, DefaultValues: true, OnDuplicateKeyUpdate: []*Assignment{{Column: "n", Value: &NullValue{}}}},
+			expected: "InsertStatement(Table: TableName(t), DEFAULT VALUES, OnDuplicateKeyUpdate: [Assignment(n = NullValue(NULL))])",
+		},
+		{
+			name: "UpdateStatement",
+			node: &UpdateStatement{
+				Tables: []TableExpression{&TableName{Name: "t", Alias: addr("x")}},
+				Assignments: []*Assignment{
+					{Columns: []string{"a", "b"}, Value: &RowExpression{Items: []Expression{&NumericLiteral{Value: 1, Text: "1"}, &NullValue{}}}},
+				},
+				From:      []TableExpression{&SubqueryTable{Query: &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}}}},
+				Where:     &BooleanLiteral{Value: true},
+				Returning: []Expression{&ColumnExpression{Name: "a"}},
+			},
+			expected: "UpdateStatement(Tables: [TableName(t AS x)], Set: [Assignment((a, b) = RowExpression(NumericLiteral(1.000000), NullValue(NULL)))], From: [SubqueryTable(SelectStatement(Expressions: [NumericLiteral(1.000000)], From: []))], Where: BooleanLiteral(true), Returning: [ColumnExpression(a)])",
+		},
+		{
+			name: "Join",
+			node: &Join{
+				Left:  &Join{Left: &TableName{Name: "a"}, Type: JoinCross, Right: &TableName{Name: "b"}},
+				Type:  JoinFull,
+				Right: &TableName{Name: "c", Alias: addr("z")},
+				Using: []string{"id", "k"},
+			},
+			expected: "Join(Join(TableName(a) CROSS JOIN TableName(b)) FULL JOIN TableName(c AS z) USING [id, k])",
+		},
+		{
+			name: "DeleteStatement",
+			node: &DeleteStatement{
+				Tables:    []TableExpression{&TableName{Name: "t"}},
+				From:      []TableExpression{&TableName{Name: "t"}},
+				Using:     []TableExpression{&TableName{Name: "u"}},
+				CurrentOf: addr("c"),
+				Limit:     &Limit{Count: &NumericLiteral{Value: 1, Text: "1"}},
+			},
+			expected: "DeleteStatement(Tables: [TableName(t)], From: [TableName(t)], Using: [TableName(u)], Where: CURRENT OF c, Limit: Limit(LIMIT, Count: NumericLiteral(1.000000), Offset: nil))",
+		},
+		{
+			name:     "TruncateStatement",
+			node:     &TruncateStatement{Tables: []TableExpression{&TableName{Name: "a"}, &TableName{Name: "b"}}, Identity: IdentityContinue, Behavior: DropRestrict},
+			expected: "TruncateStatement(Tables: [TableName(a), TableName(b)], CONTINUE IDENTITY, RESTRICT)",
+		},
+		{
+			name: "CreateTableStatement",
+			node: &CreateTableStatement{
+				Name:        "t",
+				Temporary:   true,
+				IfNotExists: true,
+				Elements: []TableElement{
+					&ColumnDefinition{
+						Name:      "id",
+						Type:      &DataType{Name: "INT"},
+						Collation: addr("C"),
+						Constraints: []*ColumnConstraint{
+							{Kind: ConstraintIdentity, IdentityOptions: []*SequenceOption{{Name: "CACHE", Value: &NumericLiteral{Value: 5, Text: "5"}}}},
+							{Name: addr("nn"), Kind: ConstraintNotNull},
+						},
+					},
+					&TableConstraint{Kind: TableConstraintForeignKey, Columns: []string{"id"}, References: &ForeignKeyReference{Table: "p", OnDelete: ActionSetDefault}},
+					&TableLikeClause{Table: "base"},
+				},
+				PartitionBy: &PartitionSpec{Strategy: "HASH", Keys: []Expression{&ColumnExpression{Name: "id"}}},
+			},
+			expected: "CreateTableStatement(Name: t, TEMPORARY, IF NOT EXISTS, Elements: [ColumnDefinition(id DataType(INT) COLLATE C ColumnConstraint(GENERATED ALWAYS AS IDENTITY [SequenceOption(CACHE NumericLiteral(5.000000))]) ColumnConstraint(CONSTRAINT nn NOT NULL)), TableConstraint(FOREIGN KEY [id] REFERENCES ForeignKeyReference(p ON DELETE SET DEFAULT)), Like(base)], PartitionBy(HASH [ColumnExpression(id)]))",
+		},
+		{
+			name: "TableConstraint exclude",
+			node: &TableConstraint{
+				Name:       addr("no_overlap"),
+				Kind:       TableConstraintExclude,
+				Exclusions: []*ExclusionElement{{Expression: &ColumnExpression{Name: "r"}, Operator: "&&"}},
+			},
+			expected: "TableConstraint(CONSTRAINT no_overlap EXCLUDE [ExclusionElement(ColumnExpression(r) WITH &&)])",
+		},
+		{
+			name:     "ColumnConstraint generated stored",
+			node:     &ColumnConstraint{Kind: ConstraintGenerated, Expression: &NumericLiteral{Value: 1, Text: "1"}},
+			expected: "ColumnConstraint(GENERATED ALWAYS AS NumericLiteral(1.000000) STORED)",
+		},
+		{
+			name:     "TableConstraint deferrable",
+			node:     &TableConstraint{Kind: TableConstraintUnique, NullsNotDistinct: true, Columns: []string{"a"}, Deferrable: addr(false), InitiallyDeferred: addr(true)},
+			expected: "TableConstraint(UNIQUE NULLS NOT DISTINCT [a] NOT DEFERRABLE INITIALLY DEFERRED)",
+		},
+		{
+			name: "AlterTableStatement",
+			node: &AlterTableStatement{
+				Name:     "t",
+				IfExists: true,
+				Actions: []*AlterTableAction{
+					{Kind: AlterAddColumn, Column: &ColumnDefinition{Name: "a", Type: &DataType{Name: "INT"}}, After: addr("b")},
+					{Kind: AlterDropColumn, Name: "c", IfExists: true, Behavior: DropCascade},
+					{Kind: AlterColumnType, Name: "d", Type: &DataType{Name: "TEXT"}, Using: &ColumnExpression{Name: "d"}},
+					{Kind: AlterAddConstraint, Constraint: &TableConstraint{Kind: TableConstraintUnique, Columns: []string{"a"}}, NotValid: true},
+					{Kind: AlterAttachPartition, Name: "p", Bound: &PartitionBound{Modulus: &NumericLiteral{Value: 2, Text: "2"}, Remainder: &NumericLiteral{Value: 1, Text: "1"}}},
+				},
+			},
+			expected: "AlterTableStatement(Name: t, IF EXISTS, Actions: [AlterTableAction(ADD COLUMN ColumnDefinition(a DataType(INT)) AFTER b), AlterTableAction(DROP COLUMN IF EXISTS c CASCADE), AlterTableAction(ALTER COLUMN d TYPE DataType(TEXT) USING ColumnExpression(d)), AlterTableAction(ADD TableConstraint(UNIQUE [a]) NOT VALID), AlterTableAction(ATTACH PARTITION p PartitionBound(MODULUS NumericLiteral(2.000000), REMAINDER NumericLiteral(1.000000)))])",
+		},
+		{
+			name:     "PartitionBound range",
+			node:     &PartitionBound{From: []Expression{&NumericLiteral{Value: 1, Text: "1"}}, To: []Expression{&NumericLiteral{Value: 10, Text: "10"}}},
+			expected: "PartitionBound(FROM [NumericLiteral(1.000000)] TO [NumericLiteral(10.000000)])",
+		},
+		{
+			name: "CreateIndexStatement",
+			node: &CreateIndexStatement{
+				Unique:   true,
+				Name:     addr("i"),
+				Only:     true,
+				Table:    "t",
+				Method:   addr("gin"),
+				Elements: []*IndexElement{{Expression: &ColumnExpression{Name: "a"}, Collation: addr("C"), OpClass: addr("ops"), Direction: SortAsc, Nulls: NullsFirst}},
+				Include:  []string{"b"},
+				Where:    &BooleanLiteral{Value: true},
+			},
+			expected: "CreateIndexStatement(UNIQUE, Name: i, Table: ONLY t, Using: gin, Elements: [IndexElement(ColumnExpression(a) COLLATE C ops ASC NULLS FIRST)], Include: [b], Where: BooleanLiteral(true))",
+		},
+		{
+			name: "CreateViewStatement",
+			node: &CreateViewStatement{
+				OrReplace:   true,
+				Name:        "v",
+				Columns:     []string{"a"},
+				Query:       &SelectStatement{Expressions: []Expression{&NumericLiteral{Value: 1, Text: "1"}}},
+				CheckOption: "CASCADED",
+			},
+			expected: "CreateViewStatement(OR REPLACE, Name: v, Columns: [a], Query: SelectStatement(Expressions: [NumericLiteral(1.000000)], From: []), WITH CASCADED CHECK OPTION)",
+		},
+		{
+			name:     "RefreshMaterializedViewStatement",
+			node:     &RefreshMaterializedViewStatement{Name: "mv", WithNoData: true},
+			expected: "RefreshMaterializedViewStatement(Name: mv, WITH NO DATA)",
+		},
+		{
+			name:     "CreateSchemaStatement",
+			node:     &CreateSchemaStatement{IfNotExists: true, Name: "s", Authorization: addr("r")},
+			expected: "CreateSchemaStatement(IF NOT EXISTS, Name: s, Authorization: r)",
+		},
+		{
+			name: "CreateSequenceStatement",
+			node: &CreateSequenceStatement{
+				Temporary: true,
+				Name:      "s",
+				Options:   []*SequenceOption{{Name: "AS", Type: &DataType{Name: "INT"}}, {Name: "NO CYCLE"}},
+			},
+			expected: "CreateSequenceStatement(TEMPORARY, Name: s, Options: [SequenceOption(AS DataType(INT)), SequenceOption(NO CYCLE)])",
+		},
+		{
+			name:     "AlterSequenceStatement",
+			node:     &AlterSequenceStatement{IfExists: true, Name: "s", Options: []*SequenceOption{{Name: "RESTART"}}},
+			expected: "AlterSequenceStatement(IF EXISTS, Name: s, Options: [SequenceOption(RESTART)])",
+		},
+		{
+			name:     "DropStatement",
+			node:     &DropStatement{ObjectKind: "INDEX", Concurrently: true, IfExists: true, Names: []string{"a", "b"}, Behavior: DropCascade},
+			expected: "DropStatement(INDEX, CONCURRENTLY, IF EXISTS, Names: [a, b], CASCADE)",
+		},
+		{
+			name: "CreateFunctionStatement",
+			node: &CreateFunctionStatement{
+				OrReplace:  true,
+				Name:       "f",
+				Parameters: []*FunctionParameter{{Mode: ParameterModeOut, Name: addr("n"), Type: &DataType{Name: "int"}}},
+				Returns:    &DataType{Name: "int"},
+				Language:   "sql",
+				Volatility: "STABLE",
+				Attributes: []string{"STRICT"},
+				Body:       addr("select 1"),
+			},
+			expected: `CreateFunctionStatement(OR REPLACE, Name: f, Parameters: [FunctionParameter(OUT n DataType(int))], Returns: DataType(int), Language: sql, STABLE, STRICT, Body: "select 1")`,
+		},
+		{
+			name:     "CreateProcedureStatement",
+			node:     &CreateFunctionStatement{Procedure: true, Name: "p", Return: &NumericLiteral{Value: 1, Text: "1"}},
+			expected: "CreateProcedureStatement(Name: p, Parameters: [], Return: NumericLiteral(1.000000))",
+		},
+		{
+			name: "CreateTriggerStatement",
+			node: &CreateTriggerStatement{
+				Name:     "t",
+				Timing:   "AFTER",
+				Events:   []*TriggerEvent{{Kind: "UPDATE", Columns: []string{"a"}}, {Kind: "DELETE"}},
+				Table:    "x",
+				Function: "f",
+			},
+			expected: "CreateTriggerStatement(Name: t, AFTER [TriggerEvent(UPDATE OF [a]), TriggerEvent(DELETE)], Table: x, FOR EACH STATEMENT, Execute: f())",
+		},
+		{
+			name:     "CreateTypeStatement",
+			node:     &CreateTypeStatement{Name: "mood", Kind: TypeKindEnum, Labels: []string{"sad", "ok"}},
+			expected: `CreateTypeStatement(Name: mood, ENUM ["sad" "ok"])`,
+		},
+		{
+			name:     "AlterTypeStatement",
+			node:     &AlterTypeStatement{Name: "mood", IfNotExists: true, Value: "meh", Before: addr("ok")},
+			expected: `AlterTypeStatement(Name: mood, ADD VALUE IF NOT EXISTS "meh" BEFORE "ok")`,
+		},
+		{
+			name:     "CreateDomainStatement",
+			node:     &CreateDomainStatement{Name: "d", Type: &DataType{Name: "text"}, Collation: addr("C"), Constraints: []*ColumnConstraint{{Kind: ConstraintNotNull}}},
+			expected: "CreateDomainStatement(Name: d, Type: DataType(text), COLLATE C, ColumnConstraint(NOT NULL))",
+		},
+		{
+			name:     "CreateExtensionStatement",
+			node:     &CreateExtensionStatement{Name: "hstore", Schema: addr("ext"), Cascade: true},
+			expected: "CreateExtensionStatement(Name: hstore, Schema: ext, CASCADE)",
+		},
+		{
+			name: "DropStatement with signatures",
+			node: &DropStatement{
+				ObjectKind: "FUNCTION",
+				Names:      []string{"f", "g"},
+				Signatures: [][]*FunctionParameter{{{Type: &DataType{Name: "int"}}}, nil},
+			},
+			expected: "DropStatement(FUNCTION, Names: [f(FunctionParameter(DataType(int))), g])",
+		},
+		{
+			name:     "BeginStatement",
+			node:     &BeginStatement{Modes: TransactionModes{IsolationLevel: "SERIALIZABLE", ReadOnly: addr(false), Deferrable: addr(true)}},
+			expected: "BeginStatement(ISOLATION LEVEL SERIALIZABLE, READ WRITE, DEFERRABLE)",
+		},
+		{
+			name:     "CommitStatement",
+			node:     &CommitStatement{Chain: true},
+			expected: "CommitStatement(AND CHAIN)",
+		},
+		{
+			name:     "RollbackStatement",
+			node:     &RollbackStatement{Savepoint: addr("sp")},
+			expected: "RollbackStatement(To: sp)",
+		},
+		{
+			name:     "SetStatement",
+			node:     &SetStatement{Scope: SetScopeLocal, Name: "search_path", Values: []Expression{&ColumnExpression{Name: "public"}}},
+			expected: "SetStatement(LOCAL, Name: search_path, Values: [ColumnExpression(public)])",
+		},
+		{
+			name:     "SetStatement to default",
+			node:     &SetStatement{Name: "work_mem", Default: true},
+			expected: "SetStatement(Name: work_mem, DEFAULT)",
+		},
+		{
+			name:     "SetTransactionStatement",
+			node:     &SetTransactionStatement{Session: true, Modes: TransactionModes{ReadOnly: addr(true)}},
+			expected: "SetTransactionStatement(SESSION, READ ONLY)",
+		},
+		{
+			name:     "ShowStatement",
+			node:     &ShowStatement{Name: "ALL"},
+			expected: "ShowStatement(ALL)",
+		},
+		{
+			name: "GrantStatement",
+			node: &GrantStatement{
+				Privileges:      []*Privilege{{Name: "UPDATE", Columns: []string{"a"}}},
+				ObjectKind:      "ALL TABLES IN SCHEMA",
+				Objects:         []string{"app"},
+				Grantees:        []string{"r"},
+				WithGrantOption: true,
+			},
+			expected: "GrantStatement(Privileges: [Privilege(UPDATE, Columns: [a])], On: ALL TABLES IN SCHEMA app, To: [r], WITH GRANT OPTION)",
+		},
+		{
+			name:     "RevokeStatement",
+			node:     &RevokeStatement{GrantOptionFor: true, Roles: []string{"admin"}, Grantees: []string{"alice"}, GrantedBy: addr("owner"), Behavior: DropCascade},
+			expected: "RevokeStatement(GRANT OPTION FOR, Roles: [admin], From: [alice], GrantedBy: owner, CASCADE)",
+		},
+		{
+			name:     "CreateUserStatement",
+			node:     &CreateRoleStatement{User: true, Name: "bob", Options: []*RoleOption{{Name: "LOGIN"}, {Name: "CONNECTION LIMIT", Value: &NumericLiteral{Value: 2, Text: "2"}}, {Name: "IN ROLE", Roles: []string{"a"}}}},
+			expected: "CreateUserStatement(Name: bob, Options: [RoleOption(LOGIN), RoleOption(CONNECTION LIMIT NumericLiteral(2.000000)), RoleOption(IN ROLE [a])])",
+		},
+		{
+			name:     "AlterRoleStatement",
+			node:     &AlterRoleStatement{Name: "app", NewName: addr("svc")},
+			expected: "AlterRoleStatement(Name: app, RenameTo: svc)",
+		},
+		{
+			name:     "AlterRoleStatement set",
+			node:     &AlterRoleStatement{Name: "app", Database: addr("shop"), Set: &SetStatement{Name: "work_mem", Values: []Expression{&StringLiteral{Value: "64MB"}}}},
+			expected: "AlterRoleStatement(Name: app, InDatabase: shop, SetStatement(Name: work_mem, Values: [StringLiteral('64MB')]))",
+		},
+		{
+			name: "AlterDefaultPrivilegesStatement",
+			node: &AlterDefaultPrivilegesStatement{
+				Schemas: []string{"app"},
+				Action:  &GrantStatement{Privileges: []*Privilege{{Name: "SELECT"}}, ObjectKind: "TABLES", Grantees: []string{"r"}},
+			},
+			expected: "AlterDefaultPrivilegesStatement(Schemas: [app], GrantStatement(Privileges: [Privilege(SELECT)], On: TABLES, To: [r]))",
+		},
+		{
+			name:     "GrantStatement to a MySQL account",
+			node:     &GrantStatement{Privileges: []*Privilege{{Name: "SELECT"}}, Objects: []string{"shop.*"}, Grantees: []string{"app"}, Hosts: []*string{addr("%")}},
+			expected: "GrantStatement(Privileges: [Privilege(SELECT)], On: shop.*, To: [app@%])",
+		},
+		{
+			name: "MergeStatement",
+			node: &MergeStatement{
+				Target: &TableName{Name: "t"},
+				Source: &TableName{Name: "s"},
+				On:     &BooleanLiteral{Value: true},
+				Clauses: []*MergeWhenClause{
+					{Match: MergeMatched, Condition: &BooleanLiteral{Value: false}, Action: MergeUpdate, Assignments: []*Assignment{{Column: "a", Value: &NumericLiteral{Value: 1, Text: "1"}}}},
+					{Match: MergeNotMatched, Action: MergeInsert, Columns: []string{"a"}, Values: []Expression{&NumericLiteral{Value: 2, Text: "2"}}},
+					{Match: MergeNotMatchedBySource, Action: MergeDoNothing},
+				},
+			},
+			expected: "MergeStatement(Target: TableName(t), Using: TableName(s), On: BooleanLiteral(true), " +
+				"When(MATCHED AND BooleanLiteral(false) THEN UPDATE SET [Assignment(a = NumericLiteral(1.000000))]), " +
+				"When(NOT MATCHED THEN INSERT (a) VALUES (NumericLiteral(2.000000))), " +
+				"When(NOT MATCHED BY SOURCE THEN DO NOTHING))",
+		},
+		{
+			name:     "PositionalParameter",
+			node:     &PositionalParameter{Index: 2},
+			expected: "PositionalParameter($2)",
+		},
+		{
+			name:     "PositionalParameter placeholder",
+			node:     &PositionalParameter{Index: 2, Question: true},
+			expected: "PositionalParameter(?2)",
+		},
+		{
+			name:     "ExplainStatement",
+			node:     &ExplainStatement{Options: []*UtilityOption{{Name: "ANALYZE"}, {Name: "FORMAT", Value: addr("json")}}, Statement: &ExecuteStatement{Name: "p"}},
+			expected: "ExplainStatement(Options: [UtilityOption(ANALYZE), UtilityOption(FORMAT json)], ExecuteStatement(Name: p, Arguments: []))",
+		},
+		{
+			name:     "PrepareStatement",
+			node:     &PrepareStatement{Name: "p", Types: []*DataType{{Name: "int"}}, Statement: &DeallocateStatement{Name: "ALL"}},
+			expected: "PrepareStatement(Name: p, Types: [DataType(int)], DeallocateStatement(ALL))",
+		},
+		{
+			name:     "VacuumStatement",
+			node:     &VacuumStatement{Options: []*UtilityOption{{Name: "FULL"}}, Tables: []*VacuumRelation{{Table: "t", Columns: []string{"a"}}}},
+			expected: "VacuumStatement(Options: [UtilityOption(FULL)], Tables: [VacuumRelation(t [a])])",
+		},
+		{
+			name:     "CopyStatement",
+			node:     &CopyStatement{Table: "t", Columns: []string{"a"}, Options: []*UtilityOption{{Name: "CSV"}}, Data: addr("1\n")},
+			expected: `CopyStatement(t (a), FROM STDIN, Options: [UtilityOption(CSV)], Data: "1\n")`,
+		},
+		{
+			name:     "CopyStatement to program",
+			node:     &CopyStatement{Table: "t", To: true, File: addr("gzip > t.gz"), Program: true},
+			expected: `CopyStatement(t, TO PROGRAM "gzip > t.gz")`,
+		},
+		{
+			name:     "LoadDataStatement",
+			node:     &LoadDataStatement{File: "u.csv", Duplicates: "IGNORE", Table: "users", FieldsEnclosedBy: addr(`"`), IgnoreLines: 1},
+			expected: `LoadDataStatement(File: "u.csv", IGNORE, Table: users, FieldsEnclosedBy: "\"", IgnoreLines: 1)`,
+		},
 	}
 
 	for _, tt := range tests {
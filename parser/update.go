@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseUpdate parses an UPDATE statement. with is the statement's WITH clause, or nil.
+func (p *Parser) parseUpdate(with *WithClause) (*UpdateStatement, error) {
+	p.pos++ // Skip the UPDATE token
+	stmt := &UpdateStatement{With: with}
+	var err error
+	stmt.Tables, err = p.parseTableExpressions()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing UPDATE target: %w", err)
+	}
+	if _, err := p.expect(tokens.TokenSet, "SET"); err != nil {
+		return nil, err
+	}
+	stmt.Assignments, err = p.parseAssignments()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SET clause: %w", err)
+	}
+	if p.consume(tokens.TokenFrom) {
+		stmt.From, err = p.parseTableExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing FROM clause: %w", err)
+		}
+	}
+	if p.consume(tokens.TokenWhere) {
+		stmt.Where, err = p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing WHERE clause: %w", err)
+		}
+	}
+	if p.consume(tokens.TokenOrder) {
+		stmt.OrderBy, err = p.parseOrderBy()
+		if err != nil {
+			return nil, err
+		}
+	}
+	stmt.Limit, err = p.parseLimit()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Returning, err = p.parseReturning()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
@@ -0,0 +1,226 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseExplain parses EXPLAIN [ANALYZE] [VERBOSE] statement, EXPLAIN (option [value], ...) statement
+// and MySQL's EXPLAIN FORMAT = format statement.
+func (p *Parser) parseExplain() (*ExplainStatement, error) {
+	p.pos++ // Skip EXPLAIN
+	stmt := &ExplainStatement{}
+	var err error
+	switch {
+	case p.peek().Type == tokens.TokenLeftParen:
+		stmt.Options, err = p.parseUtilityOptions()
+		if err != nil {
+			return nil, err
+		}
+	case p.peekWord("FORMAT") && p.peekAhead(1).Type == tokens.TokenEqual:
+		pos := p.peek().Pos
+		p.pos += 2 // Skip FORMAT =
+		format, err := p.parseIdentifier("EXPLAIN format")
+		if err != nil {
+			return nil, err
+		}
+		stmt.Options = []*UtilityOption{finishNode(p, pos, &UtilityOption{Name: "FORMAT", Value: &format})}
+	default:
+		stmt.Options = p.parseUtilityWords("ANALYZE", "VERBOSE")
+	}
+	start := p.pos
+	stmt.Statement, err = p.parseStatement()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing explained statement: %w", err)
+	}
+	if !explainable(stmt.Statement) {
+		return nil, fmt.Errorf("expected SELECT, INSERT, UPDATE, DELETE, MERGE, EXECUTE, CREATE TABLE AS or CREATE MATERIALIZED VIEW after EXPLAIN, found %q at position %d", p.tokens[start].Literal, start)
+	}
+	return stmt, nil
+}
+
+// explainable reports whether EXPLAIN can show the plan of stmt: a query,
+// DML, EXECUTE, or a CREATE TABLE AS or CREATE MATERIALIZED VIEW that runs one.
+func explainable(stmt Statement) bool {
+	switch s := stmt.(type) {
+	case *SelectStatement, *SetOperation, *InsertStatement, *UpdateStatement, *DeleteStatement, *MergeStatement, *ExecuteStatement:
+		return true
+	case *CreateTableStatement:
+		return s.Query != nil
+	case *CreateViewStatement:
+		return s.Materialized
+	}
+	return false
+}
+
+// parseUtilityOptions parses a parenthesized list of options, each a name
+// followed by an optional value such as a word, number or string.
+func (p *Parser) parseUtilityOptions() ([]*UtilityOption, error) {
+	p.pos++ // Skip the opening parenthesis
+	var options []*UtilityOption
+	for {
+		// TRUNCATE is a reserved word elsewhere but also an option of VACUUM.
+		name := p.next()
+		if name.Type != tokens.TokenIdentifier && name.Type != tokens.TokenTruncate {
+			return nil, fmt.Errorf("expected option name, found %q at position %d", name.Literal, p.pos-1)
+		}
+		option := &UtilityOption{Name: strings.ToUpper(name.Literal)}
+		switch value := p.peek(); value.Type {
+		case tokens.TokenIdentifier, tokens.TokenStringLiteral, tokens.TokenNumericLiteral, tokens.TokenBooleanLiteral, tokens.TokenOn:
+			p.pos++
+			literal := value.RawValue()
+			option.Value = &literal
+			option.Quoted = value.Type == tokens.TokenStringLiteral
+		}
+		options = append(options, finishNode(p, name.Pos, option))
+
+		if !p.consume(tokens.TokenComma) {
+			break
+		}
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after options"); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// parseUtilityWords parses the option words that may precede the statement or tables
+// of EXPLAIN, ANALYZE and VACUUM, in the given order, each of which may be omitted.
+func (p *Parser) parseUtilityWords(words ...string) []*UtilityOption {
+	var options []*UtilityOption
+	for _, word := range words {
+		if pos := p.peek().Pos; p.consumeWord(word) {
+			options = append(options, finishNode(p, pos, &UtilityOption{Name: word}))
+		}
+	}
+	return options
+}
+
+// parsePrepare parses PREPARE name [(type, ...)] AS statement.
+func (p *Parser) parsePrepare() (*PrepareStatement, error) {
+	p.pos++ // Skip PREPARE
+	name, err := p.parseIdentifier("prepared statement name")
+	if err != nil {
+		return nil, err
+	}
+	stmt := &PrepareStatement{Name: name}
+	if p.consume(tokens.TokenLeftParen) {
+		for {
+			typ, err := p.parseDataType()
+			if err != nil {
+				return nil, err
+			}
+			stmt.Types = append(stmt.Types, typ)
+
+			if !p.consume(tokens.TokenComma) {
+				break
+			}
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after parameter types"); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.expect(tokens.TokenAs, "AS after prepared statement name"); err != nil {
+		return nil, err
+	}
+	stmt.Statement, err = p.parseStatement()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing prepared statement %s: %w", name, err)
+	}
+	return stmt, nil
+}
+
+// parseExecute parses EXECUTE name [(argument, ...)].
+func (p *Parser) parseExecute() (*ExecuteStatement, error) {
+	p.pos++ // Skip EXECUTE
+	name, err := p.parseIdentifier("prepared statement name")
+	if err != nil {
+		return nil, err
+	}
+	stmt := &ExecuteStatement{Name: name}
+	if p.consume(tokens.TokenLeftParen) {
+		stmt.Arguments, err = p.parseExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing EXECUTE arguments: %w", err)
+		}
+		if _, err := p.expect(tokens.TokenRightParen, ") after EXECUTE arguments"); err != nil {
+			return nil, err
+		}
+	}
+	return stmt, nil
+}
+
+// parseDeallocate parses DEALLOCATE [PREPARE] {name | ALL}.
+func (p *Parser) parseDeallocate() (*DeallocateStatement, error) {
+	p.pos++ // Skip DEALLOCATE
+	p.consumeWord("PREPARE")
+	if p.consume(tokens.TokenAll) {
+		return &DeallocateStatement{Name: "ALL"}, nil
+	}
+	name, err := p.parseIdentifier("prepared statement name")
+	if err != nil {
+		return nil, err
+	}
+	return &DeallocateStatement{Name: name}, nil
+}
+
+// parseAnalyze parses ANALYZE [(options) | VERBOSE] [table [(column, ...)], ...].
+func (p *Parser) parseAnalyze() (*AnalyzeStatement, error) {
+	p.pos++ // Skip ANALYZE
+	options, tables, err := p.parseVacuumArguments("VERBOSE")
+	if err != nil {
+		return nil, err
+	}
+	return &AnalyzeStatement{Options: options, Tables: tables}, nil
+}
+
+// parseVacuum parses VACUUM [(options) | [FULL] [FREEZE] [VERBOSE] [ANALYZE]] [table [(column, ...)], ...].
+func (p *Parser) parseVacuum() (*VacuumStatement, error) {
+	p.pos++ // Skip VACUUM
+	options, tables, err := p.parseVacuumArguments("FULL", "FREEZE", "VERBOSE", "ANALYZE")
+	if err != nil {
+		return nil, err
+	}
+	return &VacuumStatement{Options: options, Tables: tables}, nil
+}
+
+// parseVacuumArguments parses the options and the optional table list shared by ANALYZE
+// and VACUUM; words are the option words allowed in place of a parenthesized list.
+func (p *Parser) parseVacuumArguments(words ...string) ([]*UtilityOption, []*VacuumRelation, error) {
+	var options []*UtilityOption
+	if p.peek().Type == tokens.TokenLeftParen {
+		var err error
+		options, err = p.parseUtilityOptions()
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		options = p.parseUtilityWords(words...)
+	}
+	var tables []*VacuumRelation
+	if p.peek().Type != tokens.TokenIdentifier {
+		return options, tables, nil
+	}
+	for {
+		pos := p.peek().Pos
+		name, err := p.parseQualifiedName("table name")
+		if err != nil {
+			return nil, nil, err
+		}
+		relation := &VacuumRelation{Table: name}
+		if p.peek().Type == tokens.TokenLeftParen {
+			relation.Columns, err = p.parseIdentifierList()
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		tables = append(tables, finishNode(p, pos, relation))
+
+		if !p.consume(tokens.TokenComma) {
+			return options, tables, nil
+
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package parser
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children
+// of node with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses an AST in depth-first order, visiting the nodes in source order.
+// It starts by calling v.Visit(node); node must not be nil. If the visitor w
+// returned by v.Visit(node) is not nil, Walk is invoked recursively with visitor
+// w for each of the children of node, followed by a call of w.Visit(nil), which
+// serves as the hook for leaving node. Returning nil skips the subtree.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	for _, child := range node.Children() {
+		Walk(v, child)
+	}
+	v.Visit(nil)
+}
+
+// inspector adapts a function to the Visitor interface.
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses an AST in depth-first order: It starts by calling f(node);
+// node must not be nil. If f returns true, Inspect invokes f recursively for
+// each of the children of node, followed by a call of f(nil).
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sanemat/go-sql-parser/lexer"
+)
+
+// parseOne parses input, which must hold a single statement.
+func parseOne(t *testing.T, input string) Statement {
+	t.Helper()
+	stmts, err := NewParser(lexer.NewLexer(input).Lex()).Parse()
+	if err != nil {
+		t.Fatalf("Parser.Parse() error = %v", err)
+	}
+	if len(stmts) != 1 {
+		t.Fatalf("Parser.Parse() returned %d statements, want 1", len(stmts))
+	}
+	return stmts[0]
+}
+
+// checkSourceOrder checks that the children of every node parsed from input
+// start in source order, as Walk promises.
+func checkSourceOrder(t *testing.T, input string) {
+	t.Helper()
+	stmts, err := NewParser(lexer.NewLexer(input).Lex()).Parse()
+	if err != nil {
+		t.Fatalf("Parser.Parse() error = %v", err)
+	}
+	for _, stmt := range stmts {
+		Inspect(stmt, func(node Node) bool {
+			if node == nil {
+				return false
+			}
+			children := node.Children()
+			for i := 1; i < len(children); i++ {
+				if children[i].Pos() < children[i-1].Pos() {
+					t.Errorf("%T.Children(): %v at %d follows %v at %d", node, children[i], children[i].Pos(), children[i-1], children[i-1].Pos())
+				}
+			}
+			return true
+		})
+	}
+}
+
+func TestInspect(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "tables in source order",
+			input: "update orders o set total = (select sum(price) from items where paid) from customers c join regions r on c.region = r.id",
+			want:  []string{"orders", "items", "customers", "regions"},
+		},
+		{
+			name:  "tables of a merge",
+			input: "merge into stock s using (select * from deliveries) d on s.item = d.item when matched then delete",
+			want:  []string{"stock", "deliveries"},
+		},
+		{
+			name:  "joins nested in a delete",
+			input: "delete from a using b join c on b.id = c.id where exists (select 1)",
+			want:  []string{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			Inspect(parseOne(t, tt.input), func(node Node) bool {
+				if table, ok := node.(*TableName); ok {
+					got = append(got, table.Name)
+				}
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tables = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInspectSkipsSubtrees(t *testing.T) {
+	stmt := parseOne(t, "select a, (select b from t where c = 1) from u where d in (select e from v)")
+	var columns []string
+	Inspect(stmt, func(node Node) bool {
+		switch node := node.(type) {
+		case *SubqueryExpression, *InExpression:
+			return false
+		case *ColumnExpression:
+			columns = append(columns, node.Name)
+		}
+		return true
+	})
+	if want := []string{"a"}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("columns = %v, want %v", columns, want)
+	}
+}
+
+// tracer records entering and leaving nodes as an indented outline.
+type tracer struct {
+	depth int
+	lines []string
+}
+
+func (v *tracer) Visit(node Node) Visitor {
+	if node == nil {
+		v.depth--
+		v.lines = append(v.lines, strings.Repeat("  ", v.depth)+"}")
+		return nil
+	}
+	name := strings.TrimPrefix(fmt.Sprintf("%T", node), "*parser.")
+	v.lines = append(v.lines, strings.Repeat("  ", v.depth)+name+" {")
+	v.depth++
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	stmt := parseOne(t, "select -a from t where b between 1 and 2")
+	v := &tracer{}
+	Walk(v, stmt)
+	want := []string{
+		"SelectStatement {",
+		"  UnaryExpression {",
+		"    ColumnExpression {",
+		"    }",
+		"  }",
+		"  TableName {",
+		"  }",
+		"  BetweenExpression {",
+		"    ColumnExpression {",
+		"    }",
+		"    NumericLiteral {",
+		"    }",
+		"    NumericLiteral {",
+		"    }",
+		"  }",
+		"}",
+	}
+	if !reflect.DeepEqual(v.lines, want) {
+		t.Errorf("Walk visited\n%s\nwant\n%s", strings.Join(v.lines, "\n"), strings.Join(want, "\n"))
+
+	}
+}
@@ -0,0 +1,174 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseOver parses the window of an OVER clause: either the name of a window
+// defined in the WINDOW clause or a parenthesized window specification.
+func (p *Parser) parseOver() (*WindowSpec, error) {
+	if p.peek().Type == tokens.TokenIdentifier {
+		pos := p.peek().Pos
+		name := p.next().RawValue()
+		return finishNode(p, pos, &WindowSpec{Name: &name}), nil
+	}
+	return p.parseWindowSpec()
+}
+
+// parseWindows parses the definitions of a WINDOW clause. The WINDOW token has already been consumed.
+func (p *Parser) parseWindows() ([]*NamedWindow, error) {
+	var windows []*NamedWindow
+	for {
+		pos := p.peek().Pos
+		name, err := p.parseIdentifier("window name")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenAs, "AS"); err != nil {
+			return nil, err
+		}
+		spec, err := p.parseWindowSpec()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing window %s: %w", name, err)
+		}
+		windows = append(windows, finishNode(p, pos, &NamedWindow{Name: name, Spec: spec}))
+
+		if !p.consume(tokens.TokenComma) {
+			return windows, nil
+		}
+	}
+}
+
+// parseWindowSpec parses ( [existing_window] [PARTITION BY ...] [ORDER BY ...] [frame] ).
+func (p *Parser) parseWindowSpec() (*WindowSpec, error) {
+	paren, err := p.expect(tokens.TokenLeftParen, "( before window specification")
+	if err != nil {
+		return nil, err
+	}
+	spec := &WindowSpec{}
+	if p.peek().Type == tokens.TokenIdentifier && !p.peekWord("PARTITION") && !p.peekFrameUnits() {
+		name := p.next().RawValue()
+		spec.Name = &name
+	}
+	if p.consumeWord("PARTITION") {
+		if _, err := p.expect(tokens.TokenBy, "BY after PARTITION"); err != nil {
+			return nil, err
+		}
+		partitionBy, err := p.parseExpressions()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing PARTITION BY clause: %w", err)
+		}
+		spec.PartitionBy = partitionBy
+	}
+	if p.consume(tokens.TokenOrder) {
+		orderBy, err := p.parseOrderBy()
+		if err != nil {
+			return nil, err
+		}
+		spec.OrderBy = orderBy
+	}
+	if p.peekFrameUnits() {
+		frame, err := p.parseWindowFrame()
+		if err != nil {
+			return nil, err
+		}
+		spec.Frame = frame
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after window specification"); err != nil {
+		return nil, err
+	}
+	return finishNode(p, paren.Pos, spec), nil
+}
+
+// peekFrameUnits reports whether the current token starts a window frame clause.
+func (p *Parser) peekFrameUnits() bool {
+	return p.peekWord("ROWS") || p.peekWord("RANGE") || p.peekWord("GROUPS")
+}
+
+// parseWindowFrame parses {ROWS | RANGE | GROUPS} {start | BETWEEN start AND end} [EXCLUDE ...].
+func (p *Parser) parseWindowFrame() (*WindowFrame, error) {
+	pos := p.peek().Pos
+	frame := &WindowFrame{}
+	switch {
+	case p.consumeWord("ROWS"):
+		frame.Units = FrameRows
+	case p.consumeWord("RANGE"):
+		frame.Units = FrameRange
+	case p.consumeWord("GROUPS"):
+		frame.Units = FrameGroups
+	}
+	var err error
+	if p.consume(tokens.TokenBetween) {
+		frame.StartBound, err = p.parseFrameBound()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenAnd, "AND in window frame"); err != nil {
+			return nil, err
+		}
+		frame.EndBound, err = p.parseFrameBound()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		frame.StartBound, err = p.parseFrameBound()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.consumeWord("EXCLUDE") {
+		switch {
+		case p.consumeWord("CURRENT"):
+			if err := p.expectWord("ROW"); err != nil {
+				return nil, err
+			}
+			frame.Exclude = ExcludeCurrentRow
+		case p.consume(tokens.TokenGroup):
+			frame.Exclude = ExcludeGroup
+		case p.consumeWord("TIES"):
+			frame.Exclude = ExcludeTies
+		case p.consumeWord("NO"):
+			if err := p.expectWord("OTHERS"); err != nil {
+				return nil, err
+			}
+			frame.Exclude = ExcludeNoOthers
+		default:
+			return nil, fmt.Errorf("expected CURRENT ROW, GROUP, TIES or NO OTHERS after EXCLUDE, found %q at position %d", p.peek().Literal, p.pos)
+		}
+	}
+	return finishNode(p, pos, frame), nil
+}
+
+// parseFrameBound parses UNBOUNDED {PRECEDING | FOLLOWING}, CURRENT ROW or offset {PRECEDING | FOLLOWING}.
+func (p *Parser) parseFrameBound() (*FrameBound, error) {
+	pos := p.peek().Pos
+	switch {
+	case p.consumeWord("UNBOUNDED"):
+		switch {
+		case p.consumeWord("PRECEDING"):
+			return finishNode(p, pos, &FrameBound{Type: UnboundedPreceding}), nil
+		case p.consumeWord("FOLLOWING"):
+			return finishNode(p, pos, &FrameBound{Type: UnboundedFollowing}), nil
+		}
+		return nil, fmt.Errorf("expected PRECEDING or FOLLOWING after UNBOUNDED, found %q at position %d", p.peek().Literal, p.pos)
+	case p.consumeWord("CURRENT"):
+		if err := p.expectWord("ROW"); err != nil {
+			return nil, err
+		}
+		return finishNode(p, pos, &FrameBound{Type: CurrentRow}), nil
+	}
+	offset, err := p.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing window frame bound: %w", err)
+	}
+	switch {
+	case p.consumeWord("PRECEDING"):
+		return finishNode(p, pos, &FrameBound{Type: Preceding, Offset: offset}), nil
+	case p.consumeWord("FOLLOWING"):
+		return finishNode(p, pos, &FrameBound{Type: Following, Offset: offset}), nil
+
+	}
+	return nil, fmt.Errorf("expected PRECEDING or FOLLOWING after frame offset, found %q at position %d", p.peek().Literal, p.pos)
+}
@@ -0,0 +1,148 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/sanemat/go-sql-parser/tokens"
+)
+
+// parseWith parses a WITH clause of common table expressions.
+func (p *Parser) parseWith() (*WithClause, error) {
+	pos := p.next().Pos // Skip the WITH token
+	with := &WithClause{Recursive: p.consumeWord("RECURSIVE")}
+	for {
+		cte, err := p.parseCommonTableExpression()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing WITH clause: %w", err)
+		}
+		with.CTEs = append(with.CTEs, cte)
+
+		if !p.consume(tokens.TokenComma) {
+			return finishNode(p, pos, with), nil
+		}
+	}
+}
+
+// parseCommonTableExpression parses name [(columns)] AS [[NOT] MATERIALIZED] (query) [SEARCH ...] [CYCLE ...].
+func (p *Parser) parseCommonTableExpression() (*CommonTableExpression, error) {
+	pos := p.peek().Pos
+	name, err := p.parseIdentifier("common table expression name")
+	if err != nil {
+		return nil, err
+	}
+	cte := &CommonTableExpression{Name: name}
+	if p.peek().Type == tokens.TokenLeftParen {
+		cte.Columns, err = p.parseIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.expect(tokens.TokenAs, "AS"); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.consumeWord("MATERIALIZED"):
+		cte.Materialized = Materialized
+	case p.peek().Type == tokens.TokenNot && p.peekAhead(1).Type == tokens.TokenIdentifier:
+		p.pos++ // Skip NOT
+		if err := p.expectWord("MATERIALIZED"); err != nil {
+			return nil, err
+		}
+		cte.Materialized = NotMaterialized
+	}
+	if _, err := p.expect(tokens.TokenLeftParen, "( before common table expression query"); err != nil {
+		return nil, err
+	}
+	cte.Query, err = p.parseQuery()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing common table expression %s: %w", name, err)
+	}
+	if _, err := p.expect(tokens.TokenRightParen, ") after common table expression query"); err != nil {
+		return nil, err
+	}
+	if p.peekWord("SEARCH") {
+		cte.Search, err = p.parseSearchClause()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.peekWord("CYCLE") {
+		cte.Cycle, err = p.parseCycleClause()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return finishNode(p, pos, cte), nil
+}
+
+// parseSearchClause parses SEARCH {BREADTH | DEPTH} FIRST BY columns SET column.
+func (p *Parser) parseSearchClause() (*SearchClause, error) {
+	pos := p.next().Pos // Skip the SEARCH word
+	search := &SearchClause{}
+	switch {
+	case p.consumeWord("BREADTH"):
+	case p.consumeWord("DEPTH"):
+		search.DepthFirst = true
+	default:
+		return nil, fmt.Errorf("expected BREADTH or DEPTH after SEARCH, found %q at position %d", p.peek().Literal, p.pos)
+	}
+	if err := p.expectWord("FIRST"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenBy, "BY"); err != nil {
+		return nil, err
+	}
+	var err error
+	search.Columns, err = p.parseIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenSet, "SET"); err != nil {
+		return nil, err
+	}
+	search.SetColumn, err = p.parseIdentifier("search sequence column")
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, search), nil
+}
+
+// parseCycleClause parses CYCLE columns SET column [TO value DEFAULT value] USING column.
+func (p *Parser) parseCycleClause() (*CycleClause, error) {
+	pos := p.next().Pos // Skip the CYCLE word
+	cycle := &CycleClause{}
+	var err error
+	cycle.Columns, err = p.parseIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens.TokenSet, "SET"); err != nil {
+		return nil, err
+	}
+	cycle.SetColumn, err = p.parseIdentifier("cycle mark column")
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(tokens.TokenTo) {
+		cycle.MarkValue, err = p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokens.TokenDefault, "DEFAULT"); err != nil {
+			return nil, err
+		}
+		cycle.DefaultValue, err = p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.expect(tokens.TokenUsing, "USING"); err != nil {
+		return nil, err
+	}
+	cycle.PathColumn, err = p.parseIdentifier("cycle path column")
+	if err != nil {
+		return nil, err
+	}
+	return finishNode(p, pos, cycle), nil
+
+}
@@ -2,6 +2,7 @@ package tokens
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -31,13 +32,200 @@ const (
 	TokenGreaterThanOrEqual
 	TokenLessThan
 	TokenLessThanOrEqual
+	TokenEqual
+	TokenNotEqual
+	TokenPlus
+	TokenMinus
+	TokenSlash
+	TokenPercent
+	TokenLeftParen
+	TokenRightParen
+	TokenDot
+	TokenLeftBracket
+	TokenRightBracket
+	// TokenMultiply is never emitted by the lexer: "*" is lexed as TokenSymbol
+	// because it doubles as the SELECT wildcard. The parser uses this type for
+	// "*" found in operator position.
+	TokenMultiply
+	TokenConcat
+	TokenDoubleColon
+
+	TokenWhere
+	TokenGroup
+	TokenHaving
+	TokenOrder
+	TokenBy
+	TokenAsc
+	TokenDesc
+	TokenLimit
+	TokenOffset
+	TokenFetch
+	TokenAnd
+	TokenOr
+	TokenNot
+	TokenCollate
+	TokenAll
+	TokenWith
+	TokenUnion
+	TokenIntersect
+	TokenExcept
+	TokenDistinct
+	TokenAs
+	TokenSet
+	TokenTo
+	TokenDefault
+	TokenUsing
+	TokenWindow
+	TokenBetween
+	TokenCase
+	TokenWhen
+	TokenThen
+	TokenElse
+	TokenEnd
+	TokenCast
+	TokenIs
+	TokenIn
+	TokenLike
+	TokenILike
+	TokenFor
+	TokenInsert
+	TokenInto
+	TokenValues
+	TokenOn
+	TokenUpdate
+	TokenReturning
+	TokenConstraint
+	TokenDelete
+	TokenTruncate
+	TokenTable
+	TokenCreate
+	TokenPrimary
+	TokenUnique
+	TokenCheck
+	TokenReferences
+	TokenForeign
+	TokenAlter
+	TokenDrop
+	// TokenParameter is a positional parameter such as $1, or MySQL's ?, in a prepared statement.
+	TokenParameter
+	// TokenCopyData holds the rows that follow COPY ... FROM STDIN; in a script,
+	// up to but not including the terminating \. line.
+	TokenCopyData
+	// TokenVariable is a MySQL user variable such as @total.
+	TokenVariable
 	// Extend with more token types as needed (e.g., TokenString, TokenNumber)
 )
 
+// tokenTypeNames holds the name of each token type, which is its constant without the Token prefix.
+var tokenTypeNames = [...]string{
+	TokenError:              "Error",
+	TokenEOF:                "EOF",
+	TokenIdentifier:         "Identifier",
+	TokenKeyword:            "Keyword",
+	TokenSymbol:             "Symbol",
+	TokenComment:            "Comment",
+	TokenStringLiteral:      "StringLiteral",
+	TokenNumericLiteral:     "NumericLiteral",
+	TokenDateAndTimeLiteral: "DateAndTimeLiteral",
+	TokenHexadecimalLiteral: "HexadecimalLiteral",
+	TokenBitValueLiteral:    "BitValueLiteral",
+	TokenBooleanLiteral:     "BooleanLiteral",
+	TokenNull:               "Null",
+	TokenSelect:             "Select",
+	TokenFrom:               "From",
+	TokenComma:              "Comma",
+	TokenSemicolon:          "Semicolon",
+	TokenGreaterThan:        "GreaterThan",
+	TokenGreaterThanOrEqual: "GreaterThanOrEqual",
+	TokenLessThan:           "LessThan",
+	TokenLessThanOrEqual:    "LessThanOrEqual",
+	TokenEqual:              "Equal",
+	TokenNotEqual:           "NotEqual",
+	TokenPlus:               "Plus",
+	TokenMinus:              "Minus",
+	TokenSlash:              "Slash",
+	TokenPercent:            "Percent",
+	TokenLeftParen:          "LeftParen",
+	TokenRightParen:         "RightParen",
+	TokenDot:                "Dot",
+	TokenLeftBracket:        "LeftBracket",
+	TokenRightBracket:       "RightBracket",
+	TokenMultiply:           "Multiply",
+	TokenConcat:             "Concat",
+	TokenDoubleColon:        "DoubleColon",
+	TokenWhere:              "Where",
+	TokenGroup:              "Group",
+	TokenHaving:             "Having",
+	TokenOrder:              "Order",
+	TokenBy:                 "By",
+	TokenAsc:                "Asc",
+	TokenDesc:               "Desc",
+	TokenLimit:              "Limit",
+	TokenOffset:             "Offset",
+	TokenFetch:              "Fetch",
+	TokenAnd:                "And",
+	TokenOr:                 "Or",
+	TokenNot:                "Not",
+	TokenCollate:            "Collate",
+	TokenAll:                "All",
+	TokenWith:               "With",
+	TokenUnion:              "Union",
+	TokenIntersect:          "Intersect",
+	TokenExcept:             "Except",
+	TokenDistinct:           "Distinct",
+	TokenAs:                 "As",
+	TokenSet:                "Set",
+	TokenTo:                 "To",
+	TokenDefault:            "Default",
+	TokenUsing:              "Using",
+	TokenWindow:             "Window",
+	TokenBetween:            "Between",
+	TokenCase:               "Case",
+	TokenWhen:               "When",
+	TokenThen:               "Then",
+	TokenElse:               "Else",
+	TokenEnd:                "End",
+	TokenCast:               "Cast",
+	TokenIs:                 "Is",
+	TokenIn:                 "In",
+	TokenLike:               "Like",
+	TokenILike:              "ILike",
+	TokenFor:                "For",
+	TokenInsert:             "Insert",
+	TokenInto:               "Into",
+	TokenValues:             "Values",
+	TokenOn:                 "On",
+	TokenUpdate:             "Update",
+	TokenReturning:          "Returning",
+	TokenConstraint:         "Constraint",
+	TokenDelete:             "Delete",
+	TokenTruncate:           "Truncate",
+	TokenTable:              "Table",
+	TokenCreate:             "Create",
+	TokenPrimary:            "Primary",
+	TokenUnique:             "Unique",
+	TokenCheck:              "Check",
+	TokenReferences:         "References",
+	TokenForeign:            "Foreign",
+	TokenAlter:              "Alter",
+	TokenDrop:               "Drop",
+	TokenParameter:          "Parameter",
+	TokenCopyData:           "CopyData",
+	TokenVariable:           "Variable",
+}
+
+func (t TokenType) String() string {
+	if t >= 0 && int(t) < len(tokenTypeNames) {
+		return tokenTypeNames[t]
+	}
+	return fmt.Sprintf("TokenType(%d)", int(t))
+}
+
 // Token represents a lexed piece of the input.
 type Token struct {
 	Type    TokenType
 	Literal string
+	Pos     int // byte offset of the token in the lexed input
 }
 
 func (t Token) String() string {
@@ -52,6 +240,103 @@ func (t Token) RawValue() string {
 			unquoted := t.Literal[1 : len(t.Literal)-1]
 			return strings.ReplaceAll(unquoted, "''", "'")
 		}
+		// Prefixed strings: E'...' with backslash escapes, hexadecimal X'...' and bit B'...'
+		// strings, whose digits are returned as written, and national N'...' strings
+		if len(t.Literal) >= 3 && t.Literal[1] == '\'' && strings.HasSuffix(t.Literal, "'") {
+			body := t.Literal[2 : len(t.Literal)-1]
+			switch t.Literal[0] {
+			case 'e', 'E':
+				return unescapeString(body)
+			case 'x', 'X', 'b', 'B':
+				return body
+			default:
+				return strings.ReplaceAll(body, "''", "'")
+			}
+		}
+		// PostgreSQL dollar-quoted strings such as $$text$$ or $tag$text$tag$
+		if strings.HasPrefix(t.Literal, "$") {
+			if end := strings.Index(t.Literal[1:], "$"); end >= 0 {
+				tag := t.Literal[:end+2]
+				if len(t.Literal) >= 2*len(tag) && strings.HasSuffix(t.Literal, tag) {
+					return t.Literal[len(tag) : len(t.Literal)-len(tag)]
+				}
+			}
+		}
+	case TokenIdentifier:
+		// Standard SQL double-quoted and MySQL backtick-quoted identifiers
+		for _, quote := range []string{`"`, "`"} {
+			if len(t.Literal) >= 2 && strings.HasPrefix(t.Literal, quote) && strings.HasSuffix(t.Literal, quote) {
+				unquoted := t.Literal[1 : len(t.Literal)-1]
+				return strings.ReplaceAll(unquoted, quote+quote, quote)
+			}
+		}
 	}
 	return t.Literal
 }
+
+// unescapeString returns the value of the body of an escape string E'...',
+// resolving doubled quotes and the backslash escapes of PostgreSQL: \b, \f,
+// \n, \r, \t, octal \o, \oo and \ooo, hexadecimal \xh and \xhh, and
+// Unicode \uXXXX and \UXXXXXXXX. Any other escaped character stands for itself.
+func unescapeString(body string) string {
+	var b strings.Builder
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case c == '\'' && i+1 < len(body) && body[i+1] == '\'':
+			b.WriteByte('\'')
+			i++
+			continue
+		case c != '\\' || i+1 == len(body):
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch c = body[i]; c {
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			n := digitsAt(body, i, 3, 8)
+			value, _ := strconv.ParseUint(body[i:i+n], 8, 8)
+			b.WriteByte(byte(value))
+			i += n - 1
+		case 'x', 'u', 'U':
+			width := map[byte]int{'x': 2, 'u': 4, 'U': 8}[c]
+			n := digitsAt(body, i+1, width, 16)
+			if n == 0 || (c != 'x' && n < width) {
+				b.WriteByte(c)
+				continue
+			}
+			value, _ := strconv.ParseUint(body[i+1:i+1+n], 16, 32)
+			if c == 'x' {
+				b.WriteByte(byte(value))
+			} else {
+				b.WriteRune(rune(value))
+			}
+			i += n
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// digitsAt returns the number of digits of the given base, at most max, that start at s[i].
+func digitsAt(s string, i, max, base int) int {
+	n := 0
+	for n < max && i+n < len(s) {
+		if _, err := strconv.ParseUint(s[i+n:i+n+1], base, 8); err != nil {
+			break
+		}
+		n++
+	}
+	return n
+}
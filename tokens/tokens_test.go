@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"strconv"
 	"testing"
 )
 
@@ -34,6 +35,62 @@ func TestTokenRawValue(t *testing.T) {
 			},
 			expected: "O'Reilly",
 		},
+		{
+			name: "plain identifier",
+			token: Token{
+				Type:    TokenIdentifier,
+				Literal: "users",
+			},
+			expected: "users",
+		},
+		{
+			name: "double-quoted identifier",
+			token: Token{
+				Type:    TokenIdentifier,
+				Literal: `"Order ""Id"""`,
+			},
+			expected: `Order "Id"`,
+		},
+		{
+			name: "backtick-quoted identifier",
+			token: Token{
+				Type:    TokenIdentifier,
+				Literal: "`user name`",
+			},
+			expected: "user name",
+		},
+		{
+			name: "dollar-quoted string",
+			token: Token{
+				Type:    TokenStringLiteral,
+				Literal: "$fn$it's $$raw$$$fn$",
+			},
+			expected: "it's $$raw$$",
+		},
+		{
+			name: "escape string",
+			token: Token{
+				Type:    TokenStringLiteral,
+				Literal: `E'it\'s''\t\x41\101\u00e9\q'`,
+			},
+			expected: "it's'\tAA\u00e9q",
+		},
+		{
+			name: "hexadecimal string",
+			token: Token{
+				Type:    TokenStringLiteral,
+				Literal: "X'1F'",
+			},
+			expected: "1F",
+		},
+		{
+			name: "national string",
+			token: Token{
+				Type:    TokenStringLiteral,
+				Literal: "N'O''Reilly'",
+			},
+			expected: "O'Reilly",
+		},
 	}
 
 	for _, tt := range tests {
@@ -45,3 +102,26 @@ func TestTokenRawValue(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenTypeString(t *testing.T) {
+	tests := []struct {
+		tokenType TokenType
+		expected  string
+	}{
+		{TokenError, "Error"},
+		{TokenSelect, "Select"},
+		{TokenDoubleColon, "DoubleColon"},
+		{TokenCopyData, "CopyData"},
+		{TokenVariable, "Variable"},
+		{TokenVariable + 1, "TokenType(" + strconv.Itoa(int(TokenVariable)+1) + ")"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got := tt.tokenType.String()
+			if got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}